@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/webpush"
+	"github.com/chestnut42/terraforming-mars-manager/internal/database"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+const (
+	ratingUsage  = "usage: manager rating replay [-dry-run]"
+	webPushUsage = "usage: manager webpush keys"
+)
+
+// runCommand executes a one-off maintenance command instead of starting the server
+func runCommand(ctx context.Context, cfg Config, args []string) error {
+	switch args[0] {
+	case "rating":
+		return runRating(ctx, cfg, args[1:])
+	case "webpush":
+		return runWebPush(args[1:])
+	default:
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+}
+
+func runRating(ctx context.Context, cfg Config, args []string) error {
+	if len(args) == 0 || args[0] != "replay" {
+		return errors.New(ratingUsage)
+	}
+
+	fs := flag.NewFlagSet("rating replay", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "print rating changes without saving them")
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%s: %w", ratingUsage, err)
+	}
+
+	db, err := database.PrepareDB(cfg.PostgresDSN)
+	if err != nil {
+		return fmt.Errorf("failed to prepare db: %w", err)
+	}
+	defer db.Close() //nolint:errcheck
+
+	storageSvc, err := storage.New(db)
+	if err != nil {
+		return fmt.Errorf("failed to create storage: %w", err)
+	}
+	gameSvc := game.NewService(game.Config{
+		ScanInterval: cfg.Games.ScanInterval,
+	}, storageSvc, nil)
+
+	replays, err := gameSvc.ReplayRatings(ctx, *dryRun)
+	if err != nil {
+		return err
+	}
+	return printRatingReplays(os.Stdout, replays, *dryRun)
+}
+
+func printRatingReplays(w io.Writer, replays []*storage.RatingReplay, dryRun bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "USER\tNICKNAME\tELO\tGLICKO"); err != nil {
+		return err
+	}
+
+	changed, users := 0, 0
+	var skipped []*storage.RatingReplay
+	for _, r := range replays {
+		if r.SkippedGameId != "" {
+			skipped = append(skipped, r)
+			continue
+		}
+		users++
+		if r.OldElo == r.NewElo && r.OldGlicko == r.NewGlicko {
+			continue
+		}
+		changed++
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%d -> %d (%+d)\t%.1f -> %.1f (%+.1f)\n",
+			r.UserId, r.Nickname,
+			r.OldElo, r.NewElo, r.NewElo-r.OldElo,
+			r.OldGlicko.Rating, r.NewGlicko.Rating, r.NewGlicko.Rating-r.OldGlicko.Rating); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, r := range skipped {
+		if _, err := fmt.Fprintf(w, "skipped game %s: %s\n", r.SkippedGameId, r.SkipReason); err != nil {
+			return err
+		}
+	}
+
+	mode := "applied"
+	if dryRun {
+		mode = "dry run, nothing saved"
+	}
+	_, err := fmt.Fprintf(w, "%d of %d users changed (%s)\n", changed, users, mode)
+	return err
+}
+
+func runWebPush(args []string) error {
+	if len(args) == 0 || args[0] != "keys" {
+		return errors.New(webPushUsage)
+	}
+
+	privateKey, publicKey, err := webpush.GenerateKeys()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Printf("MARS_WEBPUSH_PRIVATE_KEY=%s\npublic key: %s\n", privateKey, publicKey)
+	return err
+}
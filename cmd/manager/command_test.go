@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestPrintRatingReplays(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := printRatingReplays(buf, []*storage.RatingReplay{
+		{
+			UserId: "u1", Nickname: "Andy",
+			OldElo: 1000, NewElo: 1012,
+			OldGlicko: storage.Glicko{Rating: 1500}, NewGlicko: storage.Glicko{Rating: 1550.25},
+		},
+		{
+			UserId: "u2", Nickname: "Belka",
+			OldElo: 1000, NewElo: 1000,
+		},
+		{
+			UserId: "u3", Nickname: "Max",
+			OldElo: 1020, NewElo: 990,
+			OldGlicko: storage.Glicko{Rating: 1600}, NewGlicko: storage.Glicko{Rating: 1450},
+		},
+		{SkippedGameId: "g1", SkipReason: "not enough players"},
+	}, true)
+	assert.NilError(t, err)
+	assert.Equal(t, buf.String(), ""+
+		"USER  NICKNAME  ELO                 GLICKO\n"+
+		"u1    Andy      1000 -> 1012 (+12)  1500.0 -> 1550.2 (+50.2)\n"+
+		"u3    Max       1020 -> 990 (-30)   1600.0 -> 1450.0 (-150.0)\n"+
+		"skipped game g1: not enough players\n"+
+		"2 of 3 users changed (dry run, nothing saved)\n")
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 )
 
 func TestNewConfig(t *testing.T) {
@@ -17,11 +19,18 @@ func TestConfig(t *testing.T) {
 	t.Setenv("MARS_GAME_URL", "https://example.com/url")
 	t.Setenv("MARS_PUBLIC_GAME_URL", "https://some.other.domain/url")
 	t.Setenv("MARS_POSTGRES_DSN", "postgres://somedb")
+	t.Setenv("MARS_ADMIN_USERS", "user1,user2")
 	t.Setenv("MARS_APN_TEAM_ID", "team id")
 	t.Setenv("MARS_APN_KEY_ID", "key id")
 	t.Setenv("MARS_APN_KEY_FILE", "key file")
 	t.Setenv("MARS_APN_BUNDLE_ID", "bundle-id")
+	t.Setenv("MARS_FCM_CREDENTIALS_FILE", "fcm file")
+	t.Setenv("MARS_WEBPUSH_SUBJECT", "mailto:admin@example.com")
+	t.Setenv("MARS_WEBPUSH_PRIVATE_KEY", "private key")
 	t.Setenv("MARS_NOTIFY_SCAN_INTERVAL", "42s")
+	t.Setenv("MARS_NOTIFY_REMINDER_SCHEDULE", "1h,6h")
+	t.Setenv("MARS_GAMES_RATING_SYSTEM", "glicko2")
+	t.Setenv("MARS_CACHE_TTL", "5s")
 
 	c, err := NewConfig()
 	assert.NilError(t, err)
@@ -29,6 +38,7 @@ func TestConfig(t *testing.T) {
 	assert.Equal(t, c.GameURL.String(), "https://example.com/url")
 	assert.Equal(t, c.PublicGameURL.String(), "https://some.other.domain/url")
 	assert.Equal(t, c.PostgresDSN, "postgres://somedb")
+	assert.DeepEqual(t, c.AdminUsers, []string{"user1", "user2"})
 	assert.Equal(t, c.APN.SandboxURL.String(), "https://api.sandbox.push.apple.com")
 	assert.Equal(t, c.APN.ProdURL.String(), "https://api.push.apple.com")
 	assert.Equal(t, c.APN.TeamId, "team id")
@@ -36,8 +46,46 @@ func TestConfig(t *testing.T) {
 	assert.Equal(t, c.APN.KeyFile, "key file")
 	assert.Equal(t, c.APN.BundleId, "bundle-id")
 	assert.Equal(t, c.APN.MaxTokenAge, 30*time.Minute)
+	assert.Equal(t, c.FCM.BaseURL.String(), "https://fcm.googleapis.com")
+	assert.Equal(t, c.FCM.CredentialsFile, "fcm file")
+	assert.Equal(t, c.WebPush.Subject, "mailto:admin@example.com")
+	assert.Equal(t, c.WebPush.PrivateKey, "private key")
+	assert.Equal(t, c.WebPush.TTL, 24*time.Hour)
 	assert.Equal(t, c.Notifications.ActivityBuffer, time.Hour)
 	assert.Equal(t, c.Notifications.ScanInterval, 42*time.Second)
 	assert.Equal(t, c.Notifications.WorkersCount, 10)
+	assert.Equal(t, c.Notifications.DispatchInterval, 10*time.Second)
+	assert.DeepEqual(t, c.Notifications.ReminderSchedule, []time.Duration{time.Hour, 6 * time.Hour})
+	assert.Equal(t, c.Notifications.NudgeInterval, time.Hour)
+	assert.Equal(t, c.Notifications.OutboxRetention, 720*time.Hour)
+	assert.Equal(t, c.Notifications.AttemptRetention, 168*time.Hour)
 	assert.Equal(t, c.Games.ScanInterval, 10*time.Minute)
+	assert.Equal(t, c.Games.StateMaxAge, 30*time.Minute)
+	assert.Equal(t, c.Games.RatingSystem, storage.RatingSystemGlicko2)
+	assert.Equal(t, c.Games.LobbyTTL, 72*time.Hour)
+	assert.Equal(t, c.Matchmaking.Interval, time.Minute)
+	assert.Equal(t, c.Matchmaking.QueueTTL, 2*time.Hour)
+	assert.Equal(t, c.MarsCache.TTL, 5*time.Second)
+	assert.Equal(t, c.MarsCache.StatsInterval, 10*time.Minute)
+}
+
+func TestConfigUnknownRatingSystem(t *testing.T) {
+	t.Setenv("MARS_GAMES_RATING_SYSTEM", "trueskill")
+
+	_, err := NewConfig()
+	assert.ErrorContains(t, err, "unknown rating system")
+}
+
+func TestConfigWebPushWithoutSubject(t *testing.T) {
+	t.Setenv("MARS_WEBPUSH_PRIVATE_KEY", "private key")
+
+	_, err := NewConfig()
+	assert.ErrorContains(t, err, "web push subject is required")
+}
+
+func TestConfigReminderScheduleNotIncreasing(t *testing.T) {
+	t.Setenv("MARS_NOTIFY_REMINDER_SCHEDULE", "12h,4h")
+
+	_, err := NewConfig()
+	assert.ErrorContains(t, err, "reminder schedule must be increasing")
 }
@@ -17,8 +17,12 @@ import (
 	"github.com/chestnut42/terraforming-mars-manager/internal/app"
 	"github.com/chestnut42/terraforming-mars-manager/internal/app/interceptor"
 	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
+	"github.com/chestnut42/terraforming-mars-manager/internal/browser"
 	"github.com/chestnut42/terraforming-mars-manager/internal/client/apn"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/fcm"
 	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/marscache"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/webpush"
 	"github.com/chestnut42/terraforming-mars-manager/internal/database"
 	"github.com/chestnut42/terraforming-mars-manager/internal/docs"
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
@@ -38,6 +42,14 @@ func main() {
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	ctx = logx.WithLogger(ctx, logger)
 
+	if len(os.Args) > 1 {
+		if err := runCommand(ctx, cfg, os.Args[1:]); err != nil {
+			logger.Error("command failed", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
 	keyData, err := os.ReadFile(cfg.APN.KeyFile)
 	checkError(err)
 
@@ -54,6 +66,10 @@ func main() {
 		PublicBaseURL: cfg.PublicGameURL.URL,
 	}, httpClient)
 	checkError(err)
+	marsCacheSvc := marscache.NewService(marscache.Config{
+		TTL:           cfg.MarsCache.TTL,
+		StatsInterval: cfg.MarsCache.StatsInterval,
+	}, marsSvc)
 	sandboxApnSvc, err := apn.NewService(apn.Config{
 		BaseURL:     cfg.APN.SandboxURL.URL,
 		Topic:       cfg.APN.BundleId,
@@ -72,32 +88,85 @@ func main() {
 		MaxTokenAge: cfg.APN.MaxTokenAge,
 	}, httpClient)
 	checkError(err)
+	var androidNotifier notifications.Notifier
+	if cfg.FCM.CredentialsFile != "" {
+		serviceAccount, err := os.ReadFile(cfg.FCM.CredentialsFile)
+		checkError(err)
+		fcmSvc, err := fcm.NewService(fcm.Config{
+			BaseURL:        cfg.FCM.BaseURL.URL,
+			ServiceAccount: serviceAccount,
+		}, httpClient)
+		checkError(err)
+		androidNotifier = fcmSvc
+	}
+	var webNotifier notifications.Notifier
+	var browserSvc *browser.Service
+	if cfg.WebPush.PrivateKey != "" {
+		webPushSvc, err := webpush.NewService(webpush.Config{
+			Subject:    cfg.WebPush.Subject,
+			PrivateKey: cfg.WebPush.PrivateKey,
+			TTL:        cfg.WebPush.TTL,
+		}, httpClient)
+		checkError(err)
+		browserSvc, err = browser.NewService(browser.Config{
+			BasePath:    "/manager/browser",
+			RegisterURL: "/manager/api/v1/web-push/subscription",
+			PublicKey:   webPushSvc.PublicKey(),
+		})
+		checkError(err)
+		webNotifier = webPushSvc
+	}
 	originProxy := httputil.NewSingleHostReverseProxy(cfg.GameURL.URL)
 
 	gameSvc := game.NewService(game.Config{
-		ScanInterval: cfg.Games.ScanInterval,
-	}, storageSvc, marsSvc)
-	appSvc := app.NewService(storageSvc, gameSvc)
+		ScanInterval:  cfg.Games.ScanInterval,
+		StateMaxAge:   cfg.Games.StateMaxAge,
+		MatchInterval: cfg.Matchmaking.Interval,
+		QueueTTL:      cfg.Matchmaking.QueueTTL,
+	}, storageSvc, marsCacheSvc)
 	authSvc, err := auth.NewService(ctx, cfg.AppleKeys)
 	checkError(err)
 
 	notifySvc := notifications.NewService(notifications.Config{
-		ActivityBuffer: cfg.Notifications.ActivityBuffer,
-		ScanInterval:   cfg.Notifications.ScanInterval,
-		WorkersCount:   cfg.Notifications.WorkersCount,
+		ActivityBuffer:   cfg.Notifications.ActivityBuffer,
+		ScanInterval:     cfg.Notifications.ScanInterval,
+		StateMaxAge:      cfg.Games.StateMaxAge,
+		WorkersCount:     cfg.Notifications.WorkersCount,
+		ReminderSchedule: cfg.Notifications.ReminderSchedule,
+		DispatchInterval: cfg.Notifications.DispatchInterval,
+		OutboxRetention:  cfg.Notifications.OutboxRetention,
+		AttemptRetention: cfg.Notifications.AttemptRetention,
+		RatingSystem:     cfg.Games.RatingSystem,
 	}, notifications.Dependencies{
 		Storage:         storageSvc,
 		Game:            gameSvc,
 		SandboxNotifier: sandboxApnSvc,
 		ProdNotifier:    prodApnSvc,
+		AndroidNotifier: androidNotifier,
+		WebNotifier:     webNotifier,
 	})
-	interceptorSvc := interceptor.NewService(originProxy, storageSvc, marsSvc, notifySvc, gameSvc)
+	appSvc := app.NewService(app.Config{
+		RatingSystem:  cfg.Games.RatingSystem,
+		NudgeInterval: cfg.Notifications.NudgeInterval,
+		LobbyTTL:      cfg.Games.LobbyTTL,
+		QueueTTL:      cfg.Matchmaking.QueueTTL,
+		AdminUsers:    cfg.AdminUsers,
+	}, storageSvc, gameSvc, notifySvc)
+	interceptorSvc := interceptor.NewService(originProxy, storageSvc, marsCacheSvc, marsCacheSvc, notifySvc, gameSvc)
 
 	grpcMux := runtime.NewServeMux()
 	err = api.RegisterUsersHandlerServer(ctx, grpcMux, appSvc)
 	checkError(err)
 	err = api.RegisterGamesHandlerServer(ctx, grpcMux, appSvc)
 	checkError(err)
+	err = api.RegisterLobbiesHandlerServer(ctx, grpcMux, appSvc)
+	checkError(err)
+	err = api.RegisterMatchmakingHandlerServer(ctx, grpcMux, appSvc)
+	checkError(err)
+	err = api.RegisterPresetsHandlerServer(ctx, grpcMux, appSvc)
+	checkError(err)
+	err = api.RegisterAdminHandlerServer(ctx, grpcMux, appSvc)
+	checkError(err)
 
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
@@ -117,18 +186,31 @@ func main() {
 		// APIs to intercept
 		root.HandleFunc("POST /player/input", interceptorSvc.PlayerInputHandler)
 
+		if browserSvc != nil {
+			browserSvc.ConfigureRouter(appRouter)
+			playerProxy := httputil.NewSingleHostReverseProxy(cfg.GameURL.URL)
+			browserSvc.InjectScript(playerProxy)
+			root.Handle("GET /player", playerProxy)
+		}
+
 		logger.Info("starting http server", slog.String("addr", cfg.Listen))
 		return httpx.ServeContext(ctx, httpx.WithRemoteAddress(root), cfg.Listen)
 	})
 	eg.Go(func() error {
 		return notifySvc.Run(ctx)
 	})
+	eg.Go(func() error {
+		return marsCacheSvc.Run(ctx)
+	})
 	eg.Go(func() error {
 		return gameSvc.ProcessFinishedGames(ctx)
 	})
 	eg.Go(func() error {
 		return gameSvc.ProcessElo(ctx)
 	})
+	eg.Go(func() error {
+		return gameSvc.ProcessMatchmaking(ctx, notifySvc)
+	})
 	eg.Go(func() error {
 		return signalx.ListenContext(ctx, syscall.SIGTERM, syscall.SIGINT)
 	})
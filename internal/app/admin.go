@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
+)
+
+const (
+	deliveryAttemptsLimit    = 50
+	deliveryAttemptsMaxLimit = 500
+)
+
+func (s *Service) GetDeliveryAttempts(ctx context.Context, req *api.GetDeliveryAttempts_Request) (*api.GetDeliveryAttempts_Response, error) {
+	if err := s.checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = deliveryAttemptsLimit
+	}
+	if limit > deliveryAttemptsMaxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit is too big: %d", limit)
+	}
+
+	user, err := s.storage.GetUserByNickname(ctx, req.GetNickname())
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	attempts, err := s.storage.GetDeliveryAttempts(ctx, user.UserId, limit)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.GetDeliveryAttempts_Response{Attempts: []*api.DeliveryAttempt{}}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*api.DeliveryAttempt, len(attempts))
+	for i, a := range attempts {
+		res[i] = deliveryAttemptToAPI(a)
+	}
+	return &api.GetDeliveryAttempts_Response{Attempts: res}, nil
+}
+
+func (s *Service) checkAdmin(ctx context.Context) error {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not found")
+	}
+	if !slices.Contains(s.cfg.AdminUsers, user.Id) {
+		return status.Error(codes.PermissionDenied, "user is not an admin")
+	}
+	return nil
+}
@@ -1,10 +1,19 @@
 package app
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
 	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
 )
@@ -33,6 +42,82 @@ var fromAPIColors = map[api.PlayerColor]storage.Color{
 	api.PlayerColor_BRONZE: storage.ColorBronze,
 }
 
+var toAPIRatingSystems = map[storage.RatingSystem]api.RatingSystem{
+	storage.RatingSystemElo:     api.RatingSystem_RATING_SYSTEM_ELO,
+	storage.RatingSystemGlicko2: api.RatingSystem_RATING_SYSTEM_GLICKO2,
+}
+
+var fromAPIDevicePlatforms = map[api.DevicePlatform]storage.DevicePlatform{
+	api.DevicePlatform_DEVICE_PLATFORM_IOS:     storage.DevicePlatformIOS,
+	api.DevicePlatform_DEVICE_PLATFORM_ANDROID: storage.DevicePlatformAndroid,
+	api.DevicePlatform_DEVICE_PLATFORM_WEB:     storage.DevicePlatformWeb,
+}
+
+var toAPIDevicePlatforms = map[storage.DevicePlatform]api.DevicePlatform{
+	storage.DevicePlatformIOS:     api.DevicePlatform_DEVICE_PLATFORM_IOS,
+	storage.DevicePlatformAndroid: api.DevicePlatform_DEVICE_PLATFORM_ANDROID,
+	storage.DevicePlatformWeb:     api.DevicePlatform_DEVICE_PLATFORM_WEB,
+}
+
+var fromAPIExpansions = map[api.Expansion]game.Expansion{
+	api.Expansion_EXPANSION_CORPORATE_ERA: game.ExpansionCorporateEra,
+	api.Expansion_EXPANSION_PRELUDE:       game.ExpansionPrelude,
+	api.Expansion_EXPANSION_PRELUDE2:      game.ExpansionPrelude2,
+	api.Expansion_EXPANSION_VENUS_NEXT:    game.ExpansionVenusNext,
+	api.Expansion_EXPANSION_COLONIES:      game.ExpansionColonies,
+	api.Expansion_EXPANSION_TURMOIL:       game.ExpansionTurmoil,
+	api.Expansion_EXPANSION_PROMO:         game.ExpansionPromo,
+	api.Expansion_EXPANSION_COMMUNITY:     game.ExpansionCommunity,
+	api.Expansion_EXPANSION_ARES:          game.ExpansionAres,
+	api.Expansion_EXPANSION_MOON:          game.ExpansionMoon,
+	api.Expansion_EXPANSION_PATHFINDERS:   game.ExpansionPathfinders,
+	api.Expansion_EXPANSION_CEOS:          game.ExpansionCeos,
+	api.Expansion_EXPANSION_UNDERWORLD:    game.ExpansionUnderworld,
+}
+
+var toAPIBoards = map[mars.Board]api.Board{
+	mars.BoardTharsis:          api.Board_BOARD_THARSIS,
+	mars.BoardHellas:           api.Board_BOARD_HELLAS,
+	mars.BoardElysium:          api.Board_BOARD_ELYSIUM,
+	mars.BoardAmazonis:         api.Board_BOARD_AMAZONIS,
+	mars.BoardTerraCimmeria:    api.Board_BOARD_TERRA_CIMMERIA,
+	mars.BoardVastitasBorealis: api.Board_BOARD_VASTITAS_BOREALIS,
+}
+
+var fromAPIBoards = map[api.Board]mars.Board{
+	api.Board_BOARD_THARSIS:           mars.BoardTharsis,
+	api.Board_BOARD_HELLAS:            mars.BoardHellas,
+	api.Board_BOARD_ELYSIUM:           mars.BoardElysium,
+	api.Board_BOARD_AMAZONIS:          mars.BoardAmazonis,
+	api.Board_BOARD_TERRA_CIMMERIA:    mars.BoardTerraCimmeria,
+	api.Board_BOARD_VASTITAS_BOREALIS: mars.BoardVastitasBorealis,
+}
+
+var fromAPIRandomMA = map[api.GameOptions_RandomMilestonesAwards]mars.RandomMA{
+	api.GameOptions_RANDOM_MILESTONES_AWARDS_NONE:    mars.RandomMANone,
+	api.GameOptions_RANDOM_MILESTONES_AWARDS_LIMITED: mars.RandomMALimited,
+	api.GameOptions_RANDOM_MILESTONES_AWARDS_FULL:    mars.RandomMAFull,
+}
+
+var toAPIRandomMA = map[mars.RandomMA]api.GameOptions_RandomMilestonesAwards{
+	mars.RandomMANone:    api.GameOptions_RANDOM_MILESTONES_AWARDS_NONE,
+	mars.RandomMALimited: api.GameOptions_RANDOM_MILESTONES_AWARDS_LIMITED,
+	mars.RandomMAFull:    api.GameOptions_RANDOM_MILESTONES_AWARDS_FULL,
+}
+
+var toAPILobbyStatuses = map[storage.LobbyStatus]api.LobbyStatus{
+	storage.LobbyStatusOpen:     api.LobbyStatus_LOBBY_STATUS_OPEN,
+	storage.LobbyStatusStarting: api.LobbyStatus_LOBBY_STATUS_STARTING,
+	storage.LobbyStatusStarted:  api.LobbyStatus_LOBBY_STATUS_STARTED,
+	storage.LobbyStatusExpired:  api.LobbyStatus_LOBBY_STATUS_EXPIRED,
+}
+
+var toAPIInvitationStatuses = map[storage.InvitationStatus]api.InvitationStatus{
+	storage.InvitationStatusPending:  api.InvitationStatus_INVITATION_STATUS_PENDING,
+	storage.InvitationStatusAccepted: api.InvitationStatus_INVITATION_STATUS_ACCEPTED,
+	storage.InvitationStatusDeclined: api.InvitationStatus_INVITATION_STATUS_DECLINED,
+}
+
 func userToAPI(user *storage.User) *api.User {
 	return &api.User{
 		Id:        user.UserId,
@@ -40,7 +125,72 @@ func userToAPI(user *storage.User) *api.User {
 		Color:     toAPIColors[user.Color],
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		Elo:       int32(user.Elo),
+		Glicko:    glickoToAPI(user.Glicko),
+	}
+}
+
+func glickoToAPI(g storage.Glicko) *api.Glicko {
+	return &api.Glicko{
+		Rating:     g.Rating,
+		Deviation:  g.Deviation,
+		Volatility: g.Volatility,
+	}
+}
+
+func ratingChangeToAPI(h *storage.RatingHistory) *api.RatingChange {
+	return &api.RatingChange{
+		GameId:    h.GameId,
+		ChangedAt: timestamppb.New(h.CreatedAt),
+		OldElo:    int32(h.OldElo),
+		NewElo:    int32(h.NewElo),
+		OldGlicko: glickoToAPI(h.OldGlicko),
+		NewGlicko: glickoToAPI(h.NewGlicko),
+	}
+}
+
+// deliveryTokenPrefix is enough bytes of a device token to tell devices apart
+const deliveryTokenPrefix = 8
+
+func deliveryAttemptToAPI(a *storage.DeliveryAttempt) *api.DeliveryAttempt {
+	token := a.Token
+	if len(token) > deliveryTokenPrefix {
+		token = token[:deliveryTokenPrefix]
+	}
+	return &api.DeliveryAttempt{
+		OutboxId:    a.OutboxId,
+		Platform:    toAPIDevicePlatforms[a.Platform],
+		Environment: string(a.Environment),
+		Token:       hex.EncodeToString(token),
+		PayloadHash: a.PayloadHash,
+		MessageId:   a.MessageId,
+		Status:      int32(a.Status),
+		Reason:      a.Reason,
+		Error:       a.Error,
+		Latency:     durationpb.New(a.Latency),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
+
+// pageToken encodes a keyset pagination cursor made of a timestamp and a game id
+func pageToken(t time.Time, gameId string) string {
+	raw := strconv.FormatInt(t.UnixNano(), 10) + ":" + gameId
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func fromPageToken(token string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid page token: %w", err)
+	}
+	ts, gameId, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return time.Time{}, "", fmt.Errorf("invalid page token: %s", token)
 	}
+	nanos, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid page token: %w", err)
+	}
+	return time.Unix(0, nanos), gameId, nil
 }
 
 func fromAPIColor(color api.PlayerColor) (storage.Color, error) {
@@ -50,3 +200,418 @@ func fromAPIColor(color api.PlayerColor) (storage.Color, error) {
 	}
 	return c, nil
 }
+
+func notificationSettingsToAPI(settings storage.NotificationSettings) *api.NotificationSettings {
+	res := &api.NotificationSettings{
+		TimeZone:        settings.TimeZone,
+		BadgeOnly:       settings.BadgeOnly,
+		MinDelay:        durationpb.New(settings.MinDelay),
+		MuteGameResults: settings.MuteGameResults,
+	}
+	for _, p := range settings.DisabledPlatforms {
+		res.DisabledPlatforms = append(res.DisabledPlatforms, toAPIDevicePlatforms[p])
+	}
+	if settings.QuietHours != nil {
+		res.QuietHours = &api.QuietHours{
+			StartMinute: int32(settings.QuietHours.StartMinute),
+			EndMinute:   int32(settings.QuietHours.EndMinute),
+		}
+	}
+	return res
+}
+
+func fromAPINotificationSettings(settings *api.NotificationSettings) (storage.NotificationSettings, error) {
+	res := storage.NotificationSettings{
+		TimeZone:        settings.GetTimeZone(),
+		BadgeOnly:       settings.GetBadgeOnly(),
+		MinDelay:        settings.GetMinDelay().AsDuration(),
+		MuteGameResults: settings.GetMuteGameResults(),
+	}
+	for _, p := range settings.GetDisabledPlatforms() {
+		platform, ok := fromAPIDevicePlatforms[p]
+		if !ok {
+			return storage.NotificationSettings{}, fmt.Errorf("unknown platform: %v", p)
+		}
+		res.DisabledPlatforms = append(res.DisabledPlatforms, platform)
+	}
+	if _, err := time.LoadLocation(res.TimeZone); err != nil {
+		return storage.NotificationSettings{}, fmt.Errorf("unknown time zone: %s", res.TimeZone)
+	}
+	if res.MinDelay < 0 || res.MinDelay > maxNotificationDelay {
+		return storage.NotificationSettings{}, fmt.Errorf("min delay must be between 0 and %s", maxNotificationDelay)
+	}
+	if qh := settings.GetQuietHours(); qh != nil {
+		if !validDayMinute(qh.GetStartMinute()) || !validDayMinute(qh.GetEndMinute()) {
+			return storage.NotificationSettings{}, fmt.Errorf("quiet hours must be within a day")
+		}
+		res.QuietHours = &storage.QuietHours{
+			StartMinute: int(qh.GetStartMinute()),
+			EndMinute:   int(qh.GetEndMinute()),
+		}
+	}
+	return res, nil
+}
+
+func validDayMinute(m int32) bool {
+	return m >= 0 && m < 24*60
+}
+
+func gameSettingsToAPI(settings *storage.GameSettings) *api.GameSettings {
+	if settings == nil {
+		return nil
+	}
+	return &api.GameSettings{
+		Board:         toAPIBoards[mars.Board(settings.Board)],
+		CorporateEra:  settings.CorporateEra,
+		Prelude:       settings.Prelude,
+		VenusNext:     settings.VenusNext,
+		SolarPhase:    settings.SolarPhase,
+		Colonies:      settings.Colonies,
+		Seed:          settings.Seed,
+		FirstPlayerId: settings.FirstUserId,
+	}
+}
+
+// fromAPIGameSettings keeps the board empty if any board will do
+func fromAPIGameSettings(settings *api.GameSettings) (storage.GameSettings, error) {
+	board := mars.Board("")
+	if settings.GetBoard() != api.Board_BOARD_UNKNOWN {
+		b, ok := fromAPIBoards[settings.GetBoard()]
+		if !ok {
+			return storage.GameSettings{}, fmt.Errorf("unknown board: %s", settings.GetBoard())
+		}
+		board = b
+	}
+	return storage.GameSettings{
+		Board:        string(board),
+		CorporateEra: settings.GetCorporateEra(),
+		Prelude:      settings.GetPrelude(),
+		VenusNext:    settings.GetVenusNext(),
+		SolarPhase:   settings.GetSolarPhase(),
+		Colonies:     settings.GetColonies(),
+	}, nil
+}
+
+// gameOptionsToAPI returns nil for games created without the full options
+func gameOptionsToAPI(settings *storage.GameSettings) *api.GameOptions {
+	if settings == nil || settings.Advanced == nil {
+		return nil
+	}
+	a := settings.Advanced
+	var expansions []api.Expansion
+	for _, e := range []struct {
+		enabled   bool
+		expansion api.Expansion
+	}{
+		{settings.CorporateEra, api.Expansion_EXPANSION_CORPORATE_ERA},
+		{settings.Prelude, api.Expansion_EXPANSION_PRELUDE},
+		{a.Prelude2, api.Expansion_EXPANSION_PRELUDE2},
+		{settings.VenusNext, api.Expansion_EXPANSION_VENUS_NEXT},
+		{settings.Colonies, api.Expansion_EXPANSION_COLONIES},
+		{a.Turmoil, api.Expansion_EXPANSION_TURMOIL},
+		{a.Promo, api.Expansion_EXPANSION_PROMO},
+		{a.Community, api.Expansion_EXPANSION_COMMUNITY},
+		{a.Ares, api.Expansion_EXPANSION_ARES},
+		{a.Moon, api.Expansion_EXPANSION_MOON},
+		{a.Pathfinders, api.Expansion_EXPANSION_PATHFINDERS},
+		{a.Ceos, api.Expansion_EXPANSION_CEOS},
+		{a.Underworld, api.Expansion_EXPANSION_UNDERWORLD},
+	} {
+		if e.enabled {
+			expansions = append(expansions, e.expansion)
+		}
+	}
+	return &api.GameOptions{
+		Board:      toAPIBoards[mars.Board(settings.Board)],
+		Expansions: expansions,
+		Configuration: &api.GameOptions_Configuration{
+			Undo:               a.Undo,
+			ShowTimers:         a.ShowTimers,
+			FastMode:           a.FastMode,
+			ShowOtherPlayersVp: a.ShowOtherPlayersVP,
+		},
+		Variants: &api.GameOptions_Variants{
+			Draft:                        a.Draft,
+			InitialDraft:                 a.InitialDraft,
+			PreludeDraft:                 a.PreludeDraft,
+			SolarPhase:                   settings.SolarPhase,
+			ShuffleMap:                   a.ShuffleMap,
+			RandomMilestonesAwards:       toAPIRandomMA[mars.RandomMA(a.RandomMA)],
+			VenusMilestonesAwards:        a.IncludeVenusMA,
+			FanMilestonesAwards:          a.IncludeFanMA,
+			RemoveNegativeGlobalEvents:   a.RemoveNegativeGlobalEvents,
+			AltVenusBoard:                a.AltVenusBoard,
+			RequiresVenusTrackCompletion: a.RequiresVenusTrackCompletion,
+			RequiresMoonTrackCompletion:  a.RequiresMoonTrackCompletion,
+			TwoCorps:                     a.TwoCorpsVariant,
+			StartingCorporations:         int32(a.StartingCorporations),
+			StartingCeos:                 int32(a.StartingCeos),
+		},
+		BannedCards:   a.BannedCards,
+		IncludedCards: a.IncludedCards,
+	}
+}
+
+// fromStoredAdvanced restores the options a game was created with
+func fromStoredAdvanced(a *storage.AdvancedSettings) *mars.AdvancedSettings {
+	if a == nil {
+		return nil
+	}
+	return &mars.AdvancedSettings{
+		Prelude2:                     a.Prelude2,
+		Turmoil:                      a.Turmoil,
+		Promo:                        a.Promo,
+		Community:                    a.Community,
+		Ares:                         a.Ares,
+		Moon:                         a.Moon,
+		Pathfinders:                  a.Pathfinders,
+		Ceos:                         a.Ceos,
+		Underworld:                   a.Underworld,
+		Undo:                         a.Undo,
+		ShowTimers:                   a.ShowTimers,
+		FastMode:                     a.FastMode,
+		ShowOtherPlayersVP:           a.ShowOtherPlayersVP,
+		Draft:                        a.Draft,
+		InitialDraft:                 a.InitialDraft,
+		PreludeDraft:                 a.PreludeDraft,
+		StartingCorporations:         a.StartingCorporations,
+		StartingCeos:                 a.StartingCeos,
+		ShuffleMap:                   a.ShuffleMap,
+		RandomMA:                     mars.RandomMA(a.RandomMA),
+		IncludeVenusMA:               a.IncludeVenusMA,
+		IncludeFanMA:                 a.IncludeFanMA,
+		RemoveNegativeGlobalEvents:   a.RemoveNegativeGlobalEvents,
+		AltVenusBoard:                a.AltVenusBoard,
+		RequiresVenusTrackCompletion: a.RequiresVenusTrackCompletion,
+		RequiresMoonTrackCompletion:  a.RequiresMoonTrackCompletion,
+		TwoCorpsVariant:              a.TwoCorpsVariant,
+		BannedCards:                  a.BannedCards,
+		IncludedCards:                a.IncludedCards,
+	}
+}
+
+// fromAPIGameOptions picks a random board if it is not set, the options are not validated
+func fromAPIGameOptions(options *api.GameOptions) (mars.GameSettings, error) {
+	stored, err := storedGameOptions(options)
+	if err != nil {
+		return mars.GameSettings{}, err
+	}
+	settings := mars.GameSettings{
+		Board:        mars.Board(stored.Board),
+		CorporateEra: stored.CorporateEra,
+		Prelude:      stored.Prelude,
+		VenusNext:    stored.VenusNext,
+		SolarPhase:   stored.SolarPhase,
+		Colonies:     stored.Colonies,
+		FirstPlayer:  options.GetFirstPlayer(),
+		Advanced:     fromStoredAdvanced(stored.Advanced),
+	}
+	if settings.Board == "" {
+		settings.Board = mars.AllBoards[rand.IntN(len(mars.AllBoards))]
+	}
+	return settings, nil
+}
+
+// storedGameOptions keeps the board empty if it is not set, the first player is not stored
+func storedGameOptions(options *api.GameOptions) (storage.GameSettings, error) {
+	board := mars.Board("")
+	if options.GetBoard() != api.Board_BOARD_UNKNOWN {
+		b, ok := fromAPIBoards[options.GetBoard()]
+		if !ok {
+			return storage.GameSettings{}, fmt.Errorf("unknown board: %s", options.GetBoard())
+		}
+		board = b
+	}
+
+	variants := options.GetVariants()
+	randomMA, ok := fromAPIRandomMA[variants.GetRandomMilestonesAwards()]
+	if !ok {
+		return storage.GameSettings{}, fmt.Errorf("unknown random milestones and awards: %s", variants.GetRandomMilestonesAwards())
+	}
+	advanced := &storage.AdvancedSettings{
+		Undo:                         options.GetConfiguration().GetUndo(),
+		ShowTimers:                   options.GetConfiguration().GetShowTimers(),
+		FastMode:                     options.GetConfiguration().GetFastMode(),
+		ShowOtherPlayersVP:           options.GetConfiguration().GetShowOtherPlayersVp(),
+		Draft:                        variants.GetDraft(),
+		InitialDraft:                 variants.GetInitialDraft(),
+		PreludeDraft:                 variants.GetPreludeDraft(),
+		StartingCorporations:         int(variants.GetStartingCorporations()),
+		StartingCeos:                 int(variants.GetStartingCeos()),
+		ShuffleMap:                   variants.GetShuffleMap(),
+		RandomMA:                     string(randomMA),
+		IncludeVenusMA:               variants.GetVenusMilestonesAwards(),
+		IncludeFanMA:                 variants.GetFanMilestonesAwards(),
+		RemoveNegativeGlobalEvents:   variants.GetRemoveNegativeGlobalEvents(),
+		AltVenusBoard:                variants.GetAltVenusBoard(),
+		RequiresVenusTrackCompletion: variants.GetRequiresVenusTrackCompletion(),
+		RequiresMoonTrackCompletion:  variants.GetRequiresMoonTrackCompletion(),
+		TwoCorpsVariant:              variants.GetTwoCorps(),
+		BannedCards:                  options.GetBannedCards(),
+		IncludedCards:                options.GetIncludedCards(),
+	}
+	settings := storage.GameSettings{
+		Board:      string(board),
+		SolarPhase: variants.GetSolarPhase(),
+		Advanced:   advanced,
+	}
+	for _, e := range options.GetExpansions() {
+		switch e {
+		case api.Expansion_EXPANSION_CORPORATE_ERA:
+			settings.CorporateEra = true
+		case api.Expansion_EXPANSION_PRELUDE:
+			settings.Prelude = true
+		case api.Expansion_EXPANSION_PRELUDE2:
+			advanced.Prelude2 = true
+		case api.Expansion_EXPANSION_VENUS_NEXT:
+			settings.VenusNext = true
+		case api.Expansion_EXPANSION_COLONIES:
+			settings.Colonies = true
+		case api.Expansion_EXPANSION_TURMOIL:
+			advanced.Turmoil = true
+		case api.Expansion_EXPANSION_PROMO:
+			advanced.Promo = true
+		case api.Expansion_EXPANSION_COMMUNITY:
+			advanced.Community = true
+		case api.Expansion_EXPANSION_ARES:
+			advanced.Ares = true
+		case api.Expansion_EXPANSION_MOON:
+			advanced.Moon = true
+		case api.Expansion_EXPANSION_PATHFINDERS:
+			advanced.Pathfinders = true
+		case api.Expansion_EXPANSION_CEOS:
+			advanced.Ceos = true
+		case api.Expansion_EXPANSION_UNDERWORLD:
+			advanced.Underworld = true
+		default:
+			return storage.GameSettings{}, fmt.Errorf("unknown expansion: %s", e)
+		}
+	}
+	return settings, nil
+}
+
+func fromAPIMatchmakingPreferences(preferences *api.MatchmakingPreferences) (storage.MatchmakingPreferences, error) {
+	if len(preferences.GetPlayersCounts()) == 0 {
+		return storage.MatchmakingPreferences{}, fmt.Errorf("players counts are empty")
+	}
+	counts := make([]int, len(preferences.GetPlayersCounts()))
+	for i, c := range preferences.GetPlayersCounts() {
+		if c < 2 || c > maxPlayers {
+			return storage.MatchmakingPreferences{}, fmt.Errorf("invalid players count: %d", c)
+		}
+		counts[i] = int(c)
+	}
+	if preferences.GetRatingRange() < 0 {
+		return storage.MatchmakingPreferences{}, fmt.Errorf("invalid rating range: %d", preferences.GetRatingRange())
+	}
+	settings, err := fromAPIGameSettings(preferences.GetSettings())
+	if err != nil {
+		return storage.MatchmakingPreferences{}, err
+	}
+	return storage.MatchmakingPreferences{
+		PlayersCounts: counts,
+		Settings:      settings,
+		RatingRange:   int64(preferences.GetRatingRange()),
+	}, nil
+}
+
+func matchmakingPreferencesToAPI(preferences storage.MatchmakingPreferences) *api.MatchmakingPreferences {
+	counts := make([]int32, len(preferences.PlayersCounts))
+	for i, c := range preferences.PlayersCounts {
+		counts[i] = int32(c)
+	}
+	return &api.MatchmakingPreferences{
+		PlayersCounts: counts,
+		Settings:      gameSettingsToAPI(&preferences.Settings),
+		RatingRange:   int32(preferences.RatingRange),
+	}
+}
+
+func lobbyToAPI(lobby *storage.Lobby) *api.Lobby {
+	members := make([]*api.LobbyMember, len(lobby.Members))
+	for i, m := range lobby.Members {
+		members[i] = &api.LobbyMember{
+			Nickname: m.Nickname,
+			Color:    toAPIColors[m.Color],
+			Status:   toAPIInvitationStatuses[m.Status],
+			Host:     m.UserId == lobby.HostId,
+		}
+	}
+	return &api.Lobby{
+		Id:        lobby.LobbyId,
+		Status:    toAPILobbyStatuses[lobby.Status],
+		Settings:  gameSettingsToAPI(&lobby.Settings),
+		Members:   members,
+		CreatedAt: timestamppb.New(lobby.CreatedAt),
+		ExpiresAt: timestamppb.New(lobby.ExpiresAt),
+		GameId:    lobby.GameId,
+	}
+}
+
+func presetToAPI(preset *storage.Preset) *api.Preset {
+	res := &api.Preset{
+		Id:         preset.PresetId,
+		Name:       preset.Name,
+		SharedWith: make([]string, 0, len(preset.Users)),
+		Options:    gameOptionsToAPI(&preset.Settings),
+		CreatedAt:  timestamppb.New(preset.CreatedAt),
+		UpdatedAt:  timestamppb.New(preset.UpdatedAt),
+	}
+	for _, u := range preset.Users {
+		if u.UserId == preset.OwnerId {
+			res.Owner = u.Nickname
+		} else {
+			res.SharedWith = append(res.SharedWith, u.Nickname)
+		}
+	}
+	return res
+}
+
+func gameDetailsToAPI(details *game.GameDetails) *api.GameDetails {
+	players := make([]*api.GamePlayer, len(details.Players))
+	for i, p := range details.Players {
+		players[i] = &api.GamePlayer{
+			UserId:          p.UserId,
+			Nickname:        p.Nickname,
+			Color:           toAPIColors[p.Color],
+			Place:           int32(p.Place),
+			MegaCredits:     int32(p.MegaCredits),
+			TerraformRating: int32(p.TerraformRating),
+			VictoryPoints: &api.VictoryPoints{
+				TerraformRating: int32(p.VictoryPoints.TerraformRating),
+				Milestones:      int32(p.VictoryPoints.Milestones),
+				Awards:          int32(p.VictoryPoints.Awards),
+				Greenery:        int32(p.VictoryPoints.Greenery),
+				City:            int32(p.VictoryPoints.City),
+				Cards:           int32(p.VictoryPoints.Cards),
+				Moon:            int32(p.VictoryPoints.Moon),
+				PlanetaryTracks: int32(p.VictoryPoints.PlanetaryTracks),
+				EscapeVelocity:  int32(p.VictoryPoints.EscapeVelocity),
+				Total:           int32(p.VictoryPoints.Total),
+			},
+		}
+		if p.EloChange != nil {
+			players[i].EloChange = &api.EloChange{
+				OldElo: int32(p.EloChange.OldElo),
+				NewElo: int32(p.EloChange.NewElo),
+			}
+		}
+	}
+
+	var finishedAt *timestamppb.Timestamp
+	if details.FinishedAt != nil {
+		finishedAt = timestamppb.New(*details.FinishedAt)
+	}
+	return &api.GameDetails{
+		Id:         details.GameId,
+		CreatedAt:  timestamppb.New(details.CreatedAt),
+		ExpiresAt:  timestamppb.New(details.ExpiresAt),
+		FinishedAt: finishedAt,
+		Settings:   gameSettingsToAPI(details.Settings),
+		Generation: int32(details.Generation),
+		Players:    players,
+		SeriesId:   details.SeriesId,
+		RematchOf:  details.RematchOf,
+		Options:    gameOptionsToAPI(details.Settings),
+	}
+}
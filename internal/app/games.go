@@ -3,7 +3,10 @@ package app
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"math/rand/v2"
+	"slices"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,23 +14,47 @@ import (
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
 	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
 	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
 )
 
+const (
+	maxPlayers = 5
+
+	gameHistoryPageSize    = 20
+	gameHistoryMaxPageSize = 100
+
+	// rematchClaimTimeout limits the creation of a rematch, the rematch can be claimed again after it
+	rematchClaimTimeout = 5 * time.Minute
+)
+
 func (s *Service) CreateGame(ctx context.Context, req *api.CreateGame_Request) (*api.CreateGame_Response, error) {
 	users, err := s.getPlayers(ctx, req.GetPlayers())
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.game.CreateGame(ctx, users, mars.GameSettings{
+	settings := mars.GameSettings{
 		Board:        mars.BoardTharsis,
 		CorporateEra: true,
 		Prelude:      true,
 		VenusNext:    true,
 		SolarPhase:   false,
-	}); err != nil {
+	}
+	if req.GetPresetId() != "" {
+		options, err := s.getPresetOptions(ctx, req.GetPresetId())
+		if err != nil {
+			return nil, err
+		}
+		settings, err = gameSettingsFromOptions(options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.createGame(ctx, users, settings); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &api.CreateGame_Response{}, nil
@@ -39,7 +66,7 @@ func (s *Service) CreateGameV2(ctx context.Context, req *api.CreateGameV2_Reques
 		return nil, err
 	}
 
-	if err := s.game.CreateGame(ctx, users, mars.GameSettings{
+	if _, err := s.createGame(ctx, users, mars.GameSettings{
 		Board:        boardFromAPIV2(req.GetBoard()),
 		CorporateEra: req.GetCorporateEra(),
 		Prelude:      req.GetPrelude(),
@@ -52,6 +79,66 @@ func (s *Service) CreateGameV2(ctx context.Context, req *api.CreateGameV2_Reques
 	return &api.CreateGameV2_Response{}, nil
 }
 
+func (s *Service) CreateGameV3(ctx context.Context, req *api.CreateGameV3_Request) (*api.CreateGameV3_Response, error) {
+	users, err := s.getPlayers(ctx, req.GetPlayers())
+	if err != nil {
+		return nil, err
+	}
+
+	options := req.GetOptions()
+	if req.GetPresetId() != "" {
+		if req.GetOptions() != nil {
+			return nil, status.Error(codes.InvalidArgument, "options can't be set with a preset")
+		}
+		options, err = s.getPresetOptions(ctx, req.GetPresetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	settings, err := gameSettingsFromOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	if settings.FirstPlayer != "" &&
+		!slices.ContainsFunc(users, func(u *storage.User) bool { return u.Nickname == settings.FirstPlayer }) {
+		return nil, status.Error(codes.InvalidArgument, "first player is not in the game")
+	}
+
+	gameId, err := s.createGame(ctx, users, settings)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	details, err := s.game.GetGame(ctx, gameId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.CreateGameV3_Response{Game: gameDetailsToAPI(details)}, nil
+}
+
+// createGame creates the game and makes apps of the players refresh their game lists
+func (s *Service) createGame(ctx context.Context, users []*storage.User, settings mars.GameSettings) (string, error) {
+	gameId, err := s.game.CreateGame(ctx, users, settings)
+	if err != nil {
+		return "", err
+	}
+	for _, u := range users {
+		s.notifier.RefreshUser(ctx, u.UserId)
+	}
+	return gameId, nil
+}
+
+// gameSettingsFromOptions checks the options against the Mars server rules
+func gameSettingsFromOptions(options *api.GameOptions) (mars.GameSettings, error) {
+	settings, err := fromAPIGameOptions(options)
+	if err != nil {
+		return mars.GameSettings{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := settings.Validate(); err != nil {
+		return mars.GameSettings{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return settings, nil
+}
+
 func (s *Service) getPlayers(ctx context.Context, players []string) ([]*storage.User, error) {
 	thisUser, ok := auth.UserFromContext(ctx)
 	if !ok {
@@ -88,7 +175,7 @@ func (s *Service) getPlayers(ctx context.Context, players []string) ([]*storage.
 		}
 		users = append(users, u)
 	}
-	if len(users) > 5 {
+	if len(users) > maxPlayers {
 		return nil, status.Errorf(codes.InvalidArgument, "too many players: %d", len(users))
 	}
 	return users, nil
@@ -119,17 +206,236 @@ func (s *Service) GetGames(ctx context.Context, _ *api.GetGames_Request) (*api.G
 		}
 
 		apiGames[i] = &api.Game{
+			Id:           g.GameId,
 			PlayUrl:      g.PlayURL,
 			CreatedAt:    timestamppb.New(g.CreatedAt),
 			ExpiresAt:    timestamppb.New(g.ExpiresAt),
 			PlayersCount: int32(g.PlayersCount),
 			AwaitsInput:  g.AwaitsInput,
 			Status:       st,
+			Settings:     gameSettingsToAPI(g.Settings),
 		}
 	}
 	return &api.GetGames_Response{Games: apiGames}, nil
 }
 
+func (s *Service) GetGame(ctx context.Context, req *api.GetGame_Request) (*api.GetGame_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	details, err := s.game.GetGame(ctx, req.GetGameId())
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isParticipant := false
+	for _, p := range details.Players {
+		if p.UserId == thisUser.Id {
+			isParticipant = true
+		}
+	}
+	if !isParticipant {
+		return nil, status.Error(codes.NotFound, "game not found")
+	}
+	return &api.GetGame_Response{Game: gameDetailsToAPI(details)}, nil
+}
+
+func (s *Service) GetGameHistory(ctx context.Context, req *api.GetGameHistory_Request) (*api.GetGameHistory_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = gameHistoryPageSize
+	}
+	if pageSize > gameHistoryMaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page size is too big: %d", pageSize)
+	}
+
+	var before *storage.GameHistoryCursor
+	if req.GetPageToken() != "" {
+		finishedAt, gameId, err := fromPageToken(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		before = &storage.GameHistoryCursor{FinishedAt: finishedAt, GameId: gameId}
+	}
+
+	// Request one extra game to know whether there is a next page
+	games, err := s.game.GetGameHistory(ctx, storage.GetFinishedGames{
+		UserId: thisUser.Id,
+		Before: before,
+		Limit:  pageSize + 1,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.GetGameHistory_Response{Games: []*api.GameDetails{}}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextPageToken := ""
+	if len(games) > pageSize {
+		games = games[:pageSize]
+		last := games[len(games)-1]
+		nextPageToken = pageToken(*last.FinishedAt, last.GameId)
+	}
+
+	apiGames := make([]*api.GameDetails, len(games))
+	for i, g := range games {
+		apiGames[i] = gameDetailsToAPI(g)
+	}
+	return &api.GetGameHistory_Response{
+		Games:         apiGames,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) Rematch(ctx context.Context, req *api.Rematch_Request) (*api.Rematch_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	details, err := s.game.GetGame(ctx, req.GetGameId())
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "game not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !slices.ContainsFunc(details.Players, func(p *game.GameDetailsPlayer) bool { return p.UserId == thisUser.Id }) {
+		return nil, status.Error(codes.NotFound, "game not found")
+	}
+	if details.FinishedAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "game has not finished")
+	}
+	if details.Settings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "game settings are unknown")
+	}
+
+	rematchId, err := s.storage.GetRematchId(ctx, details.GameId)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if rematchId == "" {
+		rematchId, err = s.createRematch(ctx, details, req.GetReshuffleBoard(), req.GetReshuffleFirstPlayer())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rematch, err := s.game.GetGame(ctx, rematchId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.Rematch_Response{Game: gameDetailsToAPI(rematch)}, nil
+}
+
+// createRematch reserves the rematch before the game is created, so that players asking for
+// the rematch at the same time get the same game
+func (s *Service) createRematch(ctx context.Context, details *game.GameDetails, reshuffleBoard bool,
+	reshuffleFirstPlayer bool) (string, error) {
+	claimedAt, err := s.storage.ClaimRematch(ctx, details.GameId, rematchClaimTimeout)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			return "", status.Error(codes.Internal, err.Error())
+		}
+		rematchId, err := s.storage.GetRematchId(ctx, details.GameId)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return "", status.Error(codes.Aborted, "rematch is being created")
+			}
+			return "", status.Error(codes.Internal, err.Error())
+		}
+		return rematchId, nil
+	}
+
+	users := make([]*storage.User, len(details.Players))
+	for i, p := range details.Players {
+		users[i] = &storage.User{UserId: p.UserId, Nickname: p.Nickname, Color: p.Color}
+	}
+	createCtx, cancel := context.WithTimeout(ctx, rematchClaimTimeout)
+	defer cancel()
+	rematchId, err := s.createGame(createCtx, users, rematchSettings(details, reshuffleBoard, reshuffleFirstPlayer))
+	if err != nil {
+		if err := s.storage.ReleaseRematch(ctx, details.GameId, claimedAt); err != nil {
+			logx.Logger(ctx).Error("failed to release rematch", slog.String("id", details.GameId), slog.Any("error", err))
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	if err := s.storage.UpdateGameRematchOf(ctx, rematchId, details.GameId); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			// The claim went stale and was taken over, the other rematch wins
+			winnerId, err := s.storage.GetRematchId(ctx, details.GameId)
+			if err != nil {
+				return "", status.Error(codes.Internal, err.Error())
+			}
+			return winnerId, nil
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return rematchId, nil
+}
+
+func (s *Service) Nudge(ctx context.Context, req *api.Nudge_Request) (*api.Nudge_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	games, err := s.game.GetUserGames(ctx, thisUser.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	idx := slices.IndexFunc(games, func(g *game.UserGame) bool { return g.GameId == req.GetGameId() })
+	if idx < 0 {
+		return nil, status.Error(codes.NotFound, "game not found")
+	}
+	userGame := games[idx]
+	if userGame.HasFinished {
+		return nil, status.Error(codes.FailedPrecondition, "game has finished")
+	}
+
+	details, err := s.game.GetGame(ctx, userGame.GameId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var from string
+	var userIds, nicknames []string
+	for _, p := range details.Players {
+		if p.UserId == thisUser.Id {
+			from = p.Nickname
+			continue
+		}
+		if slices.Contains(userGame.WaitingFor, p.Color) {
+			userIds = append(userIds, p.UserId)
+			nicknames = append(nicknames, p.Nickname)
+		}
+	}
+	if len(userIds) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "game is not waiting for other players")
+	}
+
+	if err := s.storage.UpdateGameNudged(ctx, userGame.GameId, s.cfg.NudgeInterval); err != nil {
+		if errors.Is(err, storage.ErrRateLimited) {
+			return nil, status.Error(codes.ResourceExhausted, "game was nudged recently")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.notifier.Nudge(ctx, userGame.GameId, from, userIds); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.Nudge_Response{Nicknames: nicknames}, nil
+}
+
 func isUnique(str []string) bool {
 	m := make(map[string]struct{})
 	for _, v := range str {
@@ -138,6 +444,33 @@ func isUnique(str []string) bool {
 	return len(m) == len(str)
 }
 
+func rematchSettings(details *game.GameDetails, reshuffleBoard bool, reshuffleFirstPlayer bool) mars.GameSettings {
+	settings := mars.GameSettings{
+		Board:        mars.Board(details.Settings.Board),
+		CorporateEra: details.Settings.CorporateEra,
+		Prelude:      details.Settings.Prelude,
+		VenusNext:    details.Settings.VenusNext,
+		SolarPhase:   details.Settings.SolarPhase,
+		Colonies:     details.Settings.Colonies,
+		Advanced:     fromStoredAdvanced(details.Settings.Advanced),
+	}
+	boards := mars.ClassicBoards
+	if settings.Advanced != nil {
+		boards = mars.AllBoards
+	}
+	if reshuffleBoard || settings.Board == "" {
+		settings.Board = boards[rand.IntN(len(boards))]
+	}
+	if !reshuffleFirstPlayer {
+		for _, p := range details.Players {
+			if p.UserId == details.Settings.FirstUserId {
+				settings.FirstPlayer = p.Nickname
+			}
+		}
+	}
+	return settings
+}
+
 func boardFromAPIV2(board api.CreateGameV2_Board) mars.Board {
 	switch board {
 	case api.CreateGameV2_THARSIS:
@@ -147,6 +480,6 @@ func boardFromAPIV2(board api.CreateGameV2_Board) mars.Board {
 	case api.CreateGameV2_ELYSIUM:
 		return mars.BoardElysium
 	default:
-		return mars.AllBoards[rand.IntN(len(mars.AllBoards))]
+		return mars.ClassicBoards[rand.IntN(len(mars.ClassicBoards))]
 	}
 }
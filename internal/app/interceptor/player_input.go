@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -32,6 +33,32 @@ func (s *Service) PlayerInputHandler(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	s.origin.ServeHTTP(w, r)
+	s.invalidateCache(r)
+}
+
+// invalidateCache drops cached mars responses of the game the input was sent to
+func (s *Service) invalidateCache(r *http.Request) {
+	playerId := r.URL.Query().Get("id")
+	if playerId == "" {
+		return
+	}
+
+	game, err := s.storage.GetGameByPlayerId(r.Context(), playerId)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			logx.Logger(r.Context()).Error("failed to get game to invalidate cache",
+				slog.String("player_id", playerId),
+				slog.Any("error", err))
+		}
+		s.cache.InvalidatePlayer(playerId)
+		return
+	}
+
+	playerIds := make([]string, len(game.Players))
+	for i, p := range game.Players {
+		playerIds[i] = p.PlayerId
+	}
+	s.cache.InvalidateGame(game.SpectatorId, playerIds)
 }
 
 func (s *Service) getStateWatcher(r *http.Request) (func() error, error) {
@@ -40,6 +67,8 @@ func (s *Service) getStateWatcher(r *http.Request) (func() error, error) {
 		return nil, fmt.Errorf("player is empty: %s", r.URL.String())
 	}
 
+	// A cached state might be older than the last move, the diff with the new state would be wrong then
+	s.cache.InvalidatePlayer(playerId)
 	initialState, err := s.mars.WaitingFor(r.Context(), mars.WaitingForRequest{PlayerId: playerId})
 	if err != nil {
 		return nil, fmt.Errorf("failed get initial state %s: %w", playerId, err)
@@ -55,28 +84,43 @@ func (s *Service) getStateWatcher(r *http.Request) (func() error, error) {
 			return fmt.Errorf("failed get new state %s: %w", playerId, err)
 		}
 
-		updatedColors := symmetricDifference(initialState.Colors, newState.Colors)
-		if len(updatedColors) == 0 {
-			return nil
-		}
-
 		game, err := s.storage.GetGameByPlayerId(ctx, playerId)
 		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				// The game is not managed by us
+				return nil
+			}
 			return fmt.Errorf("failed get game %s: %w", playerId, err)
 		}
 
+		marsGame, err := s.mars.GetGame(ctx, mars.GetGameRequest{SpectatorId: game.SpectatorId})
+		if err != nil {
+			return fmt.Errorf("failed to get game from mars %s: %w", game.SpectatorId, err)
+		}
+		players := make([]storage.GameStatePlayer, len(marsGame.Game.Players))
+		for i, p := range marsGame.Game.Players {
+			players[i] = storage.GameStatePlayer{Name: p.Name, Color: p.Color}
+		}
+		if err := s.storage.UpdateGameState(ctx, game.GameId, storage.GameState{
+			WaitingFor:   newState.Colors,
+			Phase:        marsGame.Game.Phase,
+			Generation:   marsGame.Game.Generation,
+			PlayersCount: len(marsGame.Game.Players),
+			Players:      players,
+		}); err != nil {
+			return fmt.Errorf("failed to update game state %s: %w", game.GameId, err)
+		}
+
+		updatedColors := symmetricDifference(initialState.Colors, newState.Colors)
 		for _, pp := range game.Players {
-			if _, ok := updatedColors[pp.Color]; ok {
+			// Game lists of all players change when the game finishes
+			if _, ok := updatedColors[pp.Color]; ok || marsGame.Game.HasFinished {
 				if err := s.notifier.NotifyUser(ctx, pp.UserId); err != nil {
 					return fmt.Errorf("failed to notify user %s: %w", pp.UserId, err)
 				}
 			}
 		}
 
-		marsGame, err := s.mars.GetGame(ctx, mars.GetGameRequest{SpectatorId: game.SpectatorId})
-		if err != nil {
-			return fmt.Errorf("failed to get game from mars %s: %w", game.SpectatorId, err)
-		}
 		if marsGame.Game.HasFinished {
 			if err := s.gameNotifier.NotifyGameFinished(ctx, game.GameId); err != nil {
 				return fmt.Errorf("failed to notify game %s: %w", game.GameId, err)
@@ -10,6 +10,7 @@ import (
 
 type Storage interface {
 	GetGameByPlayerId(ctx context.Context, playerId string) (*storage.Game, error)
+	UpdateGameState(ctx context.Context, gameId string, state storage.GameState) error
 }
 
 type MarsClient interface {
@@ -17,6 +18,11 @@ type MarsClient interface {
 	WaitingFor(ctx context.Context, req mars.WaitingForRequest) (mars.WaitingForResponse, error)
 }
 
+type Cache interface {
+	InvalidateGame(spectatorId string, playerIds []string)
+	InvalidatePlayer(playerId string)
+}
+
 type Notifier interface {
 	NotifyUser(ctx context.Context, userId string) error
 }
@@ -29,15 +35,18 @@ type Service struct {
 	origin       http.Handler
 	storage      Storage
 	mars         MarsClient
+	cache        Cache
 	notifier     Notifier
 	gameNotifier GameNotifier
 }
 
-func NewService(origin http.Handler, storage Storage, mars MarsClient, notifier Notifier, gameNotifier GameNotifier) *Service {
+func NewService(origin http.Handler, storage Storage, mars MarsClient, cache Cache,
+	notifier Notifier, gameNotifier GameNotifier) *Service {
 	return &Service{
 		origin:       origin,
 		storage:      storage,
 		mars:         mars,
+		cache:        cache,
 		notifier:     notifier,
 		gameNotifier: gameNotifier,
 	}
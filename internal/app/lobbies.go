@@ -0,0 +1,340 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
+)
+
+// lobbyClaimTimeout limits the game creation of a starting lobby, the lobby can be claimed again after it
+const lobbyClaimTimeout = 5 * time.Minute
+
+func (s *Service) CreateLobby(ctx context.Context, req *api.CreateLobby_Request) (*api.CreateLobby_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	users, err := s.getInvitees(ctx, thisUser.Id, req.GetPlayers())
+	if err != nil {
+		return nil, err
+	}
+	if len(users)+1 > maxPlayers {
+		return nil, status.Errorf(codes.InvalidArgument, "too many players: %d", len(users)+1)
+	}
+	settings, err := fromAPIGameSettings(req.GetSettings())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userIds := make([]string, len(users))
+	for i, u := range users {
+		userIds[i] = u.UserId
+	}
+	lobbyId := uuid.NewString()
+	if err := s.storage.CreateLobby(ctx, storage.CreateLobby{
+		LobbyId:  lobbyId,
+		HostId:   thisUser.Id,
+		Settings: settings,
+		UserIds:  userIds,
+		TTL:      s.cfg.LobbyTTL,
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lobby, err := s.storage.GetLobby(ctx, lobbyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.inviteToLobby(ctx, lobby, userIds)
+	return &api.CreateLobby_Response{Lobby: lobbyToAPI(lobby)}, nil
+}
+
+func (s *Service) GetLobbies(ctx context.Context, _ *api.GetLobbies_Request) (*api.GetLobbies_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	lobbies, err := s.storage.GetLobbiesByUserId(ctx, thisUser.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.GetLobbies_Response{Lobbies: []*api.Lobby{}}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*api.Lobby, len(lobbies))
+	for i, l := range lobbies {
+		res[i] = lobbyToAPI(l)
+	}
+	return &api.GetLobbies_Response{Lobbies: res}, nil
+}
+
+func (s *Service) GetLobby(ctx context.Context, req *api.GetLobby_Request) (*api.GetLobby_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	lobby, err := s.getMemberLobby(ctx, req.GetLobbyId(), thisUser.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetLobby_Response{Lobby: lobbyToAPI(lobby)}, nil
+}
+
+func (s *Service) InviteToLobby(ctx context.Context, req *api.InviteToLobby_Request) (*api.InviteToLobby_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	lobby, err := s.getMemberLobby(ctx, req.GetLobbyId(), thisUser.Id)
+	if err != nil {
+		return nil, err
+	}
+	if lobby.HostId != thisUser.Id {
+		return nil, status.Error(codes.PermissionDenied, "only the host can invite players")
+	}
+	if lobby.Status != storage.LobbyStatusOpen {
+		return nil, status.Error(codes.FailedPrecondition, "lobby is not open")
+	}
+
+	users, err := s.getInvitees(ctx, thisUser.Id, req.GetPlayers())
+	if err != nil {
+		return nil, err
+	}
+	var userIds []string
+	for _, u := range users {
+		idx := slices.IndexFunc(lobby.Members, func(m storage.LobbyMember) bool { return m.UserId == u.UserId })
+		if idx < 0 || lobby.Members[idx].Status == storage.InvitationStatusDeclined {
+			userIds = append(userIds, u.UserId)
+		}
+	}
+	if len(userIds) == 0 {
+		return &api.InviteToLobby_Response{Lobby: lobbyToAPI(lobby)}, nil
+	}
+
+	if err := s.storage.InviteToLobby(ctx, lobby.LobbyId, userIds, maxPlayers); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "lobby is not open")
+		}
+		if errors.Is(err, storage.ErrLobbyFull) {
+			return nil, status.Error(codes.InvalidArgument, "too many players")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lobby, err = s.storage.GetLobby(ctx, lobby.LobbyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.inviteToLobby(ctx, lobby, userIds)
+	return &api.InviteToLobby_Response{Lobby: lobbyToAPI(lobby)}, nil
+}
+
+func (s *Service) AcceptInvitation(ctx context.Context, req *api.AcceptInvitation_Request) (*api.AcceptInvitation_Response, error) {
+	lobby, err := s.respondToInvitation(ctx, req.GetLobbyId(), storage.InvitationStatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AcceptInvitation_Response{Lobby: lobbyToAPI(lobby)}, nil
+}
+
+func (s *Service) DeclineInvitation(ctx context.Context, req *api.DeclineInvitation_Request) (*api.DeclineInvitation_Response, error) {
+	if _, err := s.respondToInvitation(ctx, req.GetLobbyId(), storage.InvitationStatusDeclined); err != nil {
+		return nil, err
+	}
+	return &api.DeclineInvitation_Response{}, nil
+}
+
+func (s *Service) StartLobby(ctx context.Context, req *api.StartLobby_Request) (*api.StartLobby_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	lobby, err := s.getMemberLobby(ctx, req.GetLobbyId(), thisUser.Id)
+	if err != nil {
+		return nil, err
+	}
+	if lobby.HostId != thisUser.Id {
+		return nil, status.Error(codes.PermissionDenied, "only the host can start the game")
+	}
+
+	if err := s.startLobby(ctx, lobby.LobbyId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "lobby is not open")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lobby, err = s.storage.GetLobby(ctx, lobby.LobbyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.StartLobby_Response{Lobby: lobbyToAPI(lobby)}, nil
+}
+
+// respondToInvitation updates the invitation and starts the game once nobody else is pending
+func (s *Service) respondToInvitation(ctx context.Context, lobbyId string, response storage.InvitationStatus) (*storage.Lobby, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	if err := s.storage.UpdateLobbyMember(ctx, lobbyId, thisUser.Id, response); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "invitation not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lobby, err := s.storage.GetLobby(ctx, lobbyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if countMembers(lobby, storage.InvitationStatusPending) > 0 ||
+		countMembers(lobby, storage.InvitationStatusAccepted) < 2 {
+		return lobby, nil
+	}
+
+	// Another response may have started the game already
+	if err := s.startLobby(ctx, lobbyId); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	lobby, err = s.storage.GetLobby(ctx, lobbyId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return lobby, nil
+}
+
+// startLobby creates the game with the members who accepted. The lobby is claimed first,
+// so only one of concurrent callers creates the game, others get storage.ErrNotFound.
+// A lobby left starting by a caller which didn't finish within lobbyClaimTimeout is claimed again.
+func (s *Service) startLobby(ctx context.Context, lobbyId string) error {
+	claimedAt, err := s.storage.ClaimLobby(ctx, lobbyId, lobbyClaimTimeout)
+	if err != nil {
+		return err
+	}
+
+	// Members don't change after the lobby is claimed
+	createCtx, cancel := context.WithTimeout(ctx, lobbyClaimTimeout)
+	defer cancel()
+	gameId, err := s.createLobbyGame(createCtx, lobbyId)
+	if err != nil {
+		if err := s.storage.ReleaseLobby(ctx, lobbyId, claimedAt, storage.LobbyStatusOpen, ""); err != nil {
+			logx.Logger(ctx).Error("failed to reopen lobby", slog.String("id", lobbyId), slog.Any("error", err))
+		}
+		return err
+	}
+
+	if err := s.storage.ReleaseLobby(ctx, lobbyId, claimedAt, storage.LobbyStatusStarted, gameId); err != nil {
+		return fmt.Errorf("failed to update lobby status: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) createLobbyGame(ctx context.Context, lobbyId string) (string, error) {
+	lobby, err := s.storage.GetLobby(ctx, lobbyId)
+	if err != nil {
+		return "", fmt.Errorf("failed to get lobby: %w", err)
+	}
+
+	var users []*storage.User
+	for _, m := range lobby.Members {
+		if m.Status == storage.InvitationStatusAccepted {
+			users = append(users, &storage.User{UserId: m.UserId, Nickname: m.Nickname, Color: m.Color})
+		}
+	}
+	gameId, err := s.createGame(ctx, users, fromLobbySettings(lobby.Settings))
+	if err != nil {
+		return "", fmt.Errorf("failed to create game: %w", err)
+	}
+	return gameId, nil
+}
+
+// getMemberLobby returns the lobby if the user is its member or is invited to it
+func (s *Service) getMemberLobby(ctx context.Context, lobbyId string, userId string) (*storage.Lobby, error) {
+	lobby, err := s.storage.GetLobby(ctx, lobbyId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "lobby not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !slices.ContainsFunc(lobby.Members, func(m storage.LobbyMember) bool { return m.UserId == userId }) {
+		return nil, status.Error(codes.NotFound, "lobby not found")
+	}
+	return lobby, nil
+}
+
+// getInvitees finds users by nicknames, the host is skipped
+func (s *Service) getInvitees(ctx context.Context, hostId string, players []string) ([]*storage.User, error) {
+	if !isUnique(players) {
+		return nil, status.Error(codes.InvalidArgument, "players are not unique")
+	}
+
+	users := make([]*storage.User, 0, len(players))
+	for _, player := range players {
+		u, err := s.storage.GetUserByNickname(ctx, player)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "user not found")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if u.UserId != hostId {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+// inviteToLobby notifies the invited users, the invitations are kept if it fails
+func (s *Service) inviteToLobby(ctx context.Context, lobby *storage.Lobby, userIds []string) {
+	if err := s.notifier.InviteToLobby(ctx, lobby, userIds); err != nil {
+		logx.Logger(ctx).Warn("failed to notify invited users", slog.String("id", lobby.LobbyId), slog.Any("error", err))
+	}
+}
+
+func countMembers(lobby *storage.Lobby, statuses ...storage.InvitationStatus) int {
+	count := 0
+	for _, m := range lobby.Members {
+		if slices.Contains(statuses, m.Status) {
+			count++
+		}
+	}
+	return count
+}
+
+func fromLobbySettings(settings storage.GameSettings) mars.GameSettings {
+	board := mars.Board(settings.Board)
+	if board == "" {
+		board = mars.ClassicBoards[rand.IntN(len(mars.ClassicBoards))]
+	}
+	return mars.GameSettings{
+		Board:        board,
+		CorporateEra: settings.CorporateEra,
+		Prelude:      settings.Prelude,
+		VenusNext:    settings.VenusNext,
+		SolarPhase:   settings.SolarPhase,
+		Colonies:     settings.Colonies,
+	}
+}
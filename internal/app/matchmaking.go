@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
+)
+
+func (s *Service) JoinQueue(ctx context.Context, req *api.JoinQueue_Request) (*api.JoinQueue_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	preferences, err := fromAPIMatchmakingPreferences(req.GetPreferences())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.storage.Enqueue(ctx, thisUser.Id, preferences); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	entry, err := s.storage.GetQueueEntry(ctx, thisUser.Id, s.cfg.QueueTTL)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			// A concurrent matchmaking run has matched the user already, or QueueTTL <= 0 makes every entry stale
+			return &api.JoinQueue_Response{}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.JoinQueue_Response{EnqueuedAt: timestamppb.New(entry.EnqueuedAt)}, nil
+}
+
+func (s *Service) LeaveQueue(ctx context.Context, _ *api.LeaveQueue_Request) (*api.LeaveQueue_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	if err := s.storage.Dequeue(ctx, thisUser.Id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "user is not queued")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.LeaveQueue_Response{}, nil
+}
+
+func (s *Service) GetQueueStatus(ctx context.Context, _ *api.GetQueueStatus_Request) (*api.GetQueueStatus_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	entry, err := s.storage.GetQueueEntry(ctx, thisUser.Id, s.cfg.QueueTTL)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.GetQueueStatus_Response{}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.GetQueueStatus_Response{
+		Queued:      true,
+		Preferences: matchmakingPreferencesToAPI(entry.Preferences),
+		EnqueuedAt:  timestamppb.New(entry.EnqueuedAt),
+	}, nil
+}
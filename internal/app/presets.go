@@ -0,0 +1,202 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
+)
+
+func (s *Service) CreatePreset(ctx context.Context, req *api.CreatePreset_Request) (*api.CreatePreset_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	settings, err := presetSettings(req.GetName(), req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	userIds, err := s.getSharedWith(ctx, thisUser.Id, req.GetSharedWith())
+	if err != nil {
+		return nil, err
+	}
+
+	presetId := uuid.NewString()
+	if err := s.storage.CreatePreset(ctx, storage.CreatePreset{
+		PresetId: presetId,
+		OwnerId:  thisUser.Id,
+		Name:     req.GetName(),
+		Settings: settings,
+		UserIds:  userIds,
+	}); err != nil {
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "preset with this name already exists")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	preset, err := s.storage.GetPreset(ctx, presetId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.CreatePreset_Response{Preset: presetToAPI(preset)}, nil
+}
+
+func (s *Service) GetPresets(ctx context.Context, _ *api.GetPresets_Request) (*api.GetPresets_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	presets, err := s.storage.GetPresetsByUserId(ctx, thisUser.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.GetPresets_Response{Presets: []*api.Preset{}}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*api.Preset, len(presets))
+	for i, p := range presets {
+		res[i] = presetToAPI(p)
+	}
+	return &api.GetPresets_Response{Presets: res}, nil
+}
+
+func (s *Service) UpdatePreset(ctx context.Context, req *api.UpdatePreset_Request) (*api.UpdatePreset_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	if _, err := s.getOwnPreset(ctx, req.GetPresetId(), thisUser.Id); err != nil {
+		return nil, err
+	}
+	settings, err := presetSettings(req.GetName(), req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	userIds, err := s.getSharedWith(ctx, thisUser.Id, req.GetSharedWith())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.UpdatePreset(ctx, storage.UpdatePreset{
+		PresetId: req.GetPresetId(),
+		Name:     req.GetName(),
+		Settings: settings,
+		UserIds:  userIds,
+	}); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "preset not found")
+		}
+		if errors.Is(err, storage.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "preset with this name already exists")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	preset, err := s.storage.GetPreset(ctx, req.GetPresetId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.UpdatePreset_Response{Preset: presetToAPI(preset)}, nil
+}
+
+func (s *Service) DeletePreset(ctx context.Context, req *api.DeletePreset_Request) (*api.DeletePreset_Response, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	if _, err := s.getOwnPreset(ctx, req.GetPresetId(), thisUser.Id); err != nil {
+		return nil, err
+	}
+	if err := s.storage.DeletePreset(ctx, req.GetPresetId()); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "preset not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.DeletePreset_Response{}, nil
+}
+
+// getPresetOptions returns options of a preset the current user can use
+func (s *Service) getPresetOptions(ctx context.Context, presetId string) (*api.GameOptions, error) {
+	thisUser, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	preset, err := s.getUserPreset(ctx, presetId, thisUser.Id)
+	if err != nil {
+		return nil, err
+	}
+	return gameOptionsToAPI(&preset.Settings), nil
+}
+
+// getUserPreset hides presets which are not shared with the user
+func (s *Service) getUserPreset(ctx context.Context, presetId string, userId string) (*storage.Preset, error) {
+	preset, err := s.storage.GetPreset(ctx, presetId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "preset not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, u := range preset.Users {
+		if u.UserId == userId {
+			return preset, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "preset not found")
+}
+
+func (s *Service) getOwnPreset(ctx context.Context, presetId string, userId string) (*storage.Preset, error) {
+	preset, err := s.getUserPreset(ctx, presetId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if preset.OwnerId != userId {
+		return nil, status.Error(codes.PermissionDenied, "only the owner can change the preset")
+	}
+	return preset, nil
+}
+
+func (s *Service) getSharedWith(ctx context.Context, ownerId string, nicknames []string) ([]string, error) {
+	users, err := s.getInvitees(ctx, ownerId, nicknames)
+	if err != nil {
+		return nil, err
+	}
+	userIds := make([]string, len(users))
+	for i, u := range users {
+		userIds[i] = u.UserId
+	}
+	return userIds, nil
+}
+
+// presetSettings validates the options and converts them for the storage
+func presetSettings(name string, options *api.GameOptions) (storage.GameSettings, error) {
+	if name == "" {
+		return storage.GameSettings{}, status.Error(codes.InvalidArgument, "name is empty")
+	}
+	if options.GetFirstPlayer() != "" {
+		return storage.GameSettings{}, status.Error(codes.InvalidArgument, "first player can't be set in a preset")
+	}
+	if _, err := gameSettingsFromOptions(options); err != nil {
+		return storage.GameSettings{}, err
+	}
+
+	settings, err := storedGameOptions(options)
+	if err != nil {
+		return storage.GameSettings{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return settings, nil
+}
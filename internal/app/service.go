@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
 	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
@@ -9,32 +10,82 @@ import (
 	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
 )
 
+type Config struct {
+	RatingSystem  storage.RatingSystem
+	NudgeInterval time.Duration
+	LobbyTTL      time.Duration
+	QueueTTL      time.Duration
+	// AdminUsers are ids of users allowed to call the Admin service
+	AdminUsers []string
+}
+
 type Storage interface {
-	GetLeaderboard(ctx context.Context, ut storage.UserType, limit int64) ([]*storage.User, error)
+	ClaimLobby(ctx context.Context, lobbyId string, staleAfter time.Duration) (time.Time, error)
+	ClaimRematch(ctx context.Context, gameId string, staleAfter time.Duration) (time.Time, error)
+	CreateLobby(ctx context.Context, req storage.CreateLobby) error
+	CreatePreset(ctx context.Context, req storage.CreatePreset) error
+	DeletePreset(ctx context.Context, presetId string) error
+	Dequeue(ctx context.Context, userId string) error
+	Enqueue(ctx context.Context, userId string, preferences storage.MatchmakingPreferences) error
+	GetDeliveryAttempts(ctx context.Context, userId string, limit int) ([]*storage.DeliveryAttempt, error)
+	GetGameByPlayerId(ctx context.Context, playerId string) (*storage.Game, error)
+	GetNotificationSettings(ctx context.Context, userId string) (storage.NotificationSettings, error)
+	GetLeaderboard(ctx context.Context, req storage.GetLeaderboard) ([]*storage.User, error)
+	GetLobbiesByUserId(ctx context.Context, userId string) ([]*storage.Lobby, error)
+	GetLobby(ctx context.Context, lobbyId string) (*storage.Lobby, error)
+	GetPreset(ctx context.Context, presetId string) (*storage.Preset, error)
+	GetPresetsByUserId(ctx context.Context, userId string) ([]*storage.Preset, error)
+	GetQueueEntry(ctx context.Context, userId string, maxAge time.Duration) (*storage.QueueEntry, error)
+	GetRatingHistory(ctx context.Context, req storage.GetRatingHistory) ([]*storage.RatingHistory, error)
+	GetRematchId(ctx context.Context, gameId string) (string, error)
 	GetUserById(ctx context.Context, userId string) (*storage.User, error)
 	GetUserByNickname(ctx context.Context, nickname string) (*storage.User, error)
+	InviteToLobby(ctx context.Context, lobbyId string, userIds []string, maxMembers int) error
+	ReleaseLobby(ctx context.Context, lobbyId string, claimedAt time.Time, to storage.LobbyStatus, gameId string) error
+	ReleaseRematch(ctx context.Context, gameId string, claimedAt time.Time) error
 	SearchUsers(ctx context.Context, req storage.SearchUsers) ([]*storage.User, error)
-	UpdateDeviceToken(ctx context.Context, userId string, deviceToken []byte, tokenType storage.DeviceTokenType) error
+	UpdateDeviceToken(ctx context.Context, req storage.UpdateDeviceToken) error
+	UpdateGameNudged(ctx context.Context, gameId string, interval time.Duration) error
+	UpdateGameRematchOf(ctx context.Context, gameId string, rematchOf string) error
+	UpdateLobbyMember(ctx context.Context, lobbyId string, userId string, status storage.InvitationStatus) error
+	UpdateNotificationSettings(ctx context.Context, userId string, settings storage.NotificationSettings) error
+	UpdatePreset(ctx context.Context, req storage.UpdatePreset) error
 	UpdateUser(ctx context.Context, req storage.UpdateUser) (*storage.User, error)
 	UpsertUser(ctx context.Context, req storage.UpsertUser) error
 }
 
 type GameService interface {
-	CreateGame(ctx context.Context, players []*storage.User, settings mars.GameSettings) error
+	CreateGame(ctx context.Context, players []*storage.User, settings mars.GameSettings) (string, error)
+	GetGame(ctx context.Context, gameId string) (*game.GameDetails, error)
+	GetGameHistory(ctx context.Context, req storage.GetFinishedGames) ([]*game.GameDetails, error)
 	GetUserGames(ctx context.Context, userId string) ([]*game.UserGame, error)
 }
 
+type Notifier interface {
+	InviteToLobby(ctx context.Context, lobby *storage.Lobby, userIds []string) error
+	Nudge(ctx context.Context, gameId string, from string, userIds []string) error
+	RefreshUser(ctx context.Context, userId string)
+}
+
 type Service struct {
-	storage Storage
-	game    GameService
+	cfg      Config
+	storage  Storage
+	game     GameService
+	notifier Notifier
 
 	api.UnsafeUsersServer
 	api.UnsafeGamesServer
+	api.UnsafeLobbiesServer
+	api.UnsafeMatchmakingServer
+	api.UnsafePresetsServer
+	api.UnsafeAdminServer
 }
 
-func NewService(storage Storage, game GameService) *Service {
+func NewService(cfg Config, storage Storage, game GameService, notifier Notifier) *Service {
 	return &Service{
-		storage: storage,
-		game:    game,
+		cfg:      cfg,
+		storage:  storage,
+		game:     game,
+		notifier: notifier,
 	}
 }
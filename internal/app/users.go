@@ -6,20 +6,26 @@ import (
 	"fmt"
 	"math/rand/v2"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/auth"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/webpush"
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
 	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 	"github.com/chestnut42/terraforming-mars-manager/pkg/api"
 )
 
 const (
-	newPlayerPrefix  = "Player "
-	leaderboardLimit = 50
+	newPlayerPrefix          = "Player "
+	leaderboardLimit         = 50
+	ratingHistoryPageSize    = 50
+	ratingHistoryMaxPageSize = 200
+	maxNotificationDelay     = 24 * time.Hour
 )
 
 func (s *Service) Login(ctx context.Context, _ *api.Login_Request) (*api.Login_Response, error) {
@@ -109,13 +115,122 @@ func (s *Service) UpdateDeviceToken(ctx context.Context, req *api.UpdateDeviceTo
 		return nil, status.Error(codes.Unauthenticated, "user not found")
 	}
 
-	if err := s.storage.UpdateDeviceToken(ctx, user.Id, req.GetDeviceToken(), storage.DeviceTokenTypeProduction); err != nil {
+	platform, ok := fromAPIDevicePlatforms[req.GetPlatform()]
+	if !ok || platform == storage.DevicePlatformWeb {
+		return nil, status.Error(codes.InvalidArgument, "unknown platform")
+	}
+
+	if len(req.GetDeviceToken()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty device token")
+	}
+
+	if err := s.storage.UpdateDeviceToken(ctx, storage.UpdateDeviceToken{
+		UserId:      user.Id,
+		Token:       req.GetDeviceToken(),
+		Platform:    platform,
+		Environment: storage.DeviceTokenTypeProduction,
+		AppVersion:  req.GetAppVersion(),
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.UpdateDeviceToken_Response{}, nil
+}
+
+func (s *Service) RegisterWebPushSubscription(ctx context.Context,
+	req *api.RegisterWebPushSubscription_Request) (*api.RegisterWebPushSubscription_Response, error) {
+	userId, err := s.webPushUserId(ctx, req.GetPlayerId())
+	if err != nil {
+		return nil, err
+	}
+
+	sub := webpush.Subscription{
+		Endpoint: req.GetEndpoint(),
+		Keys: webpush.SubscriptionKeys{
+			P256dh: req.GetP256Dh(),
+			Auth:   req.GetAuth(),
+		},
+	}
+	if err := sub.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	token, err := sub.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.storage.UpdateDeviceToken(ctx, storage.UpdateDeviceToken{
+		UserId:      userId,
+		Token:       token,
+		Platform:    storage.DevicePlatformWeb,
+		Environment: storage.DeviceTokenTypeProduction,
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.RegisterWebPushSubscription_Response{}, nil
+}
+
+func (s *Service) webPushUserId(ctx context.Context, playerId string) (string, error) {
+	if user, ok := auth.UserFromContext(ctx); ok {
+		return user.Id, nil
+	}
+	if playerId == "" {
+		return "", status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	g, err := s.storage.GetGameByPlayerId(ctx, playerId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return "", status.Error(codes.NotFound, "player not found")
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	for _, p := range g.Players {
+		if p.PlayerId == playerId {
+			return p.UserId, nil
+		}
+	}
+	return "", status.Error(codes.NotFound, "player not found")
+}
+
+func (s *Service) GetNotificationSettings(ctx context.Context,
+	_ *api.GetNotificationSettings_Request) (*api.GetNotificationSettings_Response, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	settings, err := s.storage.GetNotificationSettings(ctx, user.Id)
+	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &api.UpdateDeviceToken_Response{}, nil
+	return &api.GetNotificationSettings_Response{
+		Settings: notificationSettingsToAPI(settings),
+	}, nil
+}
+
+func (s *Service) UpdateNotificationSettings(ctx context.Context,
+	req *api.UpdateNotificationSettings_Request) (*api.UpdateNotificationSettings_Response, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	settings, err := fromAPINotificationSettings(req.GetSettings())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.storage.UpdateNotificationSettings(ctx, user.Id, settings); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.UpdateNotificationSettings_Response{
+		Settings: notificationSettingsToAPI(settings),
+	}, nil
 }
 
 func (s *Service) SearchUser(ctx context.Context, req *api.SearchUser_Request) (*api.SearchUser_Response, error) {
@@ -156,7 +271,17 @@ func (s *Service) GetEloLeaderboard(ctx context.Context, req *api.GetEloLeaderbo
 		return nil, status.Error(codes.Unauthenticated, "user not found")
 	}
 
-	users, err := s.storage.GetLeaderboard(ctx, storage.UserTypeActive, leaderboardLimit)
+	bucket, err := leaderboardBucket(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	users, err := s.storage.GetLeaderboard(ctx, storage.GetLeaderboard{
+		Type:   storage.UserTypeActive,
+		System: s.cfg.RatingSystem,
+		Bucket: bucket,
+		Limit:  leaderboardLimit,
+	})
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -169,7 +294,96 @@ func (s *Service) GetEloLeaderboard(ctx context.Context, req *api.GetEloLeaderbo
 		respUsers[i] = userToAPI(user)
 	}
 	return &api.GetEloLeaderboard_Response{
-		Users: respUsers,
+		Users:        respUsers,
+		RatingSystem: toAPIRatingSystems[s.cfg.RatingSystem],
+	}, nil
+}
+
+// leaderboardBucket returns a rating bucket selected by the request filters or
+// an empty string for the global leaderboard
+func leaderboardBucket(req *api.GetEloLeaderboard_Request) (string, error) {
+	var buckets []string
+	if req.GetPlayersCount() != 0 {
+		if req.GetPlayersCount() < 2 || req.GetPlayersCount() > 5 {
+			return "", fmt.Errorf("invalid players count: %d", req.GetPlayersCount())
+		}
+		buckets = append(buckets, game.PlayersBucket(int(req.GetPlayersCount())))
+	}
+	if req.GetBoard() != api.Board_BOARD_UNKNOWN {
+		b, ok := fromAPIBoards[req.GetBoard()]
+		if !ok {
+			return "", fmt.Errorf("unknown board: %s", req.GetBoard())
+		}
+		buckets = append(buckets, game.BoardBucket(b))
+	}
+	if req.GetExpansion() != api.Expansion_EXPANSION_UNSPECIFIED {
+		e, ok := fromAPIExpansions[req.GetExpansion()]
+		if !ok {
+			return "", fmt.Errorf("unknown expansion: %s", req.GetExpansion())
+		}
+		buckets = append(buckets, game.ExpansionBucket(e))
+	}
+
+	switch len(buckets) {
+	case 0:
+		return "", nil
+	case 1:
+		return buckets[0], nil
+	default:
+		return "", errors.New("only one leaderboard filter can be set")
+	}
+}
+
+func (s *Service) GetRatingHistory(ctx context.Context, req *api.GetRatingHistory_Request) (*api.GetRatingHistory_Response, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = ratingHistoryPageSize
+	}
+	if pageSize > ratingHistoryMaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page size is too big: %d", pageSize)
+	}
+
+	var before *storage.RatingHistoryCursor
+	if req.GetPageToken() != "" {
+		createdAt, gameId, err := fromPageToken(req.GetPageToken())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		before = &storage.RatingHistoryCursor{CreatedAt: createdAt, GameId: gameId}
+	}
+
+	// Request one extra record to know whether there is a next page
+	history, err := s.storage.GetRatingHistory(ctx, storage.GetRatingHistory{
+		UserId: user.Id,
+		Before: before,
+		Limit:  pageSize + 1,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.GetRatingHistory_Response{Changes: []*api.RatingChange{}}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextPageToken := ""
+	if len(history) > pageSize {
+		history = history[:pageSize]
+		last := history[len(history)-1]
+		nextPageToken = pageToken(last.CreatedAt, last.GameId)
+	}
+
+	changes := make([]*api.RatingChange, len(history))
+	for i, h := range history {
+		changes[i] = ratingChangeToAPI(h)
+	}
+	return &api.GetRatingHistory_Response{
+		Changes:       changes,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
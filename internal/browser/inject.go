@@ -0,0 +1,44 @@
+package browser
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func injectScript(scriptUrl string) func(resp *http.Response) error {
+	tag := []byte(fmt.Sprintf(`<script src="%s" defer></script>`, scriptUrl))
+
+	return func(resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK ||
+			!strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") ||
+			resp.Header.Get("Content-Encoding") != "" {
+			return nil
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read body: %w", err)
+		}
+		if err := resp.Body.Close(); err != nil {
+			return fmt.Errorf("failed to close body: %w", err)
+		}
+
+		injected := make([]byte, 0, len(body)+len(tag))
+		if i := bytes.LastIndex(body, []byte("</body>")); i >= 0 {
+			injected = append(injected, body[:i]...)
+			injected = append(injected, tag...)
+			injected = append(injected, body[i:]...)
+		} else {
+			injected = append(append(injected, body...), tag...)
+		}
+
+		resp.Body = io.NopCloser(bytes.NewReader(injected))
+		resp.ContentLength = int64(len(injected))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(injected)))
+		return nil
+	}
+}
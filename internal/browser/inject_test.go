@@ -0,0 +1,79 @@
+package browser
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestInjectScript(t *testing.T) {
+	const script = `<script src="/manager/browser/subscribe.js" defer></script>`
+
+	for _, tc := range []struct {
+		name        string
+		status      int
+		contentType string
+		encoding    string
+		body        string
+		want        string
+	}{
+		{
+			name:        "before body end",
+			status:      http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+			body:        "<html><body><div id=app></div></body></html>",
+			want:        "<html><body><div id=app></div>" + script + "</body></html>",
+		},
+		{
+			name:        "no body tag",
+			status:      http.StatusOK,
+			contentType: "text/html",
+			body:        "<div id=app></div>",
+			want:        "<div id=app></div>" + script,
+		},
+		{
+			name:        "not html",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        "{}",
+			want:        "{}",
+		},
+		{
+			name:        "compressed",
+			status:      http.StatusOK,
+			contentType: "text/html",
+			encoding:    "gzip",
+			body:        "<body></body>",
+			want:        "<body></body>",
+		},
+		{
+			name:        "not found",
+			status:      http.StatusNotFound,
+			contentType: "text/html",
+			body:        "<body></body>",
+			want:        "<body></body>",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tc.status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(tc.body)),
+			}
+			resp.Header.Set("Content-Type", tc.contentType)
+			if tc.encoding != "" {
+				resp.Header.Set("Content-Encoding", tc.encoding)
+			}
+
+			err := injectScript("/manager/browser/subscribe.js")(resp)
+			assert.NilError(t, err)
+
+			got, err := io.ReadAll(resp.Body)
+			assert.NilError(t, err)
+			assert.Equal(t, string(got), tc.want)
+		})
+	}
+}
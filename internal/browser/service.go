@@ -0,0 +1,87 @@
+package browser
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"path"
+	"text/template"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+)
+
+const (
+	subscribePath = "subscribe.js"
+	workerPath    = "sw.js"
+)
+
+//go:embed subscribe.js.tpl
+var subscribeTemplate string
+
+//go:embed sw.js
+var workerScript []byte
+
+type Config struct {
+	// BasePath is the path the scripts are served under
+	BasePath string
+	// RegisterURL is the URL of the RegisterWebPushSubscription API
+	RegisterURL string
+	// PublicKey is the VAPID public key of the web push notifier
+	PublicKey string
+}
+
+// Service serves the scripts that subscribe browser players to web push notifications
+type Service struct {
+	basePath        string
+	subscribeScript []byte
+}
+
+func NewService(cfg Config) (*Service, error) {
+	tpl, err := template.New("subscribe").Parse(subscribeTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tpl.Execute(buf, map[string]interface{}{
+		"workerUrl":   path.Join("/", cfg.BasePath, workerPath),
+		"scope":       path.Join("/", cfg.BasePath) + "/",
+		"registerUrl": cfg.RegisterURL,
+		"publicKey":   cfg.PublicKey,
+	}); err != nil {
+		return nil, fmt.Errorf("error executing template: %w", err)
+	}
+
+	return &Service{
+		basePath:        cfg.BasePath,
+		subscribeScript: buf.Bytes(),
+	}, nil
+}
+
+func (s *Service) ConfigureRouter(mux *http.ServeMux) {
+	mux.Handle("GET "+path.Join("/", s.basePath, subscribePath), scriptHandler(s.subscribeScript))
+	mux.Handle("GET "+path.Join("/", s.basePath, workerPath), scriptHandler(workerScript))
+}
+
+// InjectScript makes the proxy add the subscription script to the pages it serves
+func (s *Service) InjectScript(proxy *httputil.ReverseProxy) {
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		// The page is modified, so it has to come uncompressed
+		r.Header.Del("Accept-Encoding")
+	}
+	proxy.ModifyResponse = injectScript(path.Join("/", s.basePath, subscribePath))
+}
+
+func scriptHandler(data []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+		if _, err := w.Write(data); err != nil {
+			logx.Logger(r.Context()).Warn("error writing response", slog.Any("error", err))
+		}
+	}
+}
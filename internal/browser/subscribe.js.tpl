@@ -0,0 +1,43 @@
+// Subscribes the Mars player page to web push notifications of the manager.
+(function () {
+  if (!('serviceWorker' in navigator) || !('PushManager' in window) || !('Notification' in window)) {
+    return;
+  }
+  const playerId = new URLSearchParams(window.location.search).get('id');
+  if (!playerId) {
+    return;
+  }
+
+  function decodeKey(key) {
+    const base64 = (key + '='.repeat((4 - key.length % 4) % 4)).replace(/-/g, '+').replace(/_/g, '/');
+    return Uint8Array.from(atob(base64), c => c.charCodeAt(0));
+  }
+
+  async function subscribe() {
+    const registration = await navigator.serviceWorker.register('{{.workerUrl}}', {scope: '{{.scope}}'});
+    let subscription = await registration.pushManager.getSubscription();
+    if (!subscription) {
+      if (await Notification.requestPermission() !== 'granted') {
+        return;
+      }
+      subscription = await registration.pushManager.subscribe({
+        userVisibleOnly: true,
+        applicationServerKey: decodeKey('{{.publicKey}}'),
+      });
+    }
+
+    const json = subscription.toJSON();
+    await fetch('{{.registerUrl}}', {
+      method: 'POST',
+      headers: {'Content-Type': 'application/json'},
+      body: JSON.stringify({
+        endpoint: json.endpoint,
+        p256dh: json.keys.p256dh,
+        auth: json.keys.auth,
+        playerId: playerId,
+      }),
+    });
+  }
+
+  subscribe().catch(err => console.warn('mars manager: web push subscription failed', err));
+})();
@@ -0,0 +1,14 @@
+// Shows web push notifications of the manager.
+self.addEventListener('push', event => {
+  const message = event.data ? event.data.json() : {};
+  event.waitUntil(self.registration.showNotification(message.title || 'Terraforming Mars', {
+    body: message.subtitle ? message.subtitle + '\n' + message.body : message.body,
+    tag: message.tag,
+    data: message,
+  }));
+});
+
+self.addEventListener('notificationclick', event => {
+  event.notification.close();
+  event.waitUntil(self.clients.openWindow(event.notification.data.url || '/'));
+});
@@ -1,7 +1,159 @@
 package apn
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+// Reasons documented in https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns
 var (
-	ErrBadDeviceToken = errors.New("bad device token")
+	ErrBadCollapseId               = errors.New("bad collapse id")
+	ErrBadDeviceToken              = errors.New("bad device token")
+	ErrBadExpirationDate           = errors.New("bad expiration date")
+	ErrBadMessageId                = errors.New("bad message id")
+	ErrBadPriority                 = errors.New("bad priority")
+	ErrBadTopic                    = errors.New("bad topic")
+	ErrDeviceTokenNotForTopic      = fmt.Errorf("device token not for topic: %w", push.ErrInvalidToken)
+	ErrDuplicateHeaders            = errors.New("duplicate headers")
+	ErrIdleTimeout                 = errors.New("idle timeout")
+	ErrInvalidPushType             = errors.New("invalid push type")
+	ErrMissingDeviceToken          = errors.New("missing device token")
+	ErrMissingTopic                = errors.New("missing topic")
+	ErrPayloadEmpty                = errors.New("payload empty")
+	ErrTopicDisallowed             = errors.New("topic disallowed")
+	ErrBadCertificate              = errors.New("bad certificate")
+	ErrBadCertificateEnvironment   = errors.New("bad certificate environment")
+	ErrExpiredProviderToken        = errors.New("expired provider token")
+	ErrForbidden                   = errors.New("forbidden")
+	ErrInvalidProviderToken        = errors.New("invalid provider token")
+	ErrMissingProviderToken        = errors.New("missing provider token")
+	ErrUnrelatedKeyIdInToken       = errors.New("unrelated key id in token")
+	ErrBadPath                     = errors.New("bad path")
+	ErrMethodNotAllowed            = errors.New("method not allowed")
+	ErrExpiredToken                = fmt.Errorf("expired token: %w", push.ErrInvalidToken)
+	ErrUnregistered                = fmt.Errorf("unregistered: %w", push.ErrInvalidToken)
+	ErrPayloadTooLarge             = errors.New("payload too large")
+	ErrTooManyProviderTokenUpdates = errors.New("too many provider token updates")
+	ErrTooManyRequests             = errors.New("too many requests")
+	ErrInternalServerError         = errors.New("internal server error")
+	ErrServiceUnavailable          = errors.New("service unavailable")
+	ErrShutdown                    = errors.New("shutdown")
 )
+
+var reasonErrors = map[string]error{
+	"BadCollapseId":               ErrBadCollapseId,
+	"BadDeviceToken":              ErrBadDeviceToken,
+	"BadExpirationDate":           ErrBadExpirationDate,
+	"BadMessageId":                ErrBadMessageId,
+	"BadPriority":                 ErrBadPriority,
+	"BadTopic":                    ErrBadTopic,
+	"DeviceTokenNotForTopic":      ErrDeviceTokenNotForTopic,
+	"DuplicateHeaders":            ErrDuplicateHeaders,
+	"IdleTimeout":                 ErrIdleTimeout,
+	"InvalidPushType":             ErrInvalidPushType,
+	"MissingDeviceToken":          ErrMissingDeviceToken,
+	"MissingTopic":                ErrMissingTopic,
+	"PayloadEmpty":                ErrPayloadEmpty,
+	"TopicDisallowed":             ErrTopicDisallowed,
+	"BadCertificate":              ErrBadCertificate,
+	"BadCertificateEnvironment":   ErrBadCertificateEnvironment,
+	"ExpiredProviderToken":        ErrExpiredProviderToken,
+	"Forbidden":                   ErrForbidden,
+	"InvalidProviderToken":        ErrInvalidProviderToken,
+	"MissingProviderToken":        ErrMissingProviderToken,
+	"UnrelatedKeyIdInToken":       ErrUnrelatedKeyIdInToken,
+	"BadPath":                     ErrBadPath,
+	"MethodNotAllowed":            ErrMethodNotAllowed,
+	"ExpiredToken":                ErrExpiredToken,
+	"Unregistered":                ErrUnregistered,
+	"PayloadTooLarge":             ErrPayloadTooLarge,
+	"TooManyProviderTokenUpdates": ErrTooManyProviderTokenUpdates,
+	"TooManyRequests":             ErrTooManyRequests,
+	"InternalServerError":         ErrInternalServerError,
+	"ServiceUnavailable":          ErrServiceUnavailable,
+	"Shutdown":                    ErrShutdown,
+}
+
+// permanentErrors fail the same way when retried, bad device tokens are not included
+// because the token may belong to the other environment.
+var permanentErrors = []error{
+	ErrBadCollapseId,
+	ErrBadExpirationDate,
+	ErrBadMessageId,
+	ErrBadPriority,
+	ErrBadTopic,
+	ErrDuplicateHeaders,
+	ErrInvalidPushType,
+	ErrMissingDeviceToken,
+	ErrMissingTopic,
+	ErrPayloadEmpty,
+	ErrTopicDisallowed,
+	ErrBadCertificate,
+	ErrBadCertificateEnvironment,
+	ErrForbidden,
+	ErrInvalidProviderToken,
+	ErrMissingProviderToken,
+	ErrUnrelatedKeyIdInToken,
+	ErrBadPath,
+	ErrMethodNotAllowed,
+	ErrPayloadTooLarge,
+}
+
+// Error is a rejected notification, it wraps one of the reason errors if the reason is known
+type Error struct {
+	Status int
+	Reason string
+	// Timestamp is set for unregistered tokens, it is the last time APNs confirmed the token was no longer valid
+	Timestamp time.Time
+
+	err  error
+	body string
+}
+
+func newError(status int, resp errorResponse, body string) *Error {
+	e := &Error{
+		Status: status,
+		Reason: resp.Reason,
+		err:    reasonErrors[resp.Reason],
+		body:   body,
+	}
+	if resp.Timestamp > 0 {
+		e.Timestamp = time.UnixMilli(resp.Timestamp)
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("status %d, body: %s", e.Status, e.body)
+	}
+	if e.err == nil {
+		return fmt.Sprintf("status %d: %s", e.Status, e.Reason)
+	}
+	return fmt.Sprintf("status %d: %s", e.Status, e.err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// UnregisteredAt returns the time since which the device token is no longer valid
+func UnregisteredAt(err error) (time.Time, bool) {
+	var apnErr *Error
+	if errors.As(err, &apnErr) && !apnErr.Timestamp.IsZero() {
+		return apnErr.Timestamp, true
+	}
+	return time.Time{}, false
+}
+
+func isPermanent(err error) bool {
+	for _, p := range permanentErrors {
+		if errors.Is(err, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package apn
+
+import (
+	"context"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+// Push sends a platform-neutral notification as an APNs alert or a background notification
+func (s *Service) Push(ctx context.Context, device []byte, n push.Notification) (push.Result, error) {
+	if n.Background {
+		return s.SendNotification(ctx, device, Notification{
+			ContentAvailable: 1,
+			PushType:         PushTypeBackground,
+			Priority:         PriorityConserve,
+		})
+	}
+
+	var alert *Alert
+	if n.Title != "" || n.Subtitle != "" || n.Body != "" {
+		alert = &Alert{
+			Title:    n.Title,
+			Subtitle: n.Subtitle,
+			Body:     n.Body,
+		}
+	}
+	return s.SendNotification(ctx, device, Notification{
+		Alert:      alert,
+		Badge:      &n.Badge,
+		Sound:      n.Sound,
+		ThreadId:   n.ThreadId,
+		CollapseId: n.CollapseId,
+		Data:       pushData(n),
+	})
+}
+
+func pushData(n push.Notification) map[string]string {
+	if n.URL == "" {
+		return nil
+	}
+	return map[string]string{"url": n.URL}
+}
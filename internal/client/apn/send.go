@@ -5,13 +5,16 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 
-	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
 )
 
 type Alert struct {
@@ -20,64 +23,135 @@ type Alert struct {
 	Body     string `json:"body"`
 }
 
+type PushType string
+
+const (
+	PushTypeAlert      PushType = "alert"
+	PushTypeBackground PushType = "background"
+)
+
+const (
+	PriorityImmediate = 10
+	// PriorityConserve is required for background notifications
+	PriorityConserve = 5
+)
+
 type Notification struct {
-	Alert Alert  `json:"alert"`
-	Badge int    `json:"badge"`
-	Sound string `json:"sound"`
+	Alert    *Alert `json:"alert,omitempty"`
+	Badge    *int   `json:"badge,omitempty"`
+	Sound    string `json:"sound,omitempty"`
+	ThreadId string `json:"thread-id,omitempty"`
+	// ContentAvailable set to 1 wakes the app up in the background,
+	// such notifications must have no alert, badge or sound
+	ContentAvailable int `json:"content-available,omitempty"`
+
+	// PushType, Priority and CollapseId are sent as apns-push-type, apns-priority and apns-collapse-id headers.
+	// PushType defaults to alert and Priority to the APNs default. Data is sent as custom keys next to aps.
+	PushType   PushType          `json:"-"`
+	Priority   int               `json:"-"`
+	CollapseId string            `json:"-"`
+	Data       map[string]string `json:"-"`
 }
 
-type fullNotification struct {
-	Aps Notification `json:"aps"`
+// maxCollapseIdSize is the limit of apns-collapse-id header in bytes
+const maxCollapseIdSize = 64
+
+func (n *Notification) payload() map[string]any {
+	p := make(map[string]any, len(n.Data)+1)
+	for k, v := range n.Data {
+		p[k] = v
+	}
+	p["aps"] = n
+	return p
 }
 
 type errorResponse struct {
 	Reason string `json:"reason"`
+	// Timestamp is in milliseconds since epoch
+	Timestamp int64 `json:"timestamp"`
 }
 
-func (s *Service) SendNotification(ctx context.Context, device []byte, n Notification) error {
-	bodyData, err := json.Marshal(&fullNotification{Aps: n})
+// maxErrorBodySize limits how much of an unexpected response is kept in errors
+const maxErrorBodySize = 1024
+
+func (s *Service) SendNotification(ctx context.Context, device []byte, n Notification) (push.Result, error) {
+	if len(n.CollapseId) > maxCollapseIdSize {
+		return push.Result{}, fmt.Errorf("collapse id is too long: %d", len(n.CollapseId))
+	}
+	bodyData, err := json.Marshal(n.payload())
 	if err != nil {
-		return fmt.Errorf("failed to marshal notification: %w", err)
+		return push.Result{}, fmt.Errorf("failed to marshal notification: %w", err)
 	}
 
 	url := s.baseURL.JoinPath("3", "device", hex.EncodeToString(device)).String()
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(bodyData))
+	messageId := uuid.NewString()
+	result, err := s.send(ctx, url, bodyData, messageId, n)
+	if errors.Is(err, ErrExpiredProviderToken) {
+		// The token has been dropped by send, the request is repeated once with a new one
+		return s.send(ctx, url, bodyData, messageId, n)
+	}
+	return result, err
+}
+
+func (s *Service) send(ctx context.Context, url string, body []byte, messageId string, n Notification) (push.Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return push.Result{}, fmt.Errorf("failed to create request: %w", err)
 	}
 	t, err := s.getToken()
 	if err != nil {
-		return fmt.Errorf("failed to get token: %w", err)
+		return push.Result{}, fmt.Errorf("failed to get token: %w", err)
 	}
-	messageId := uuid.NewString()
 	req.Header.Set("Authorization", "Bearer "+t)
+	pushType := n.PushType
+	if pushType == "" {
+		pushType = PushTypeAlert
+	}
 	req.Header.Set("apns-id", messageId)
-	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-push-type", string(pushType))
 	req.Header.Set("apns-topic", s.topic)
+	if n.Priority != 0 {
+		req.Header.Set("apns-priority", strconv.Itoa(n.Priority))
+	}
+	if n.CollapseId != "" {
+		req.Header.Set("apns-collapse-id", n.CollapseId)
+	}
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send notification: %w", err)
+		return push.Result{MessageId: messageId}, fmt.Errorf("failed to send notification: %w", err)
 	}
 	defer resp.Body.Close() //nolint:errcheck
 
-	if resp.StatusCode == http.StatusBadRequest {
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("failed to read response body: %w", err)
-		}
-		var errResp errorResponse
-		if err := json.Unmarshal(body, &errResp); err != nil {
-			return fmt.Errorf("failed to unmarshal response body(%s): %w", string(body), err)
-		}
-		if errResp.Reason == "BadDeviceToken" {
-			return ErrBadDeviceToken
-		}
-		return fmt.Errorf("failed to send notification: %s", errResp.Reason)
-	}
-
-	if err := httpx.CheckResponse(resp); err != nil {
-		return fmt.Errorf("failed to send notification: %w", err)
-	}
-	return nil
+	result := push.Result{MessageId: messageId, Status: resp.StatusCode}
+	if id := resp.Header.Get("apns-id"); id != "" {
+		result.MessageId = id
+	}
+	if resp.StatusCode == http.StatusOK {
+		return result, nil
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil {
+		return result, fmt.Errorf("failed to read response body: %w", err)
+	}
+	var errResp errorResponse
+	// Responses of proxies and load balancers may not be JSON, the body is kept in the error then
+	_ = json.Unmarshal(respBody, &errResp)
+	apnErr := newError(resp.StatusCode, errResp, strings.TrimSpace(string(respBody)))
+	result.Reason = apnErr.Reason
+
+	if errors.Is(apnErr, ErrExpiredProviderToken) {
+		s.dropToken(t)
+	}
+	if push.IsRetryStatus(resp.StatusCode) {
+		return result, fmt.Errorf("failed to send notification: %w", &push.RetryError{
+			After: push.ParseRetryAfter(resp.Header.Get("Retry-After"), s.now()),
+			Err:   apnErr,
+		})
+	}
+	if isPermanent(apnErr) {
+		return result, fmt.Errorf("failed to send notification: %w: %w", push.ErrPermanent, apnErr)
+	}
+	return result, fmt.Errorf("failed to send notification: %w", apnErr)
 }
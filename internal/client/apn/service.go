@@ -89,3 +89,13 @@ func (s *Service) getToken() (string, error) {
 	s.createdAt = now
 	return s.token, nil
 }
+
+// dropToken makes the next request sign a new token unless the token has been replaced already
+func (s *Service) dropToken(token string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
@@ -1,12 +1,22 @@
 package apn
 
 import (
+	"context"
 	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
 	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
 )
 
 //go:embed test_key.p8
@@ -32,3 +42,300 @@ func TestNewService(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Assert(t, strings.HasPrefix(token, "eyJhbGciOiJFUzI1NiIsImtpZCI6ImtleSBpZCIsInR5cCI6IkpXVCJ9.eyJpYXQiOjE3MjMzMDAwMDAsImlzcyI6InRlYW0gaWQifQ."), "received: %s", token)
 }
+
+func TestNotification_payload(t *testing.T) {
+	badge := 2
+	for _, tc := range []struct {
+		name string
+		n    Notification
+		want string
+	}{
+		{
+			name: "alert",
+			n: Notification{
+				Alert:      &Alert{Title: "title", Body: "body"},
+				Badge:      &badge,
+				Sound:      "default",
+				ThreadId:   "game id",
+				CollapseId: "game id",
+				Data:       map[string]string{"url": "https://mars.example.com/player?id=p1"},
+			},
+			want: `{"aps":{"alert":{"title":"title","subtitle":"","body":"body"},` +
+				`"badge":2,"sound":"default","thread-id":"game id"},"url":"https://mars.example.com/player?id=p1"}`,
+		},
+		{
+			name: "badge only",
+			n:    Notification{Badge: new(int)},
+			want: `{"aps":{"badge":0}}`,
+		},
+		{
+			name: "background",
+			n:    Notification{ContentAvailable: 1, PushType: PushTypeBackground, Priority: PriorityConserve},
+			want: `{"aps":{"content-available":1}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.n.payload())
+			assert.NilError(t, err)
+			assert.Equal(t, string(data), tc.want)
+		})
+	}
+}
+
+type fakeResponse struct {
+	status     int
+	body       string
+	retryAfter string
+}
+
+type fakeAPNs struct {
+	// responses are returned in order, the last one is repeated
+	responses []fakeResponse
+	requests  []*http.Request
+	bodies    []string
+}
+
+func (f *fakeAPNs) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	resp := f.responses[min(len(f.requests), len(f.responses)-1)]
+	body, _ := io.ReadAll(r.Body)
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, string(body))
+
+	w.Header().Set("apns-id", r.Header.Get("apns-id"))
+	if resp.retryAfter != "" {
+		w.Header().Set("Retry-After", resp.retryAfter)
+	}
+	w.WriteHeader(resp.status)
+	_, _ = io.WriteString(w, resp.body)
+}
+
+func newTestService(t *testing.T, f *fakeAPNs) *Service {
+	t.Helper()
+
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	baseURL, err := url.Parse(server.URL)
+	assert.NilError(t, err)
+
+	s, err := NewService(Config{
+		BaseURL:     baseURL,
+		Topic:       "com.example.mars",
+		TeamId:      "team id",
+		KeyId:       "key id",
+		KeyData:     testKeyData,
+		MaxTokenAge: time.Hour,
+	}, server.Client())
+	assert.NilError(t, err)
+	return s
+}
+
+func TestService_SendNotification(t *testing.T) {
+	ctx := context.Background()
+	device := []byte{0xde, 0xad, 0xbe, 0xef}
+	n := Notification{
+		Alert:      &Alert{Title: "Mars awaits you!"},
+		CollapseId: "game id",
+	}
+	unregisteredAt := time.UnixMilli(1723300000123)
+
+	for _, tc := range []struct {
+		name       string
+		responses  []fakeResponse
+		requests   int
+		wantStatus int
+		wantReason string
+		wantErrs   []error
+		wantRetry  time.Duration
+		permanent  bool
+		// unregisteredAt is zero unless the token was reported unregistered
+		unregisteredAt time.Time
+	}{
+		{
+			name:       "success",
+			responses:  []fakeResponse{{status: http.StatusOK}},
+			requests:   1,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "bad device token",
+			responses:  []fakeResponse{{status: http.StatusBadRequest, body: `{"reason":"BadDeviceToken"}`}},
+			requests:   1,
+			wantStatus: http.StatusBadRequest,
+			wantReason: "BadDeviceToken",
+			wantErrs:   []error{ErrBadDeviceToken},
+		},
+		{
+			name:       "device token not for topic",
+			responses:  []fakeResponse{{status: http.StatusBadRequest, body: `{"reason":"DeviceTokenNotForTopic"}`}},
+			requests:   1,
+			wantStatus: http.StatusBadRequest,
+			wantReason: "DeviceTokenNotForTopic",
+			wantErrs:   []error{ErrDeviceTokenNotForTopic, push.ErrInvalidToken},
+		},
+		{
+			name: "unregistered",
+			responses: []fakeResponse{{status: http.StatusGone,
+				body: `{"reason":"Unregistered","timestamp":1723300000123}`}},
+			requests:       1,
+			wantStatus:     http.StatusGone,
+			wantReason:     "Unregistered",
+			wantErrs:       []error{ErrUnregistered, push.ErrInvalidToken},
+			unregisteredAt: unregisteredAt,
+		},
+		{
+			name:       "payload too large",
+			responses:  []fakeResponse{{status: http.StatusRequestEntityTooLarge, body: `{"reason":"PayloadTooLarge"}`}},
+			requests:   1,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantReason: "PayloadTooLarge",
+			wantErrs:   []error{ErrPayloadTooLarge},
+			permanent:  true,
+		},
+		{
+			name:       "bad topic",
+			responses:  []fakeResponse{{status: http.StatusBadRequest, body: `{"reason":"BadTopic"}`}},
+			requests:   1,
+			wantStatus: http.StatusBadRequest,
+			wantReason: "BadTopic",
+			wantErrs:   []error{ErrBadTopic},
+			permanent:  true,
+		},
+		{
+			name: "too many requests",
+			responses: []fakeResponse{{status: http.StatusTooManyRequests, body: `{"reason":"TooManyRequests"}`,
+				retryAfter: "60"}},
+			requests:   1,
+			wantStatus: http.StatusTooManyRequests,
+			wantReason: "TooManyRequests",
+			wantErrs:   []error{ErrTooManyRequests},
+			wantRetry:  time.Minute,
+		},
+		{
+			name:       "service unavailable",
+			responses:  []fakeResponse{{status: http.StatusServiceUnavailable, body: `{"reason":"ServiceUnavailable"}`}},
+			requests:   1,
+			wantStatus: http.StatusServiceUnavailable,
+			wantReason: "ServiceUnavailable",
+			wantErrs:   []error{ErrServiceUnavailable},
+		},
+		{
+			name: "expired provider token is refreshed",
+			responses: []fakeResponse{
+				{status: http.StatusForbidden, body: `{"reason":"ExpiredProviderToken"}`},
+				{status: http.StatusOK},
+			},
+			requests:   2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "expired provider token",
+			responses:  []fakeResponse{{status: http.StatusForbidden, body: `{"reason":"ExpiredProviderToken"}`}},
+			requests:   2,
+			wantStatus: http.StatusForbidden,
+			wantReason: "ExpiredProviderToken",
+			wantErrs:   []error{ErrExpiredProviderToken},
+		},
+		{
+			name:       "unknown reason",
+			responses:  []fakeResponse{{status: http.StatusBadRequest, body: `{"reason":"SomethingNew"}`}},
+			requests:   1,
+			wantStatus: http.StatusBadRequest,
+			wantReason: "SomethingNew",
+		},
+		{
+			name:       "not json",
+			responses:  []fakeResponse{{status: http.StatusBadGateway, body: "bad gateway"}},
+			requests:   1,
+			wantStatus: http.StatusBadGateway,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := &fakeAPNs{responses: tc.responses}
+			s := newTestService(t, f)
+
+			result, err := s.SendNotification(ctx, device, n)
+			assert.Equal(t, len(f.requests), tc.requests)
+			assert.Equal(t, result.Status, tc.wantStatus)
+			assert.Equal(t, result.Reason, tc.wantReason)
+			assert.Equal(t, result.MessageId, f.requests[0].Header.Get("apns-id"))
+
+			r := f.requests[0]
+			assert.Equal(t, r.URL.Path, "/3/device/deadbeef")
+			assert.Equal(t, r.Header.Get("apns-topic"), "com.example.mars")
+			assert.Equal(t, r.Header.Get("apns-push-type"), "alert")
+			assert.Equal(t, r.Header.Get("apns-collapse-id"), "game id")
+			if tc.requests > 1 {
+				// The retried request has the same id and a new token
+				assert.Equal(t, f.requests[1].Header.Get("apns-id"), r.Header.Get("apns-id"))
+				assert.Assert(t, f.requests[1].Header.Get("Authorization") != r.Header.Get("Authorization"))
+			}
+
+			if tc.wantStatus == http.StatusOK {
+				assert.NilError(t, err)
+				return
+			}
+			assert.Assert(t, err != nil)
+			for _, want := range tc.wantErrs {
+				assert.ErrorIs(t, err, want)
+			}
+			at, ok := UnregisteredAt(err)
+			assert.Equal(t, ok, !tc.unregisteredAt.IsZero())
+			assert.Assert(t, at.Equal(tc.unregisteredAt))
+			if tc.wantReason == "" {
+				assert.ErrorContains(t, err, "bad gateway")
+			} else {
+				assert.ErrorContains(t, err, fmt.Sprintf("status %d", tc.wantStatus))
+			}
+			after, retry := push.RetryAfter(err)
+			assert.Equal(t, retry, push.IsRetryStatus(tc.wantStatus))
+			assert.Equal(t, after, tc.wantRetry)
+			assert.Equal(t, errors.Is(err, push.ErrPermanent), tc.permanent)
+		})
+	}
+}
+
+func TestService_Push(t *testing.T) {
+	ctx := context.Background()
+	device := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	for _, tc := range []struct {
+		name     string
+		n        push.Notification
+		pushType string
+		priority string
+		body     string
+	}{
+		{
+			name:     "alert",
+			n:        push.Notification{Title: "Mars awaits you!", Badge: 1, Sound: "default", URL: "https://mars/p"},
+			pushType: "alert",
+			body: `{"aps":{"alert":{"title":"Mars awaits you!","subtitle":"","body":""},"badge":1,"sound":"default"},` +
+				`"url":"https://mars/p"}`,
+		},
+		{
+			name:     "badge only",
+			n:        push.Notification{Badge: 0},
+			pushType: "alert",
+			body:     `{"aps":{"badge":0}}`,
+		},
+		{
+			name:     "background",
+			n:        push.Notification{Background: true, Badge: 3},
+			pushType: "background",
+			priority: "5",
+			body:     `{"aps":{"content-available":1}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := &fakeAPNs{responses: []fakeResponse{{status: http.StatusOK}}}
+			s := newTestService(t, f)
+
+			_, err := s.Push(ctx, device, tc.n)
+			assert.NilError(t, err)
+			assert.Equal(t, len(f.requests), 1)
+			assert.Equal(t, f.requests[0].Header.Get("apns-push-type"), tc.pushType)
+			assert.Equal(t, f.requests[0].Header.Get("apns-priority"), tc.priority)
+			assert.Equal(t, f.bodies[0], tc.body)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package fcm
+
+import (
+	"fmt"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+var (
+	ErrUnregistered = fmt.Errorf("unregistered: %w", push.ErrInvalidToken)
+)
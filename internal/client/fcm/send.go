@@ -0,0 +1,154 @@
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
+)
+
+type Notification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type AndroidNotification struct {
+	Sound             string `json:"sound,omitempty"`
+	Tag               string `json:"tag,omitempty"`
+	NotificationCount int    `json:"notification_count,omitempty"`
+}
+
+type AndroidConfig struct {
+	CollapseKey  string               `json:"collapse_key,omitempty"`
+	Notification *AndroidNotification `json:"notification,omitempty"`
+}
+
+type Message struct {
+	Token        string            `json:"token"`
+	Notification *Notification     `json:"notification,omitempty"`
+	Android      *AndroidConfig    `json:"android,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type sendRequest struct {
+	Message Message `json:"message"`
+}
+
+type sendResponse struct {
+	Name string `json:"name"`
+}
+
+type errorDetail struct {
+	Type      string `json:"@type"`
+	ErrorCode string `json:"errorCode"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Code    int           `json:"code"`
+		Message string        `json:"message"`
+		Status  string        `json:"status"`
+		Details []errorDetail `json:"details"`
+	} `json:"error"`
+}
+
+func (s *Service) SendMessage(ctx context.Context, m Message) (push.Result, error) {
+	bodyData, err := json.Marshal(&sendRequest{Message: m})
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	url := s.baseURL.JoinPath("v1", "projects", s.projectId, "messages:send").String()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(bodyData))
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	t, err := s.getToken(ctx)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to get token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+t)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to send message: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	result := push.Result{Status: resp.StatusCode}
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return result, fmt.Errorf("failed to read response body: %w", err)
+		}
+		var errResp errorResponse
+		if err := json.Unmarshal(body, &errResp); err != nil {
+			return result, fmt.Errorf("failed to unmarshal response body(%s): %w", string(body), err)
+		}
+		result.Reason = errResp.Error.Status
+		for _, d := range errResp.Error.Details {
+			if d.ErrorCode != "" {
+				result.Reason = d.ErrorCode
+			}
+			if d.ErrorCode == "UNREGISTERED" {
+				return result, ErrUnregistered
+			}
+		}
+		return result, fmt.Errorf("failed to send message: %w: %s: %s",
+			push.ErrPermanent, errResp.Error.Status, errResp.Error.Message)
+	}
+
+	if push.IsRetryStatus(resp.StatusCode) {
+		return result, fmt.Errorf("failed to send message: %w", push.NewRetryError(resp, s.now()))
+	}
+	if err := httpx.CheckResponse(resp); err != nil {
+		if push.IsPermanentStatus(resp.StatusCode) {
+			return result, fmt.Errorf("failed to send message: %w: %w", push.ErrPermanent, err)
+		}
+		return result, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	var sendResp sendResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sendResp); err != nil {
+		return result, fmt.Errorf("failed to decode response: %w", err)
+	}
+	result.MessageId = sendResp.Name
+	return result, nil
+}
+
+// Push sends a platform-neutral notification, badge only notifications are sent as data messages.
+// Background notifications are not sent.
+func (s *Service) Push(ctx context.Context, device []byte, n push.Notification) (push.Result, error) {
+	if n.Background {
+		return push.Result{}, nil
+	}
+	m := Message{
+		Token: string(device),
+		Data:  map[string]string{"badge": strconv.Itoa(n.Badge)},
+	}
+	if n.URL != "" {
+		m.Data["url"] = n.URL
+	}
+	if n.Title != "" || n.Body != "" {
+		m.Notification = &Notification{
+			Title: n.Title,
+			Body:  n.Body,
+		}
+		m.Android = &AndroidConfig{
+			CollapseKey: n.CollapseId,
+			Notification: &AndroidNotification{
+				Sound:             n.Sound,
+				Tag:               n.ThreadId,
+				NotificationCount: n.Badge,
+			},
+		}
+	}
+	return s.SendMessage(ctx, m)
+}
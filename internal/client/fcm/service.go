@@ -0,0 +1,158 @@
+package fcm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
+)
+
+const (
+	messagingScope  = "https://www.googleapis.com/auth/firebase.messaging"
+	jwtBearerGrant  = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	assertionMaxAge = time.Hour
+	// tokenExpiryGap makes sure an access token is not used right before it expires
+	tokenExpiryGap = time.Minute
+)
+
+type Config struct {
+	BaseURL *url.URL
+	// TokenURL overrides the token uri of the service account
+	TokenURL *url.URL
+	// ServiceAccount is the content of a Google service account JSON key
+	ServiceAccount []byte
+}
+
+type Client interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type serviceAccount struct {
+	ProjectId   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type Service struct {
+	baseURL     *url.URL
+	tokenURL    *url.URL
+	projectId   string
+	clientEmail string
+	key         jwk.Key
+
+	client Client
+
+	now       func() time.Time
+	l         sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewService(cfg Config, client Client) (*Service, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(cfg.ServiceAccount, &account); err != nil {
+		return nil, fmt.Errorf("failed to parse service account: %w", err)
+	}
+	if account.ProjectId == "" || account.ClientEmail == "" {
+		return nil, fmt.Errorf("service account has no project id or client email")
+	}
+
+	key, err := jwk.ParseKey([]byte(account.PrivateKey), jwk.WithPEM(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key: %w", err)
+	}
+	if err := key.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	tokenURL := cfg.TokenURL
+	if tokenURL == nil {
+		tokenURL, err = url.Parse(account.TokenURI)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse token uri: %w", err)
+		}
+	}
+
+	return &Service{
+		baseURL:     cfg.BaseURL,
+		tokenURL:    tokenURL,
+		projectId:   account.ProjectId,
+		clientEmail: account.ClientEmail,
+		key:         key,
+
+		client: client,
+		now:    time.Now,
+	}, nil
+}
+
+// getToken returns a cached OAuth 2.0 access token or exchanges a signed JWT for a new one
+func (s *Service) getToken(ctx context.Context) (string, error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	assertion, err := jwt.NewBuilder().
+		Issuer(s.clientEmail).
+		Audience([]string{s.tokenURL.String()}).
+		IssuedAt(now).
+		Expiration(now.Add(assertionMaxAge)).
+		Claim("scope", messagingScope).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("failed to build token: %w", err)
+	}
+	signed, err := jwt.Sign(assertion, jwt.WithKey(jwa.RS256, s.key))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", jwtBearerGrant)
+	form.Set("assertion", string(signed))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if err := httpx.CheckResponse(resp); err != nil {
+		return "", fmt.Errorf("failed to request token: %w", err)
+	}
+	var tr tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return "", fmt.Errorf("empty access token")
+	}
+
+	s.token = tr.AccessToken
+	s.expiresAt = now.Add(time.Duration(tr.ExpiresIn)*time.Second - tokenExpiryGap)
+	return s.token, nil
+}
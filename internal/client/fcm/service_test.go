@@ -0,0 +1,234 @@
+package fcm
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+type fakeServer struct {
+	tokenRequests int
+	messages      []Message
+	sendStatus    int
+	sendBody      string
+}
+
+func (f *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/token":
+		f.tokenRequests++
+		if r.FormValue("grant_type") != jwtBearerGrant || r.FormValue("assertion") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = io.WriteString(w, `{"access_token":"access token","expires_in":3600,"token_type":"Bearer"}`)
+	case "/v1/projects/test-project/messages:send":
+		if r.Header.Get("Authorization") != "Bearer access token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req sendRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.messages = append(f.messages, req.Message)
+		if f.sendStatus != 0 {
+			w.WriteHeader(f.sendStatus)
+			_, _ = io.WriteString(w, f.sendBody)
+			return
+		}
+		_, _ = io.WriteString(w, `{"name":"projects/test-project/messages/1"}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestService(t *testing.T, f *fakeServer) *Service {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NilError(t, err)
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	baseURL, err := url.Parse(server.URL)
+	assert.NilError(t, err)
+
+	account, err := json.Marshal(serviceAccount{
+		ProjectId:   "test-project",
+		ClientEmail: "manager@test-project.iam.gserviceaccount.com",
+		PrivateKey:  string(keyPem),
+		TokenURI:    baseURL.JoinPath("token").String(),
+	})
+	assert.NilError(t, err)
+
+	s, err := NewService(Config{
+		BaseURL:        baseURL,
+		ServiceAccount: account,
+	}, server.Client())
+	assert.NilError(t, err)
+	return s
+}
+
+func TestService_Push(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("alert", func(t *testing.T) {
+		f := &fakeServer{}
+		s := newTestService(t, f)
+
+		result, err := s.Push(ctx, []byte("device token"), push.Notification{
+			Title: "Mars awaits you!",
+			Body:  "1 game is awaiting for your decision",
+			Badge: 1,
+			Sound: "default",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, result, push.Result{MessageId: "projects/test-project/messages/1", Status: http.StatusOK})
+		assert.DeepEqual(t, f.messages, []Message{
+			{
+				Token: "device token",
+				Notification: &Notification{
+					Title: "Mars awaits you!",
+					Body:  "1 game is awaiting for your decision",
+				},
+				Android: &AndroidConfig{
+					Notification: &AndroidNotification{Sound: "default", NotificationCount: 1},
+				},
+				Data: map[string]string{"badge": "1"},
+			},
+		})
+	})
+
+	t.Run("game alert", func(t *testing.T) {
+		f := &fakeServer{}
+		s := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{
+			Title:      "Mars awaits you!",
+			Body:       "Waiting for you",
+			Badge:      1,
+			ThreadId:   "game id",
+			CollapseId: "game id",
+			URL:        "https://mars.example.com/player?id=p1",
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, f.messages, []Message{
+			{
+				Token: "device token",
+				Notification: &Notification{
+					Title: "Mars awaits you!",
+					Body:  "Waiting for you",
+				},
+				Android: &AndroidConfig{
+					CollapseKey:  "game id",
+					Notification: &AndroidNotification{Tag: "game id", NotificationCount: 1},
+				},
+				Data: map[string]string{"badge": "1", "url": "https://mars.example.com/player?id=p1"},
+			},
+		})
+	})
+
+	t.Run("badge only", func(t *testing.T) {
+		f := &fakeServer{}
+		s := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{Badge: 0})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, f.messages, []Message{
+			{
+				Token: "device token",
+				Data:  map[string]string{"badge": "0"},
+			},
+		})
+	})
+
+	t.Run("background is skipped", func(t *testing.T) {
+		f := &fakeServer{}
+		s := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{Background: true})
+		assert.NilError(t, err)
+		assert.Equal(t, len(f.messages), 0)
+	})
+
+	t.Run("token is reused", func(t *testing.T) {
+		f := &fakeServer{}
+		s := newTestService(t, f)
+		now := time.Unix(1723300000, 0)
+		s.now = func() time.Time { return now }
+
+		for range 2 {
+			_, err := s.Push(ctx, []byte("device token"), push.Notification{})
+			assert.NilError(t, err)
+		}
+		assert.Equal(t, f.tokenRequests, 1)
+
+		now = now.Add(time.Hour)
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{})
+		assert.NilError(t, err)
+		assert.Equal(t, f.tokenRequests, 2)
+	})
+
+	t.Run("unregistered", func(t *testing.T) {
+		f := &fakeServer{
+			sendStatus: http.StatusNotFound,
+			sendBody: `{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND",
+				"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`,
+		}
+		s := newTestService(t, f)
+
+		result, err := s.Push(ctx, []byte("device token"), push.Notification{})
+		assert.ErrorIs(t, err, ErrUnregistered)
+		assert.DeepEqual(t, result, push.Result{Status: http.StatusNotFound, Reason: "UNREGISTERED"})
+		assert.ErrorIs(t, err, push.ErrInvalidToken)
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+		f := &fakeServer{
+			sendStatus: http.StatusBadRequest,
+			sendBody:   `{"error":{"code":400,"message":"Invalid registration token","status":"INVALID_ARGUMENT"}}`,
+		}
+		s := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{})
+		assert.ErrorContains(t, err, "INVALID_ARGUMENT: Invalid registration token")
+		assert.ErrorIs(t, err, push.ErrPermanent)
+	})
+
+	t.Run("sender id mismatch", func(t *testing.T) {
+		f := &fakeServer{sendStatus: http.StatusForbidden}
+		s := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{})
+		assert.ErrorContains(t, err, "status 403")
+		assert.ErrorIs(t, err, push.ErrPermanent)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		f := &fakeServer{sendStatus: http.StatusServiceUnavailable}
+		s := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("device token"), push.Notification{})
+		assert.ErrorContains(t, err, "status 503")
+		assert.Assert(t, !errors.Is(err, push.ErrPermanent))
+	})
+}
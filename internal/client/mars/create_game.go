@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand/v2"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
@@ -26,6 +27,46 @@ type GameSettings struct {
 	VenusNext    bool
 	SolarPhase   bool
 	Colonies     bool
+	FirstPlayer  string            // Name of the player who starts the game, random if empty
+	Advanced     *AdvancedSettings // The Mars server defaults are used if nil
+}
+
+// AdvancedSettings are the rest of the options of the Mars server game creation form
+type AdvancedSettings struct {
+	// Expansions
+	Prelude2    bool
+	Turmoil     bool
+	Promo       bool
+	Community   bool
+	Ares        bool
+	Moon        bool
+	Pathfinders bool
+	Ceos        bool
+	Underworld  bool
+
+	// Configuration
+	Undo               bool
+	ShowTimers         bool
+	FastMode           bool
+	ShowOtherPlayersVP bool
+
+	// Variants
+	Draft                        bool
+	InitialDraft                 bool
+	PreludeDraft                 bool
+	StartingCorporations         int // Depends on the expansions if zero
+	StartingCeos                 int // Default if zero
+	ShuffleMap                   bool
+	RandomMA                     RandomMA
+	IncludeVenusMA               bool
+	IncludeFanMA                 bool
+	RemoveNegativeGlobalEvents   bool
+	AltVenusBoard                bool
+	RequiresVenusTrackCompletion bool
+	RequiresMoonTrackCompletion  bool
+	TwoCorpsVariant              bool
+	BannedCards                  []string
+	IncludedCards                []string
 }
 
 type CreateGameRequest struct {
@@ -38,11 +79,13 @@ type CreateGameResponse struct {
 	SpectatorId string
 	Players     []NewPlayer
 	PurgeDate   time.Time
+	Seed        float64
+	FirstPlayer string // Name of the player who starts the game
 }
 
 func (s *Service) CreateGame(ctx context.Context, game CreateGameRequest) (CreateGameResponse, error) {
 	req := defaultCreateGame()
-	req.Players = requestPlayers(game.Players)
+	req.Players = requestPlayers(game.Players, game.Settings.FirstPlayer)
 	req.Board = game.Settings.Board
 	req.CorporateEra = game.Settings.CorporateEra
 	req.Prelude = game.Settings.Prelude
@@ -55,6 +98,9 @@ func (s *Service) CreateGame(ctx context.Context, game CreateGameRequest) (Creat
 	if game.Settings.Colonies {
 		req.StartingCorporations += 1
 	}
+	if game.Settings.Advanced != nil {
+		applyAdvancedSettings(&req, *game.Settings.Advanced)
+	}
 
 	reqData, err := json.Marshal(req)
 	if err != nil {
@@ -90,15 +136,23 @@ func (s *Service) CreateGame(ctx context.Context, game CreateGameRequest) (Creat
 			Color: storage.Color(p.Color),
 		}
 	}
+	var firstPlayer string
+	for _, p := range req.Players {
+		if p.First {
+			firstPlayer = p.Name
+		}
+	}
 	return CreateGameResponse{
 		Id:          resp.Id,
 		SpectatorId: resp.SpectatorId,
 		Players:     respPlayers,
 		PurgeDate:   time.UnixMilli(resp.PurgeDateMs),
+		Seed:        float64(req.Seed),
+		FirstPlayer: firstPlayer,
 	}, nil
 }
 
-func requestPlayers(players []NewPlayer) []newPlayer {
+func requestPlayers(players []NewPlayer, firstPlayerName string) []newPlayer {
 	leftColors := make(map[storage.Color]struct{})
 	for _, c := range allColors {
 		leftColors[c] = struct{}{}
@@ -135,11 +189,54 @@ func requestPlayers(players []NewPlayer) []newPlayer {
 		}
 	}
 
-	firstPlayer := rand.N(len(players))
+	firstPlayer := slices.IndexFunc(newPlayers, func(p newPlayer) bool { return p.Name == firstPlayerName })
+	if firstPlayerName == "" || firstPlayer < 0 {
+		firstPlayer = rand.N(len(players))
+	}
 	newPlayers[firstPlayer].First = true
 	return newPlayers
 }
 
+func applyAdvancedSettings(req *createGame, settings AdvancedSettings) {
+	req.Prelude2Expansion = settings.Prelude2
+	req.Turmoil = settings.Turmoil
+	req.PromoCardsOption = settings.Promo
+	req.CommunityCardsOption = settings.Community
+	req.AresExtension = settings.Ares
+	req.MoonExpansion = settings.Moon
+	req.PathfindersExpansion = settings.Pathfinders
+	req.CeoExtension = settings.Ceos
+	req.UnderworldExpansion = settings.Underworld
+
+	req.UndoOption = settings.Undo
+	req.ShowTimers = settings.ShowTimers
+	req.FastModeOption = settings.FastMode
+	req.ShowOtherPlayersVP = settings.ShowOtherPlayersVP
+
+	req.DraftVariant = settings.Draft
+	req.InitialDraft = settings.InitialDraft
+	req.PreludeDraftVariant = settings.PreludeDraft
+	if settings.StartingCorporations > 0 {
+		req.StartingCorporations = settings.StartingCorporations
+	}
+	if settings.StartingCeos > 0 {
+		req.StartingCeos = settings.StartingCeos
+	}
+	req.ShuffleMapOption = settings.ShuffleMap
+	if settings.RandomMA != "" {
+		req.RandomMA = string(settings.RandomMA)
+	}
+	req.IncludeVenusMA = settings.IncludeVenusMA
+	req.IncludeFanMA = settings.IncludeFanMA
+	req.RemoveNegativeGlobalEventsOption = settings.RemoveNegativeGlobalEvents
+	req.AltVenusBoard = settings.AltVenusBoard
+	req.RequiresVenusTrackCompletion = settings.RequiresVenusTrackCompletion
+	req.RequiresMoonTrackCompletion = settings.RequiresMoonTrackCompletion
+	req.TwoCorpsVariant = settings.TwoCorpsVariant
+	req.BannedCards = append(req.BannedCards, settings.BannedCards...)
+	req.IncludedCards = append(req.IncludedCards, settings.IncludedCards...)
+}
+
 func defaultCreateGame() createGame {
 	return createGame{
 		CorporateEra:              true,
@@ -149,8 +246,8 @@ func defaultCreateGame() createGame {
 		CustomCorporationsList:    make([]any, 0),
 		CustomColoniesList:        make([]any, 0),
 		CustomPreludes:            make([]any, 0),
-		BannedCards:               make([]any, 0),
-		IncludedCards:             make([]any, 0),
+		BannedCards:               make([]string, 0),
+		IncludedCards:             make([]string, 0),
 		Board:                     BoardTharsis,
 		Seed:                      rand.Float32(),
 		PoliticalAgendasExtension: "Standard",
@@ -159,7 +256,7 @@ func defaultCreateGame() createGame {
 		IncludeVenusMA:            true,
 		StartingCorporations:      2,
 		PreludeDraftVariant:       true,
-		RandomMA:                  "No randomization",
+		RandomMA:                  string(RandomMANone),
 		CustomCeos:                make([]any, 0),
 		StartingCeos:              3,
 	}
@@ -168,17 +265,39 @@ func defaultCreateGame() createGame {
 type Board string
 
 const (
-	BoardTharsis Board = "tharsis"
-	BoardHellas  Board = "hellas"
-	BoardElysium Board = "elysium"
+	BoardTharsis          Board = "tharsis"
+	BoardHellas           Board = "hellas"
+	BoardElysium          Board = "elysium"
+	BoardAmazonis         Board = "amazonis planitia"
+	BoardTerraCimmeria    Board = "terra cimmeria"
+	BoardVastitasBorealis Board = "vastitas borealis"
 )
 
+// ClassicBoards are the boards random games were always played on,
+// the random choice of the older APIs, lobbies and matchmaking is kept to them
+var ClassicBoards = []Board{
+	BoardTharsis,
+	BoardHellas,
+	BoardElysium,
+}
+
 var AllBoards = []Board{
 	BoardTharsis,
 	BoardHellas,
 	BoardElysium,
+	BoardAmazonis,
+	BoardTerraCimmeria,
+	BoardVastitasBorealis,
 }
 
+type RandomMA string
+
+const (
+	RandomMANone    RandomMA = "No randomization"
+	RandomMALimited RandomMA = "Limited synergy"
+	RandomMAFull    RandomMA = "Full random"
+)
+
 type newPlayer struct {
 	Name     string `json:"name"`
 	Color    string `json:"color"`
@@ -218,26 +337,26 @@ type createGame struct {
 	CeoExtension                     bool   `json:"ceoExtension"`
 
 	// Variants
-	DraftVariant                 bool   `json:"draftVariant"`
-	InitialDraft                 bool   `json:"initialDraft"`
-	PreludeDraftVariant          bool   `json:"preludeDraftVariant"`
-	StartingCorporations         int    `json:"startingCorporations"`
-	ShuffleMapOption             bool   `json:"shuffleMapOption"`
-	RandomMA                     string `json:"randomMA"`
-	IncludeFanMA                 bool   `json:"includeFanMA"`
-	SoloTR                       bool   `json:"soloTR"`
-	CustomCorporationsList       []any  `json:"customCorporationsList"`
-	BannedCards                  []any  `json:"bannedCards"`
-	IncludedCards                []any  `json:"includedCards"`
-	CustomColoniesList           []any  `json:"customColoniesList"`
-	CustomPreludes               []any  `json:"customPreludes"`
-	RequiresMoonTrackCompletion  bool   `json:"requiresMoonTrackCompletion"`
-	RequiresVenusTrackCompletion bool   `json:"requiresVenusTrackCompletion"`
-	MoonStandardProjectVariant   bool   `json:"moonStandardProjectVariant"`
-	MoonStandardProjectVariant1  bool   `json:"moonStandardProjectVariant1"`
-	AltVenusBoard                bool   `json:"altVenusBoard"`
-	EscapeVelocityMode           bool   `json:"escapeVelocityMode"`
-	TwoCorpsVariant              bool   `json:"twoCorpsVariant"`
+	DraftVariant                 bool     `json:"draftVariant"`
+	InitialDraft                 bool     `json:"initialDraft"`
+	PreludeDraftVariant          bool     `json:"preludeDraftVariant"`
+	StartingCorporations         int      `json:"startingCorporations"`
+	ShuffleMapOption             bool     `json:"shuffleMapOption"`
+	RandomMA                     string   `json:"randomMA"`
+	IncludeFanMA                 bool     `json:"includeFanMA"`
+	SoloTR                       bool     `json:"soloTR"`
+	CustomCorporationsList       []any    `json:"customCorporationsList"`
+	BannedCards                  []string `json:"bannedCards"`
+	IncludedCards                []string `json:"includedCards"`
+	CustomColoniesList           []any    `json:"customColoniesList"`
+	CustomPreludes               []any    `json:"customPreludes"`
+	RequiresMoonTrackCompletion  bool     `json:"requiresMoonTrackCompletion"`
+	RequiresVenusTrackCompletion bool     `json:"requiresVenusTrackCompletion"`
+	MoonStandardProjectVariant   bool     `json:"moonStandardProjectVariant"`
+	MoonStandardProjectVariant1  bool     `json:"moonStandardProjectVariant1"`
+	AltVenusBoard                bool     `json:"altVenusBoard"`
+	EscapeVelocityMode           bool     `json:"escapeVelocityMode"`
+	TwoCorpsVariant              bool     `json:"twoCorpsVariant"`
 	CustomCeos                   []any
 	StartingCeos                 int
 	StarWarsExpansion            bool `json:"starWarsExpansion"`
@@ -41,9 +41,10 @@ func TestCreateGameDAO(t *testing.T) {
 
 func TestRequestPlayers(t *testing.T) {
 	tests := []struct {
-		name string
-		in   []NewPlayer
-		want []newPlayer
+		name  string
+		in    []NewPlayer
+		first string
+		want  []newPlayer
 	}{
 		{
 			name: "no conflicts",
@@ -75,21 +76,56 @@ func TestRequestPlayers(t *testing.T) {
 				{Name: "name 5", Color: "black"},
 			},
 		},
+		{
+			name: "first player",
+			in: []NewPlayer{
+				{Name: "name 1", Color: storage.ColorGreen},
+				{Name: "name 2", Color: storage.ColorBlue},
+			},
+			first: "name 2",
+			want: []newPlayer{
+				{Name: "name 1", Color: "green"},
+				{Name: "name 2", Color: "blue", First: true},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := requestPlayers(tt.in)
+			got := requestPlayers(tt.in, tt.first)
 
 			firstCount := 0
 			for i, v := range got {
 				if v.First {
 					firstCount++
 				}
-				got[i].First = false
+				if tt.first == "" {
+					got[i].First = false
+				}
 			}
 			assert.Equal(t, 1, firstCount)
 			assert.DeepEqual(t, got, tt.want)
 		})
 	}
 }
+
+func TestApplyAdvancedSettings(t *testing.T) {
+	req := defaultCreateGame()
+	req.StartingCorporations = 3
+	applyAdvancedSettings(&req, AdvancedSettings{
+		Turmoil:       true,
+		Draft:         true,
+		RandomMA:      RandomMAFull,
+		BannedCards:   []string{"Mohole Lake"},
+		IncludedCards: []string{"Pets"},
+	})
+
+	assert.Equal(t, req.Turmoil, true)
+	assert.Equal(t, req.DraftVariant, true)
+	assert.Equal(t, req.UndoOption, false)
+	assert.Equal(t, req.StartingCorporations, 3)
+	assert.Equal(t, req.StartingCeos, 3)
+	assert.Equal(t, req.RandomMA, "Full random")
+	assert.DeepEqual(t, req.BannedCards, []string{"Mohole Lake"})
+	assert.DeepEqual(t, req.IncludedCards, []string{"Pets"})
+}
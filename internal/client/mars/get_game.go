@@ -10,16 +10,57 @@ import (
 	"path"
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/httpx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 )
 
 type GetGamePlayer struct {
-	Id          string
-	MegaCredits int
-	Score       int
+	Id              string
+	Name            string
+	Color           storage.Color
+	MegaCredits     int
+	TerraformRating int
+	Score           int
+	VictoryPoints   VictoryPoints
 }
 
+type VictoryPoints struct {
+	TerraformRating int
+	Milestones      int
+	Awards          int
+	Greenery        int
+	City            int
+	Cards           int
+	Moon            int
+	PlanetaryTracks int
+	EscapeVelocity  int
+	Total           int
+}
+
+type GameOptions struct {
+	Board        Board
+	CorporateEra bool
+	Prelude      bool
+	Prelude2     bool
+	VenusNext    bool
+	Colonies     bool
+	Turmoil      bool
+	Promo        bool
+	Community    bool
+	Ares         bool
+	Moon         bool
+	Pathfinders  bool
+	Ceos         bool
+	Underworld   bool
+	SolarPhase   bool
+}
+
+const PhaseEnd = "end"
+
 type GetGameModel struct {
 	HasFinished bool
+	Phase       string
+	Generation  int
+	Options     GameOptions
 	Players     []GetGamePlayer
 }
 
@@ -92,16 +133,52 @@ func readResponse(data []byte) (GetGameResponse, error) {
 
 	players := make([]GetGamePlayer, len(resp.Players))
 	for i, p := range resp.Players {
+		vp := p.VPBreakdown
 		players[i] = GetGamePlayer{
-			Id:          p.Id,
-			MegaCredits: p.MegaCredits,
-			Score:       p.VPBreakdown.Total,
+			Id:              p.Id,
+			Name:            p.Name,
+			Color:           storage.Color(p.Color),
+			MegaCredits:     p.MegaCredits,
+			TerraformRating: p.TerraformRating,
+			Score:           vp.Total,
+			VictoryPoints: VictoryPoints{
+				TerraformRating: vp.TerraformRating,
+				Milestones:      vp.Milestones,
+				Awards:          vp.Awards,
+				Greenery:        vp.Greenery,
+				City:            vp.City,
+				Cards:           vp.VictoryPoints,
+				Moon:            vp.MoonHabitats + vp.MoonMines + vp.MoonRoads,
+				PlanetaryTracks: vp.PlanetaryTracks,
+				EscapeVelocity:  vp.EscapeVelocity,
+				Total:           vp.Total,
+			},
 		}
 	}
+	opts := resp.Game.Options
 	return GetGameResponse{
 		Game: GetGameModel{
-			HasFinished: resp.Game.Phase == "end",
-			Players:     players,
+			HasFinished: resp.Game.Phase == PhaseEnd,
+			Phase:       resp.Game.Phase,
+			Generation:  resp.Game.Generation,
+			Options: GameOptions{
+				Board:        opts.BoardName,
+				CorporateEra: opts.CorporateEra,
+				Prelude:      opts.PreludeExtension,
+				Prelude2:     opts.Prelude2Expansion,
+				VenusNext:    opts.VenusNextExtension,
+				Colonies:     opts.ColoniesExtension,
+				Turmoil:      opts.TurmoilExtension,
+				Promo:        opts.PromoCardsOption,
+				Community:    opts.CommunityCardsOption,
+				Ares:         opts.AresExtension,
+				Moon:         opts.MoonExpansion,
+				Pathfinders:  opts.PathfindersExpansion,
+				Ceos:         opts.CeoExtension,
+				Underworld:   opts.UnderworldExpansion,
+				SolarPhase:   opts.SolarPhaseOption,
+			},
+			Players: players,
 		},
 		Raw: raw,
 	}, nil
@@ -113,15 +190,49 @@ type getGameResponse struct {
 }
 
 type getGameGame struct {
-	Phase string `json:"phase"`
+	Phase      string             `json:"phase"`
+	Generation int                `json:"generation"`
+	Options    getGameGameOptions `json:"gameOptions"`
+}
+
+type getGameGameOptions struct {
+	BoardName            Board `json:"boardName"`
+	CorporateEra         bool  `json:"corporateEra"`
+	PreludeExtension     bool  `json:"preludeExtension"`
+	Prelude2Expansion    bool  `json:"prelude2Expansion"`
+	VenusNextExtension   bool  `json:"venusNextExtension"`
+	ColoniesExtension    bool  `json:"coloniesExtension"`
+	TurmoilExtension     bool  `json:"turmoilExtension"`
+	PromoCardsOption     bool  `json:"promoCardsOption"`
+	CommunityCardsOption bool  `json:"communityCardsOption"`
+	AresExtension        bool  `json:"aresExtension"`
+	MoonExpansion        bool  `json:"moonExpansion"`
+	PathfindersExpansion bool  `json:"pathfindersExpansion"`
+	CeoExtension         bool  `json:"ceoExtension"`
+	UnderworldExpansion  bool  `json:"underworldExpansion"`
+	SolarPhaseOption     bool  `json:"solarPhaseOption"`
 }
 
 type getGamePlayer struct {
-	Id          string                        `json:"id"`
-	MegaCredits int                           `json:"megaCredits"`
-	VPBreakdown getGameVictoryPointsBreakdown `json:"victoryPointsBreakdown"`
+	Id              string                        `json:"id"`
+	Name            string                        `json:"name"`
+	Color           string                        `json:"color"`
+	MegaCredits     int                           `json:"megaCredits"`
+	TerraformRating int                           `json:"terraformRating"`
+	VPBreakdown     getGameVictoryPointsBreakdown `json:"victoryPointsBreakdown"`
 }
 
 type getGameVictoryPointsBreakdown struct {
-	Total int `json:"total"`
+	TerraformRating int `json:"terraformRating"`
+	Milestones      int `json:"milestones"`
+	Awards          int `json:"awards"`
+	Greenery        int `json:"greenery"`
+	City            int `json:"city"`
+	EscapeVelocity  int `json:"escapeVelocity"`
+	MoonHabitats    int `json:"moonHabitats"`
+	MoonMines       int `json:"moonMines"`
+	MoonRoads       int `json:"moonRoads"`
+	PlanetaryTracks int `json:"planetaryTracks"`
+	VictoryPoints   int `json:"victoryPoints"`
+	Total           int `json:"total"`
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 )
 
 //go:embed test_get_game_response.json
@@ -16,16 +18,48 @@ func TestGetGameDAO(t *testing.T) {
 
 	assert.DeepEqual(t, resp.Game, GetGameModel{
 		HasFinished: true,
+		Phase:       PhaseEnd,
+		Generation:  13,
+		Options: GameOptions{
+			Board:        BoardElysium,
+			CorporateEra: true,
+			Prelude:      true,
+			VenusNext:    true,
+		},
 		Players: []GetGamePlayer{
 			{
-				Id:          "pfd7bca2ed0cb",
-				MegaCredits: 83,
-				Score:       136,
+				Id:              "pfd7bca2ed0cb",
+				Name:            "Squirrel",
+				Color:           storage.ColorOrange,
+				MegaCredits:     83,
+				TerraformRating: 48,
+				Score:           136,
+				VictoryPoints: VictoryPoints{
+					TerraformRating: 48,
+					Milestones:      10,
+					Awards:          10,
+					Greenery:        12,
+					City:            16,
+					Cards:           40,
+					Total:           136,
+				},
 			},
 			{
-				Id:          "p53cdbf44f911",
-				MegaCredits: 66,
-				Score:       122,
+				Id:              "p53cdbf44f911",
+				Name:            "Andy",
+				Color:           storage.ColorGreen,
+				MegaCredits:     66,
+				TerraformRating: 49,
+				Score:           122,
+				VictoryPoints: VictoryPoints{
+					TerraformRating: 49,
+					Milestones:      5,
+					Awards:          5,
+					Greenery:        16,
+					City:            28,
+					Cards:           19,
+					Total:           122,
+				},
 			},
 		},
 	})
@@ -0,0 +1,79 @@
+package mars
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	maxStartingCorporations = 6
+	maxStartingCeos         = 6
+)
+
+// Validate checks the settings against the rules of the Mars server game creation form
+func (s GameSettings) Validate() error {
+	if !slices.Contains(AllBoards, s.Board) {
+		return fmt.Errorf("unknown board: %s", s.Board)
+	}
+	if s.SolarPhase && !s.VenusNext {
+		return fmt.Errorf("solar phase requires venus next")
+	}
+
+	a := s.Advanced
+	if a == nil {
+		return nil
+	}
+	switch {
+	case a.Prelude2 && !s.Prelude:
+		return fmt.Errorf("prelude 2 requires prelude")
+	case a.Moon && !s.CorporateEra:
+		return fmt.Errorf("moon requires corporate era")
+	case a.PreludeDraft && !s.Prelude:
+		return fmt.Errorf("prelude draft requires prelude")
+	case a.PreludeDraft && !a.Draft:
+		return fmt.Errorf("prelude draft requires draft")
+	case a.IncludeVenusMA && !s.VenusNext:
+		return fmt.Errorf("venus milestones and awards require venus next")
+	case a.AltVenusBoard && !s.VenusNext:
+		return fmt.Errorf("alternative venus board requires venus next")
+	case a.RequiresVenusTrackCompletion && !s.VenusNext:
+		return fmt.Errorf("venus track completion requires venus next")
+	case a.RequiresMoonTrackCompletion && !a.Moon:
+		return fmt.Errorf("moon track completion requires moon")
+	case a.RemoveNegativeGlobalEvents && !a.Turmoil:
+		return fmt.Errorf("removing negative global events requires turmoil")
+	case a.StartingCeos != 0 && !a.Ceos:
+		return fmt.Errorf("starting ceos require ceos")
+	}
+
+	if a.StartingCorporations < 0 || a.StartingCorporations > maxStartingCorporations {
+		return fmt.Errorf("invalid starting corporations: %d", a.StartingCorporations)
+	}
+	if a.StartingCeos < 0 || a.StartingCeos > maxStartingCeos {
+		return fmt.Errorf("invalid starting ceos: %d", a.StartingCeos)
+	}
+	switch a.RandomMA {
+	case "", RandomMANone:
+		if a.IncludeFanMA {
+			return fmt.Errorf("fan milestones and awards require random milestones and awards")
+		}
+	case RandomMALimited, RandomMAFull:
+	default:
+		return fmt.Errorf("unknown random milestones and awards: %s", a.RandomMA)
+	}
+
+	for _, c := range a.BannedCards {
+		if c == "" {
+			return fmt.Errorf("empty banned card")
+		}
+		if slices.Contains(a.IncludedCards, c) {
+			return fmt.Errorf("card is both banned and included: %s", c)
+		}
+	}
+	for _, c := range a.IncludedCards {
+		if c == "" {
+			return fmt.Errorf("empty included card")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package mars
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestGameSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings GameSettings
+		wantErr  string
+	}{
+		{
+			name: "basic",
+			settings: GameSettings{
+				Board:        BoardHellas,
+				CorporateEra: true,
+				Prelude:      true,
+				Colonies:     true,
+			},
+		},
+		{
+			name: "full",
+			settings: GameSettings{
+				Board:        BoardAmazonis,
+				CorporateEra: true,
+				Prelude:      true,
+				VenusNext:    true,
+				SolarPhase:   true,
+				Colonies:     true,
+				Advanced: &AdvancedSettings{
+					Prelude2:                     true,
+					Turmoil:                      true,
+					Moon:                         true,
+					Ceos:                         true,
+					Draft:                        true,
+					PreludeDraft:                 true,
+					StartingCorporations:         4,
+					StartingCeos:                 2,
+					RandomMA:                     RandomMALimited,
+					IncludeVenusMA:               true,
+					IncludeFanMA:                 true,
+					RemoveNegativeGlobalEvents:   true,
+					RequiresVenusTrackCompletion: true,
+					RequiresMoonTrackCompletion:  true,
+					BannedCards:                  []string{"Mohole Lake"},
+					IncludedCards:                []string{"Pets"},
+				},
+			},
+		},
+		{
+			name:     "unknown board",
+			settings: GameSettings{Board: "utopia"},
+			wantErr:  "unknown board: utopia",
+		},
+		{
+			name:     "solar phase without venus",
+			settings: GameSettings{Board: BoardTharsis, SolarPhase: true},
+			wantErr:  "solar phase requires venus next",
+		},
+		{
+			name: "moon without corporate era",
+			settings: GameSettings{
+				Board:    BoardTharsis,
+				Advanced: &AdvancedSettings{Moon: true},
+			},
+			wantErr: "moon requires corporate era",
+		},
+		{
+			name: "prelude draft without draft",
+			settings: GameSettings{
+				Board:    BoardTharsis,
+				Prelude:  true,
+				Advanced: &AdvancedSettings{PreludeDraft: true},
+			},
+			wantErr: "prelude draft requires draft",
+		},
+		{
+			name: "negative global events without turmoil",
+			settings: GameSettings{
+				Board:    BoardTharsis,
+				Advanced: &AdvancedSettings{RemoveNegativeGlobalEvents: true},
+			},
+			wantErr: "removing negative global events requires turmoil",
+		},
+		{
+			name: "too many corporations",
+			settings: GameSettings{
+				Board:    BoardTharsis,
+				Advanced: &AdvancedSettings{StartingCorporations: 7},
+			},
+			wantErr: "invalid starting corporations: 7",
+		},
+		{
+			name: "fan milestones without randomization",
+			settings: GameSettings{
+				Board:    BoardTharsis,
+				Advanced: &AdvancedSettings{IncludeFanMA: true},
+			},
+			wantErr: "fan milestones and awards require random milestones and awards",
+		},
+		{
+			name: "banned and included",
+			settings: GameSettings{
+				Board: BoardTharsis,
+				Advanced: &AdvancedSettings{
+					BannedCards:   []string{"Pets"},
+					IncludedCards: []string{"Pets"},
+				},
+			},
+			wantErr: "card is both banned and included: Pets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.wantErr == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, tt.wantErr)
+			}
+		})
+	}
+}
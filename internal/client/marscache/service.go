@@ -0,0 +1,137 @@
+package marscache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+)
+
+type Config struct {
+	TTL           time.Duration
+	StatsInterval time.Duration
+}
+
+type MarsClient interface {
+	CreateGame(ctx context.Context, game mars.CreateGameRequest) (mars.CreateGameResponse, error)
+	GetGame(ctx context.Context, req mars.GetGameRequest) (mars.GetGameResponse, error)
+	GetPlayerUrl(playerId string) string
+	WaitingFor(ctx context.Context, req mars.WaitingForRequest) (mars.WaitingForResponse, error)
+}
+
+// Service is a TTL bounded cache in front of the mars client.
+// WaitingFor results are keyed by player id and GetGame results by spectator id.
+type Service struct {
+	cfg  Config
+	mars MarsClient
+
+	mu         sync.Mutex
+	waitingFor map[string]entry[mars.WaitingForResponse]
+	games      map[string]entry[mars.GetGameResponse]
+
+	waitingForHits   atomic.Int64
+	waitingForMisses atomic.Int64
+	getGameHits      atomic.Int64
+	getGameMisses    atomic.Int64
+
+	nowFunc func() time.Time
+}
+
+type entry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+func NewService(cfg Config, client MarsClient) *Service {
+	return &Service{
+		cfg:  cfg,
+		mars: client,
+
+		waitingFor: make(map[string]entry[mars.WaitingForResponse]),
+		games:      make(map[string]entry[mars.GetGameResponse]),
+
+		nowFunc: time.Now,
+	}
+}
+
+func (s *Service) CreateGame(ctx context.Context, game mars.CreateGameRequest) (mars.CreateGameResponse, error) {
+	return s.mars.CreateGame(ctx, game)
+}
+
+func (s *Service) GetPlayerUrl(playerId string) string {
+	return s.mars.GetPlayerUrl(playerId)
+}
+
+func (s *Service) WaitingFor(ctx context.Context, req mars.WaitingForRequest) (mars.WaitingForResponse, error) {
+	if v, ok := get(s, s.waitingFor, req.PlayerId); ok {
+		s.waitingForHits.Add(1)
+		return v, nil
+	}
+	s.waitingForMisses.Add(1)
+
+	resp, err := s.mars.WaitingFor(ctx, req)
+	if err != nil {
+		return mars.WaitingForResponse{}, err
+	}
+	set(s, s.waitingFor, req.PlayerId, resp)
+	return resp, nil
+}
+
+func (s *Service) GetGame(ctx context.Context, req mars.GetGameRequest) (mars.GetGameResponse, error) {
+	if v, ok := get(s, s.games, req.SpectatorId); ok {
+		s.getGameHits.Add(1)
+		return v, nil
+	}
+	s.getGameMisses.Add(1)
+
+	resp, err := s.mars.GetGame(ctx, req)
+	if err != nil {
+		return mars.GetGameResponse{}, err
+	}
+	set(s, s.games, req.SpectatorId, resp)
+	return resp, nil
+}
+
+// InvalidateGame drops everything cached for the game. Any input of a player
+// might change what the other players are waited for, so all of them are dropped.
+func (s *Service) InvalidateGame(spectatorId string, playerIds []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.games, spectatorId)
+	for _, id := range playerIds {
+		delete(s.waitingFor, id)
+	}
+}
+
+// InvalidatePlayer drops the cached WaitingFor of a single player
+func (s *Service) InvalidatePlayer(playerId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.waitingFor, playerId)
+}
+
+func get[T any](s *Service, m map[string]entry[T], key string) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := m[key]
+	if !ok || !s.nowFunc().Before(e.expiresAt) {
+		var zero T
+		return zero, false
+	}
+	return e.value, true
+}
+
+func set[T any](s *Service, m map[string]entry[T], key string, value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m[key] = entry[T]{
+		value:     value,
+		expiresAt: s.nowFunc().Add(s.cfg.TTL),
+	}
+}
@@ -0,0 +1,121 @@
+package marscache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+type fakeMars struct {
+	waitingForCalls int
+	getGameCalls    int
+	err             error
+}
+
+func (f *fakeMars) CreateGame(_ context.Context, _ mars.CreateGameRequest) (mars.CreateGameResponse, error) {
+	return mars.CreateGameResponse{}, nil
+}
+
+func (f *fakeMars) GetGame(_ context.Context, req mars.GetGameRequest) (mars.GetGameResponse, error) {
+	f.getGameCalls++
+	if f.err != nil {
+		return mars.GetGameResponse{}, f.err
+	}
+	return mars.GetGameResponse{Raw: map[string]any{"id": req.SpectatorId}}, nil
+}
+
+func (f *fakeMars) GetPlayerUrl(playerId string) string {
+	return "url/" + playerId
+}
+
+func (f *fakeMars) WaitingFor(_ context.Context, _ mars.WaitingForRequest) (mars.WaitingForResponse, error) {
+	f.waitingForCalls++
+	if f.err != nil {
+		return mars.WaitingForResponse{}, f.err
+	}
+	return mars.WaitingForResponse{Colors: []storage.Color{storage.ColorRed}}, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	origin := &fakeMars{}
+	svc := NewService(Config{TTL: time.Minute}, origin)
+	svc.nowFunc = func() time.Time { return now }
+
+	waitingFor := func(playerId string) {
+		t.Helper()
+		resp, err := svc.WaitingFor(ctx, mars.WaitingForRequest{PlayerId: playerId})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, resp, mars.WaitingForResponse{Colors: []storage.Color{storage.ColorRed}})
+	}
+	getGame := func(spectatorId string) {
+		t.Helper()
+		resp, err := svc.GetGame(ctx, mars.GetGameRequest{SpectatorId: spectatorId})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, resp, mars.GetGameResponse{Raw: map[string]any{"id": spectatorId}})
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		waitingFor("p1")
+		waitingFor("p1")
+		waitingFor("p2")
+		getGame("s1")
+		getGame("s1")
+
+		assert.Equal(t, origin.waitingForCalls, 2)
+		assert.Equal(t, origin.getGameCalls, 1)
+		assert.DeepEqual(t, svc.Stats(), Stats{
+			WaitingForHits:   1,
+			WaitingForMisses: 2,
+			GetGameHits:      1,
+			GetGameMisses:    1,
+		})
+	})
+
+	t.Run("invalidate game", func(t *testing.T) {
+		svc.InvalidateGame("s1", []string{"p1"})
+		waitingFor("p1")
+		waitingFor("p2")
+		getGame("s1")
+
+		assert.Equal(t, origin.waitingForCalls, 3)
+		assert.Equal(t, origin.getGameCalls, 2)
+	})
+
+	t.Run("invalidate player", func(t *testing.T) {
+		svc.InvalidatePlayer("p2")
+		waitingFor("p1")
+		waitingFor("p2")
+
+		assert.Equal(t, origin.waitingForCalls, 4)
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		assert.Equal(t, svc.evictExpired(), 3)
+
+		waitingFor("p1")
+		getGame("s1")
+
+		assert.Equal(t, origin.waitingForCalls, 5)
+		assert.Equal(t, origin.getGameCalls, 3)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		origin.err = errors.New("boom")
+		_, err := svc.WaitingFor(ctx, mars.WaitingForRequest{PlayerId: "p3"})
+		assert.ErrorContains(t, err, "boom")
+
+		origin.err = nil
+		waitingFor("p3")
+		assert.Equal(t, origin.waitingForCalls, 7)
+	})
+}
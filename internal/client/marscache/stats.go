@@ -0,0 +1,66 @@
+package marscache
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+)
+
+type Stats struct {
+	WaitingForHits   int64
+	WaitingForMisses int64
+	GetGameHits      int64
+	GetGameMisses    int64
+}
+
+func (s *Service) Stats() Stats {
+	return Stats{
+		WaitingForHits:   s.waitingForHits.Load(),
+		WaitingForMisses: s.waitingForMisses.Load(),
+		GetGameHits:      s.getGameHits.Load(),
+		GetGameMisses:    s.getGameMisses.Load(),
+	}
+}
+
+// Run periodically evicts expired entries and logs hit/miss counters
+func (s *Service) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.StatsInterval):
+		}
+
+		evicted := s.evictExpired()
+		stats := s.Stats()
+		logx.Logger(ctx).Info("mars cache stats",
+			slog.Int64("waiting_for_hits", stats.WaitingForHits),
+			slog.Int64("waiting_for_misses", stats.WaitingForMisses),
+			slog.Int64("get_game_hits", stats.GetGameHits),
+			slog.Int64("get_game_misses", stats.GetGameMisses),
+			slog.Int("evicted", evicted))
+	}
+}
+
+func (s *Service) evictExpired() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.nowFunc()
+	evicted := 0
+	for k, e := range s.waitingFor {
+		if !now.Before(e.expiresAt) {
+			delete(s.waitingFor, k)
+			evicted++
+		}
+	}
+	for k, e := range s.games {
+		if !now.Before(e.expiresAt) {
+			delete(s.games, k)
+			evicted++
+		}
+	}
+	return evicted
+}
@@ -0,0 +1,49 @@
+package push
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidToken is returned by notifiers when the device token is no longer valid
+// and should not be used again.
+var ErrInvalidToken = errors.New("invalid device token")
+
+// ErrPermanent is returned by notifiers when the push service rejected the notification
+// and sending it again would fail the same way.
+var ErrPermanent = errors.New("rejected permanently")
+
+// Notification is a platform-neutral push notification.
+// Notifications without title and body only update the badge.
+type Notification struct {
+	Title    string
+	Subtitle string
+	Body     string
+	Badge    int
+	Sound    string
+
+	// ThreadId groups notifications about the same subject,
+	// a notification replaces a delivered one with the same CollapseId.
+	ThreadId   string
+	CollapseId string
+	// URL is opened when the notification is tapped
+	URL string
+
+	// Background notifications have no content, they make the app refresh the game list.
+	// Only APNs delivers them, other notifiers skip them.
+	Background bool
+}
+
+// Result describes the response of the push service, it is returned along with errors
+// if the service responded at all.
+type Result struct {
+	// MessageId is the id assigned to the notification by the push service
+	MessageId string
+	Status    int
+	// Reason is the error reported by the push service
+	Reason string
+}
+
+type Notifier interface {
+	Push(ctx context.Context, device []byte, n Notification) (Result, error)
+}
@@ -0,0 +1,74 @@
+package push
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryError is returned by notifiers when the push service is temporarily unable
+// to accept the notification. After is zero if the service didn't say when to retry.
+type RetryError struct {
+	After time.Duration
+	Err   error
+}
+
+func (e *RetryError) Error() string {
+	if e.After > 0 {
+		return fmt.Sprintf("retry after %s: %s", e.After, e.Err)
+	}
+	return fmt.Sprintf("retry later: %s", e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter returns the delay requested by the push service if the error is retryable
+func RetryAfter(err error) (time.Duration, bool) {
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		return retryErr.After, true
+	}
+	return 0, false
+}
+
+// IsRetryStatus reports whether the response status means the request may succeed later
+func IsRetryStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusInternalServerError ||
+		status == http.StatusServiceUnavailable
+}
+
+// IsPermanentStatus reports whether the response status means the request is rejected for good.
+// Unauthorized and timeout statuses are not permanent, credentials and connections are renewed.
+func IsPermanentStatus(status int) bool {
+	return status >= 400 && status < 500 && status != http.StatusUnauthorized &&
+		status != http.StatusRequestTimeout && status != http.StatusTooManyRequests
+}
+
+// NewRetryError creates an error from a response with a retry status, the body is read for details
+func NewRetryError(resp *http.Response, now time.Time) *RetryError {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return &RetryError{
+		After: ParseRetryAfter(resp.Header.Get("Retry-After"), now),
+		Err:   fmt.Errorf("status %d, body: %s", resp.StatusCode, strings.TrimSpace(string(body))),
+	}
+}
+
+// ParseRetryAfter parses the Retry-After header which is either a number of seconds or an HTTP date
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0)
+	}
+	return 0
+}
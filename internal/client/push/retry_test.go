@@ -0,0 +1,54 @@
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "120", want: 2 * time.Minute},
+		{value: "-5", want: 0},
+		{value: "Sat, 10 Aug 2024 12:00:30 GMT", want: 30 * time.Second},
+		{value: "Sat, 10 Aug 2024 11:00:00 GMT", want: 0},
+		{value: "soon", want: 0},
+	} {
+		assert.Equal(t, ParseRetryAfter(tc.value, now), tc.want, "value %q", tc.value)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	err := fmt.Errorf("failed to send: %w", &RetryError{After: time.Minute, Err: fmt.Errorf("status 429")})
+	after, ok := RetryAfter(err)
+	assert.Assert(t, ok)
+	assert.Equal(t, after, time.Minute)
+
+	_, ok = RetryAfter(fmt.Errorf("status 400"))
+	assert.Assert(t, !ok)
+}
+
+func TestIsPermanentStatus(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		want   bool
+	}{
+		{status: http.StatusBadRequest, want: true},
+		{status: http.StatusForbidden, want: true},
+		{status: http.StatusRequestEntityTooLarge, want: true},
+		{status: http.StatusUnauthorized, want: false},
+		{status: http.StatusRequestTimeout, want: false},
+		{status: http.StatusTooManyRequests, want: false},
+		{status: http.StatusInternalServerError, want: false},
+	} {
+		assert.Equal(t, IsPermanentStatus(tc.status), tc.want, "status %d", tc.status)
+	}
+}
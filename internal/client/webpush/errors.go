@@ -0,0 +1,11 @@
+package webpush
+
+import (
+	"fmt"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+var (
+	ErrGone = fmt.Errorf("subscription gone: %w", push.ErrInvalidToken)
+)
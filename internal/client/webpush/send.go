@@ -0,0 +1,145 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+const vapidTokenAge = 12 * time.Hour
+
+// Message is the payload the service worker receives
+type Message struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+	Body     string `json:"body"`
+	Badge    int    `json:"badge"`
+	Tag      string `json:"tag,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// maxTopicSize is the limit of the Topic header, see RFC 8030 section 5.4
+const maxTopicSize = 32
+
+// Send delivers the encrypted payload, a pending message with the same non-empty topic is replaced
+func (s *Service) Send(ctx context.Context, sub Subscription, payload []byte, topic string) (push.Result, error) {
+	asKey, err := ecdh.P256().GenerateKey(s.random)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(s.random, salt); err != nil {
+		return push.Result{}, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	body, err := encrypt(asKey, salt, sub, payload)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to encrypt payload: %w", err)
+	}
+
+	authorization, err := s.vapidAuthorization(sub.Endpoint)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to create authorization: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(s.ttl.Seconds())))
+	req.Header.Set("Urgency", "normal")
+	if topic != "" && len(topic) <= maxTopicSize {
+		req.Header.Set("Topic", topic)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	// The push service identifies the created message by its location
+	result := push.Result{MessageId: resp.Header.Get("Location"), Status: resp.StatusCode}
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return result, ErrGone
+	}
+	if push.IsRetryStatus(resp.StatusCode) {
+		return result, fmt.Errorf("failed to send notification: %w", push.NewRetryError(resp, s.now()))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return result, fmt.Errorf("failed to read response body: %w", err)
+		}
+		if push.IsPermanentStatus(resp.StatusCode) {
+			return result, fmt.Errorf("failed to send notification: %w: status %d, body: %s",
+				push.ErrPermanent, resp.StatusCode, respBody)
+		}
+		return result, fmt.Errorf("failed to send notification: status %d, body: %s", resp.StatusCode, respBody)
+	}
+	return result, nil
+}
+
+// Push sends a platform-neutral notification. Browsers must show a notification for every push,
+// so badge only and background notifications are not sent.
+func (s *Service) Push(ctx context.Context, device []byte, n push.Notification) (push.Result, error) {
+	if n.Background || (n.Title == "" && n.Body == "") {
+		return push.Result{}, nil
+	}
+
+	sub, err := ParseSubscription(device)
+	if err != nil {
+		return push.Result{}, fmt.Errorf("%w: %w", push.ErrInvalidToken, err)
+	}
+	payload, err := json.Marshal(Message{
+		Title:    n.Title,
+		Subtitle: n.Subtitle,
+		Body:     n.Body,
+		Badge:    n.Badge,
+		Tag:      n.ThreadId,
+		URL:      n.URL,
+	})
+	if err != nil {
+		return push.Result{}, fmt.Errorf("failed to marshal message: %w", err)
+	}
+	return s.Send(ctx, sub, payload, n.CollapseId)
+}
+
+// vapidAuthorization creates the authorization header described in RFC 8292
+func (s *Service) vapidAuthorization(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+	audience := u.Scheme + "://" + u.Host
+
+	now := s.now()
+	token, err := jwt.NewBuilder().
+		Audience([]string{audience}).
+		Expiration(now.Add(vapidTokenAge)).
+		Subject(s.subject).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("failed to build token: %w", err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.ES256, s.key))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return fmt.Sprintf("vapid t=%s, k=%s", signed, base64.RawURLEncoding.EncodeToString(s.publicKey)), nil
+}
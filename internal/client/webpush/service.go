@@ -0,0 +1,83 @@
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+type Config struct {
+	// Subject is a mailto: or https: contact of the application server
+	Subject string
+	// PrivateKey is a base64url encoded P-256 VAPID private key
+	PrivateKey string
+	TTL        time.Duration
+}
+
+type Client interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type Service struct {
+	subject   string
+	key       *ecdsa.PrivateKey
+	publicKey []byte
+	ttl       time.Duration
+
+	client Client
+
+	now    func() time.Time
+	random io.Reader
+}
+
+func NewService(cfg Config, client Client) (*Service, error) {
+	d, err := decodeKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+	privateKey, err := ecdh.P256().NewPrivateKey(d)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	publicKey := privateKey.PublicKey().Bytes()
+
+	return &Service{
+		subject: cfg.Subject,
+		key: &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(publicKey[1:33]),
+				Y:     new(big.Int).SetBytes(publicKey[33:]),
+			},
+			D: new(big.Int).SetBytes(d),
+		},
+		publicKey: publicKey,
+		ttl:       cfg.TTL,
+
+		client: client,
+		now:    time.Now,
+		random: rand.Reader,
+	}, nil
+}
+
+// PublicKey returns the VAPID public key browsers subscribe with
+func (s *Service) PublicKey() string {
+	return base64.RawURLEncoding.EncodeToString(s.publicKey)
+}
+
+// GenerateKeys returns a new base64url encoded VAPID key pair
+func GenerateKeys() (privateKey string, publicKey string, err error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(key.Bytes()),
+		base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
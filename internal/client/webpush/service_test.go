@@ -0,0 +1,228 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+)
+
+type fakePushService struct {
+	status     int
+	retryAfter string
+	requests   []*http.Request
+	bodies     [][]byte
+}
+
+func (f *fakePushService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, body)
+	if f.retryAfter != "" {
+		w.Header().Set("Retry-After", f.retryAfter)
+	}
+	if f.status == http.StatusCreated {
+		w.Header().Set("Location", "/message/1")
+	}
+	w.WriteHeader(f.status)
+}
+
+func newTestService(t *testing.T, f *fakePushService) (*Service, Subscription) {
+	t.Helper()
+
+	server := httptest.NewTLSServer(f)
+	t.Cleanup(server.Close)
+
+	privateKey, _, err := GenerateKeys()
+	assert.NilError(t, err)
+	s, err := NewService(Config{
+		Subject:    "mailto:admin@example.com",
+		PrivateKey: privateKey,
+		TTL:        time.Hour,
+	}, server.Client())
+	assert.NilError(t, err)
+
+	uaKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	assert.NilError(t, err)
+	return s, Subscription{
+		Endpoint: server.URL + "/push/subscription-id",
+		Keys: SubscriptionKeys{
+			P256dh: base64.RawURLEncoding.EncodeToString(uaKey.PublicKey().Bytes()),
+			Auth:   "BTBZMqHH6r4Tts7J_aSIgg",
+		},
+	}
+}
+
+func TestService_Push(t *testing.T) {
+	ctx := context.Background()
+	alert := push.Notification{
+		Title: "Mars awaits you!",
+		Body:  "1 game is awaiting for your decision",
+		Badge: 1,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusCreated}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		result, err := s.Push(ctx, device, alert)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, result, push.Result{MessageId: "/message/1", Status: http.StatusCreated})
+		assert.Equal(t, len(f.requests), 1)
+
+		r := f.requests[0]
+		assert.Equal(t, r.URL.Path, "/push/subscription-id")
+		assert.Equal(t, r.Header.Get("Content-Encoding"), "aes128gcm")
+		assert.Equal(t, r.Header.Get("TTL"), "3600")
+		assert.Equal(t, r.Header.Get("Topic"), "")
+		// salt + record size + key id length + key id + delimiter + tag
+		assert.Assert(t, len(f.bodies[0]) > 16+4+1+65+1+16)
+
+		authorization := r.Header.Get("Authorization")
+		assert.Assert(t, strings.HasPrefix(authorization, "vapid t="), authorization)
+		token, key, ok := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+		assert.Assert(t, ok)
+		assert.Equal(t, key, s.PublicKey())
+
+		parsed, err := jwt.Parse([]byte(token), jwt.WithKey(jwa.ES256, &s.key.PublicKey))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, parsed.Audience(), []string{"https://" + r.Host})
+		assert.Equal(t, parsed.Subject(), "mailto:admin@example.com")
+	})
+
+	t.Run("topic", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusCreated}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		n := alert
+		n.CollapseId = "game-id"
+		_, err = s.Push(ctx, device, n)
+		assert.NilError(t, err)
+
+		n.CollapseId = strings.Repeat("x", maxTopicSize+1)
+		_, err = s.Push(ctx, device, n)
+		assert.NilError(t, err)
+
+		assert.Equal(t, len(f.requests), 2)
+		assert.Equal(t, f.requests[0].Header.Get("Topic"), "game-id")
+		assert.Equal(t, f.requests[1].Header.Get("Topic"), "")
+	})
+
+	t.Run("badge only and background are skipped", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusCreated}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		_, err = s.Push(ctx, device, push.Notification{Badge: 3})
+		assert.NilError(t, err)
+		_, err = s.Push(ctx, device, push.Notification{Title: "title", Body: "body", Background: true})
+		assert.NilError(t, err)
+		assert.Equal(t, len(f.requests), 0)
+	})
+
+	t.Run("gone", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusGone}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		_, err = s.Push(ctx, device, alert)
+		assert.ErrorIs(t, err, ErrGone)
+		assert.ErrorIs(t, err, push.ErrInvalidToken)
+	})
+
+	t.Run("payload too large", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusRequestEntityTooLarge}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		_, err = s.Push(ctx, device, alert)
+		assert.ErrorContains(t, err, "status 413")
+		assert.ErrorIs(t, err, push.ErrPermanent)
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusInternalServerError}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		_, err = s.Push(ctx, device, alert)
+		assert.ErrorContains(t, err, "status 500")
+		_, ok := push.RetryAfter(err)
+		assert.Assert(t, ok)
+	})
+
+	t.Run("too many requests", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusTooManyRequests, retryAfter: "30"}
+		s, sub := newTestService(t, f)
+		device, err := sub.Marshal()
+		assert.NilError(t, err)
+
+		_, err = s.Push(ctx, device, alert)
+		after, ok := push.RetryAfter(err)
+		assert.Assert(t, ok)
+		assert.Equal(t, after, 30*time.Second)
+	})
+
+	t.Run("invalid subscription", func(t *testing.T) {
+		f := &fakePushService{status: http.StatusCreated}
+		s, _ := newTestService(t, f)
+
+		_, err := s.Push(ctx, []byte("not a subscription"), alert)
+		assert.ErrorIs(t, err, push.ErrInvalidToken)
+	})
+}
+
+func TestSubscription_Validate(t *testing.T) {
+	valid := Subscription{
+		Endpoint: "https://fcm.googleapis.com/fcm/send/JzLQ3raZJfFBR0aqvOMsLrt54w4rJUsV",
+		Keys: SubscriptionKeys{
+			P256dh: "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+			Auth:   "BTBZMqHH6r4Tts7J_aSIgg==",
+		},
+	}
+	assert.NilError(t, valid.Validate())
+
+	wnsEndpoint := valid
+	wnsEndpoint.Endpoint = "https://wns2-par02p.notify.windows.com/w/?token=BQYAAAD"
+	assert.NilError(t, wnsEndpoint.Validate())
+
+	httpEndpoint := valid
+	httpEndpoint.Endpoint = "http://fcm.googleapis.com/fcm/send/JzLQ3raZJfFBR0aqvOMsLrt54w4rJUsV"
+	assert.ErrorContains(t, httpEndpoint.Validate(), "https")
+
+	unknownHost := valid
+	unknownHost.Endpoint = "https://push.example.net/push"
+	assert.ErrorContains(t, unknownHost.Validate(), "not a known push service")
+
+	lookalikeHost := valid
+	lookalikeHost.Endpoint = "https://evilfcm.googleapis.com.example.net/push"
+	assert.ErrorContains(t, lookalikeHost.Validate(), "not a known push service")
+
+	shortAuth := valid
+	shortAuth.Keys.Auth = "BTBZ"
+	assert.ErrorContains(t, shortAuth.Validate(), "auth secret")
+
+	badKey := valid
+	badKey.Keys.P256dh = "BTBZMqHH6r4Tts7J_aSIgg"
+	assert.ErrorContains(t, badKey.Validate(), "p256dh")
+}
@@ -0,0 +1,110 @@
+package webpush
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const authSecretSize = 16
+
+// pushServiceHosts are the push services of the browsers, subdomains are allowed.
+// Other endpoints are rejected so that nobody can make the manager send requests to arbitrary hosts.
+var pushServiceHosts = []string{
+	"fcm.googleapis.com",                // Chrome and other Chromium browsers
+	"updates.push.services.mozilla.com", // Firefox
+	"push.apple.com",                    // Safari
+	"notify.windows.com",                // Edge
+}
+
+// Subscription is a browser push subscription in the format of PushSubscription.toJSON()
+type Subscription struct {
+	Endpoint string           `json:"endpoint"`
+	Keys     SubscriptionKeys `json:"keys"`
+}
+
+type SubscriptionKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+func ParseSubscription(data []byte) (Subscription, error) {
+	var sub Subscription
+	if err := json.Unmarshal(data, &sub); err != nil {
+		return Subscription{}, fmt.Errorf("failed to unmarshal subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func (s Subscription) Marshal() ([]byte, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal subscription: %w", err)
+	}
+	return data, nil
+}
+
+func (s Subscription) Validate() error {
+	u, err := url.Parse(s.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("endpoint must be an https url")
+	}
+	if !isPushServiceHost(u.Hostname()) {
+		return fmt.Errorf("endpoint host is not a known push service: %s", u.Hostname())
+	}
+	if _, err := s.userAgentKey(); err != nil {
+		return err
+	}
+	if _, err := s.authSecret(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func isPushServiceHost(host string) bool {
+	for _, h := range pushServiceHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Subscription) userAgentKey() ([]byte, error) {
+	key, err := decodeKey(s.Keys.P256dh)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	if len(key) != 65 || key[0] != 0x04 {
+		return nil, fmt.Errorf("p256dh key must be an uncompressed P-256 point")
+	}
+	return key, nil
+}
+
+func (s Subscription) authSecret() ([]byte, error) {
+	secret, err := decodeKey(s.Keys.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth secret: %w", err)
+	}
+	if len(secret) != authSecretSize {
+		return nil, fmt.Errorf("auth secret must be %d bytes", authSecretSize)
+	}
+	return secret, nil
+}
+
+// decodeKey accepts base64url keys with or without padding as browsers differ
+func decodeKey(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(trimPadding(s))
+}
+
+func trimPadding(s string) string {
+	for len(s) > 0 && s[len(s)-1] == '=' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,7 @@
+ALTER TABLE manager_users
+    ADD COLUMN glicko_rating DOUBLE PRECISION NOT NULL default 1500,
+    ADD COLUMN glicko_deviation DOUBLE PRECISION NOT NULL default 350,
+    ADD COLUMN glicko_volatility DOUBLE PRECISION NOT NULL default 0.06;
+
+ALTER TABLE manager_games
+    ADD COLUMN glicko_results JSONB;
@@ -0,0 +1,19 @@
+CREATE TABLE manager_rating_history (
+    user_id                 TEXT NOT NULL,
+    game_id                 TEXT NOT NULL,
+    old_elo                 BIGINT NOT NULL,
+    new_elo                 BIGINT NOT NULL,
+    old_glicko_rating       DOUBLE PRECISION NOT NULL,
+    new_glicko_rating       DOUBLE PRECISION NOT NULL,
+    old_glicko_deviation    DOUBLE PRECISION NOT NULL,
+    new_glicko_deviation    DOUBLE PRECISION NOT NULL,
+    old_glicko_volatility   DOUBLE PRECISION NOT NULL,
+    new_glicko_volatility   DOUBLE PRECISION NOT NULL,
+    created_at              TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(user_id, game_id),
+    CONSTRAINT fk_rating_history_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id),
+    CONSTRAINT fk_rating_history_games_id FOREIGN KEY (game_id) REFERENCES manager_games(id)
+);
+
+CREATE INDEX manager_idx_rating_history_user_created ON manager_rating_history(user_id, created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE manager_bucket_ratings (
+    user_id             TEXT NOT NULL,
+    bucket              TEXT NOT NULL,
+    elo                 BIGINT NOT NULL default 1000,
+    glicko_rating       DOUBLE PRECISION NOT NULL default 1500,
+    glicko_deviation    DOUBLE PRECISION NOT NULL default 350,
+    glicko_volatility   DOUBLE PRECISION NOT NULL default 0.06,
+    games_count         INT NOT NULL default 0,
+
+    PRIMARY KEY(user_id, bucket),
+    CONSTRAINT fk_bucket_ratings_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_bucket_ratings_bucket ON manager_bucket_ratings(bucket);
@@ -0,0 +1,2 @@
+ALTER TABLE manager_games
+    ADD COLUMN settings JSONB;
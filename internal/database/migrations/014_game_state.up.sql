@@ -0,0 +1,3 @@
+ALTER TABLE manager_games
+    ADD COLUMN state JSONB,
+    ADD COLUMN state_updated_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,2 @@
+ALTER TABLE manager_users
+    ADD COLUMN device_platform TEXT NOT NULL default 'ios';
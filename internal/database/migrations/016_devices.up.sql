@@ -0,0 +1,21 @@
+CREATE TABLE manager_devices (
+    token           BYTEA NOT NULL CHECK (length(token) > 0),
+    user_id         TEXT NOT NULL,
+    platform        TEXT NOT NULL,
+    environment     TEXT NOT NULL,
+    app_version     TEXT NOT NULL DEFAULT '',
+    last_seen_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(token),
+    CONSTRAINT fk_devices_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_devices_user_id ON manager_devices(user_id);
+
+INSERT INTO manager_devices (token, user_id, platform, environment, last_seen_at)
+    SELECT device_token, id, device_platform, device_token_type, now() FROM manager_users
+        WHERE length(device_token) > 0
+    ON CONFLICT DO NOTHING;
+
+-- manager_users.device_token, device_token_type and device_platform are still read by the previous release,
+-- devices are written there too until a later migration drops them.
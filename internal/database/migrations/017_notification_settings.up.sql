@@ -0,0 +1,6 @@
+ALTER TABLE manager_users
+    ADD COLUMN notification_settings JSONB,
+    ADD COLUMN notification_deferred_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX manager_idx_users_notification_deferred_until ON manager_users(notification_deferred_until)
+    WHERE notification_deferred_until is not null;
@@ -0,0 +1,8 @@
+ALTER TABLE manager_games
+    ADD COLUMN results_announced_at TIMESTAMP WITH TIME ZONE;
+
+-- Games rated before announcements existed are not announced
+UPDATE manager_games SET results_announced_at = finished_at WHERE elo_results is not null;
+
+CREATE INDEX manager_idx_games_unannounced ON manager_games(finished_at)
+    WHERE elo_results is not null AND results_announced_at is null;
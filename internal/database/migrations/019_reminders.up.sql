@@ -0,0 +1,9 @@
+ALTER TABLE manager_game_players
+    ADD COLUMN waiting_since TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN reminders_sent INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE manager_games
+    ADD COLUMN nudged_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX manager_idx_game_players_waiting_since ON manager_game_players(waiting_since)
+    WHERE waiting_since is not null;
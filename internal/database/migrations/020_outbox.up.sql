@@ -0,0 +1,19 @@
+CREATE TABLE manager_outbox (
+    id              BIGSERIAL,
+    user_id         TEXT NOT NULL,
+    token           BYTEA NOT NULL,
+    payload         JSONB NOT NULL,
+    status          TEXT NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    last_error      TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(id),
+    CONSTRAINT fk_outbox_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_outbox_pending ON manager_outbox(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX manager_idx_outbox_user_id ON manager_outbox(user_id, created_at);
+CREATE INDEX manager_idx_outbox_finished ON manager_outbox(updated_at) WHERE status <> 'pending';
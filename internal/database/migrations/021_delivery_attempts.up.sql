@@ -0,0 +1,23 @@
+CREATE TABLE manager_delivery_attempts (
+    id           BIGSERIAL,
+    outbox_id    BIGINT NOT NULL,
+    user_id      TEXT NOT NULL,
+    token        BYTEA NOT NULL,
+    platform     TEXT NOT NULL,
+    environment  TEXT NOT NULL,
+    payload_hash TEXT NOT NULL,
+    message_id   TEXT NOT NULL,
+    status       INTEGER NOT NULL,
+    reason       TEXT NOT NULL,
+    error        TEXT NOT NULL,
+    latency_ms   BIGINT NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(id),
+    CONSTRAINT fk_delivery_attempts_outbox_id FOREIGN KEY (outbox_id) REFERENCES manager_outbox(id) ON DELETE CASCADE,
+    CONSTRAINT fk_delivery_attempts_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_delivery_attempts_user_id ON manager_delivery_attempts(user_id, created_at);
+CREATE INDEX manager_idx_delivery_attempts_outbox_id ON manager_delivery_attempts(outbox_id);
+CREATE INDEX manager_idx_delivery_attempts_created_at ON manager_delivery_attempts(created_at);
@@ -0,0 +1,30 @@
+CREATE TABLE manager_lobbies (
+    id          TEXT NOT NULL,
+    host_id     TEXT NOT NULL,
+    settings    JSONB NOT NULL,
+    status      TEXT NOT NULL,
+    game_id     TEXT,
+    -- Set while the game is being created, a stale claim can be taken over
+    claimed_at  TIMESTAMP WITH TIME ZONE,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(id),
+    CONSTRAINT fk_lobbies_users_id FOREIGN KEY (host_id) REFERENCES manager_users(id),
+    CONSTRAINT fk_lobbies_games_id FOREIGN KEY (game_id) REFERENCES manager_games(id)
+);
+
+CREATE TABLE manager_lobby_members (
+    lobby_id     TEXT NOT NULL,
+    user_id      TEXT NOT NULL,
+    status       TEXT NOT NULL,
+    invited_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY(lobby_id, user_id),
+    CONSTRAINT fk_lobby_members_lobbies_id FOREIGN KEY (lobby_id) REFERENCES manager_lobbies(id),
+    CONSTRAINT fk_lobby_members_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_lobby_members_user_id ON manager_lobby_members(user_id);
@@ -0,0 +1,10 @@
+CREATE TABLE manager_matchmaking_queue (
+    user_id     TEXT NOT NULL,
+    preferences JSONB NOT NULL,
+    enqueued_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(user_id),
+    CONSTRAINT fk_matchmaking_queue_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_matchmaking_queue_enqueued_at ON manager_matchmaking_queue(enqueued_at);
@@ -0,0 +1,9 @@
+ALTER TABLE manager_games
+    ADD COLUMN series_id TEXT,
+    ADD COLUMN rematch_of TEXT,
+    -- The rematch is reserved on the previous game while the Mars server creates it
+    ADD COLUMN rematch_claimed_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT fk_games_rematch_of FOREIGN KEY (rematch_of) REFERENCES manager_games(id);
+
+-- A game can be rematched once, the series goes on from the rematch
+CREATE UNIQUE INDEX manager_idx_games_rematch_of ON manager_games(rematch_of) WHERE rematch_of is not null;
@@ -0,0 +1,24 @@
+CREATE TABLE manager_presets (
+    id          TEXT NOT NULL,
+    owner_id    TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    settings    JSONB NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY(id),
+    CONSTRAINT fk_presets_users_id FOREIGN KEY (owner_id) REFERENCES manager_users(id)
+);
+
+CREATE UNIQUE INDEX manager_idx_presets_owner_id_name ON manager_presets(owner_id, name);
+
+CREATE TABLE manager_preset_users (
+    preset_id   TEXT NOT NULL,
+    user_id     TEXT NOT NULL,
+
+    PRIMARY KEY(preset_id, user_id),
+    CONSTRAINT fk_preset_users_presets_id FOREIGN KEY (preset_id) REFERENCES manager_presets(id),
+    CONSTRAINT fk_preset_users_users_id FOREIGN KEY (user_id) REFERENCES manager_users(id)
+);
+
+CREATE INDEX manager_idx_preset_users_user_id ON manager_preset_users(user_id);
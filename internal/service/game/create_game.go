@@ -10,7 +10,7 @@ import (
 	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 )
 
-func (s *Service) CreateGame(ctx context.Context, users []*storage.User, settings mars.GameSettings) error {
+func (s *Service) CreateGame(ctx context.Context, users []*storage.User, settings mars.GameSettings) (string, error) {
 	reqPlayers := make([]mars.NewPlayer, len(users))
 	for i, p := range users {
 		reqPlayers[i] = mars.NewPlayer{
@@ -23,12 +23,25 @@ func (s *Service) CreateGame(ctx context.Context, users []*storage.User, setting
 		Settings: settings,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create mars client game: %w", err)
+		return "", fmt.Errorf("failed to create mars client game: %w", err)
 	}
 	logx.Logger(ctx).Info("create game", slog.Any("users", users), slog.Any("response", resp))
 
+	settingsToStore := &storage.GameSettings{
+		Board:        string(settings.Board),
+		CorporateEra: settings.CorporateEra,
+		Prelude:      settings.Prelude,
+		VenusNext:    settings.VenusNext,
+		SolarPhase:   settings.SolarPhase,
+		Colonies:     settings.Colonies,
+		Seed:         resp.Seed,
+		Advanced:     advancedToStore(settings.Advanced),
+	}
 	gamePlayers := make([]storage.Player, len(users))
 	for i, u := range users {
+		if u.Nickname == resp.FirstPlayer {
+			settingsToStore.FirstUserId = u.UserId
+		}
 		for _, p := range resp.Players {
 			if u.Nickname == p.Name {
 				gamePlayers[i] = storage.Player{
@@ -39,7 +52,7 @@ func (s *Service) CreateGame(ctx context.Context, users []*storage.User, setting
 			}
 		}
 		if gamePlayers[i] == (storage.Player{}) {
-			return fmt.Errorf("player not found: %s", u.Nickname)
+			return "", fmt.Errorf("player not found: %s", u.Nickname)
 		}
 	}
 
@@ -48,8 +61,46 @@ func (s *Service) CreateGame(ctx context.Context, users []*storage.User, setting
 		SpectatorId: resp.SpectatorId,
 		ExpiresAt:   resp.PurgeDate,
 		Players:     gamePlayers,
+		Settings:    settingsToStore,
 	}); err != nil {
-		return fmt.Errorf("failed to store the game: %w", err)
+		return "", fmt.Errorf("failed to store the game: %w", err)
+	}
+	return resp.Id, nil
+}
+
+func advancedToStore(a *mars.AdvancedSettings) *storage.AdvancedSettings {
+	if a == nil {
+		return nil
+	}
+	return &storage.AdvancedSettings{
+		Prelude2:                     a.Prelude2,
+		Turmoil:                      a.Turmoil,
+		Promo:                        a.Promo,
+		Community:                    a.Community,
+		Ares:                         a.Ares,
+		Moon:                         a.Moon,
+		Pathfinders:                  a.Pathfinders,
+		Ceos:                         a.Ceos,
+		Underworld:                   a.Underworld,
+		Undo:                         a.Undo,
+		ShowTimers:                   a.ShowTimers,
+		FastMode:                     a.FastMode,
+		ShowOtherPlayersVP:           a.ShowOtherPlayersVP,
+		Draft:                        a.Draft,
+		InitialDraft:                 a.InitialDraft,
+		PreludeDraft:                 a.PreludeDraft,
+		StartingCorporations:         a.StartingCorporations,
+		StartingCeos:                 a.StartingCeos,
+		ShuffleMap:                   a.ShuffleMap,
+		RandomMA:                     string(a.RandomMA),
+		IncludeVenusMA:               a.IncludeVenusMA,
+		IncludeFanMA:                 a.IncludeFanMA,
+		RemoveNegativeGlobalEvents:   a.RemoveNegativeGlobalEvents,
+		AltVenusBoard:                a.AltVenusBoard,
+		RequiresVenusTrackCompletion: a.RequiresVenusTrackCompletion,
+		RequiresMoonTrackCompletion:  a.RequiresMoonTrackCompletion,
+		TwoCorpsVariant:              a.TwoCorpsVariant,
+		BannedCards:                  a.BannedCards,
+		IncludedCards:                a.IncludedCards,
 	}
-	return nil
 }
@@ -0,0 +1,50 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+// gameState returns the stored state of the game if it is fresh enough,
+// otherwise it asks the Mars server on behalf of the player and stores the result.
+func (s *Service) gameState(ctx context.Context, game *storage.Game, playerId string) (storage.GameState, error) {
+	if game.State != nil && time.Since(game.State.UpdatedAt) < s.cfg.StateMaxAge {
+		return *game.State, nil
+	}
+
+	resp, err := s.mars.GetGame(ctx, mars.GetGameRequest{SpectatorId: game.SpectatorId})
+	if err != nil {
+		return storage.GameState{}, fmt.Errorf("get game from mars: %w", err)
+	}
+	return s.updateGameState(ctx, game.GameId, playerId, resp)
+}
+
+// updateGameState stores the state of the game, waited colors are requested on behalf of the player
+func (s *Service) updateGameState(ctx context.Context, gameId string, playerId string,
+	game mars.GetGameResponse) (storage.GameState, error) {
+	state := storage.GameState{
+		Phase:        game.Game.Phase,
+		Generation:   game.Game.Generation,
+		PlayersCount: len(game.Game.Players),
+		Players:      make([]storage.GameStatePlayer, len(game.Game.Players)),
+	}
+	for i, p := range game.Game.Players {
+		state.Players[i] = storage.GameStatePlayer{Name: p.Name, Color: p.Color}
+	}
+	if !game.Game.HasFinished {
+		wait, err := s.mars.WaitingFor(ctx, mars.WaitingForRequest{PlayerId: playerId})
+		if err != nil {
+			return storage.GameState{}, fmt.Errorf("waiting for (%s): %w", playerId, err)
+		}
+		state.WaitingFor = wait.Colors
+	}
+
+	if err := s.storage.UpdateGameState(ctx, gameId, state); err != nil {
+		return storage.GameState{}, fmt.Errorf("update game state: %w", err)
+	}
+	return state, nil
+}
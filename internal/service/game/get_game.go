@@ -0,0 +1,170 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+type GameDetails struct {
+	GameId     string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	FinishedAt *time.Time
+	Settings   *storage.GameSettings
+	Generation int
+	Players    []*GameDetailsPlayer
+	SeriesId   string
+	RematchOf  string
+}
+
+type GameDetailsPlayer struct {
+	UserId          string
+	Nickname        string
+	Color           storage.Color
+	PlayURL         string
+	Place           int // Zero until the game has results
+	MegaCredits     int
+	TerraformRating int
+	VictoryPoints   mars.VictoryPoints
+	EloChange       *EloChange    // Set once ratings are applied
+	GlickoChange    *GlickoChange // Set once ratings are applied
+}
+
+type EloChange struct {
+	OldElo int64
+	NewElo int64
+}
+
+type GlickoChange struct {
+	OldRating float64
+	NewRating float64
+}
+
+// GetGame returns the game details built from storage only.
+// Scores are taken from the persisted results, so they are empty for unfinished games.
+func (s *Service) GetGame(ctx context.Context, gameId string) (*GameDetails, error) {
+	game, err := s.storage.GetGameById(ctx, gameId)
+	if err != nil {
+		return nil, fmt.Errorf("get game from storage: %w", err)
+	}
+	return s.gameDetails(ctx, game, make(map[string]string))
+}
+
+// GetGameHistory returns finished games of the user, most recent first
+func (s *Service) GetGameHistory(ctx context.Context, req storage.GetFinishedGames) ([]*GameDetails, error) {
+	games, err := s.storage.GetFinishedGames(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("get finished games from storage: %w", err)
+	}
+
+	nicknames := make(map[string]string)
+	result := make([]*GameDetails, len(games))
+	for i, g := range games {
+		details, err := s.gameDetails(ctx, g, nicknames)
+		if err != nil {
+			return nil, fmt.Errorf("game details (%s): %w", g.GameId, err)
+		}
+		result[i] = details
+	}
+	return result, nil
+}
+
+// gameDetails resolves nicknames of the players, nicknames map is used as a cache between calls
+func (s *Service) gameDetails(ctx context.Context, game *storage.Game, nicknames map[string]string) (*GameDetails, error) {
+	details := &GameDetails{
+		GameId:     game.GameId,
+		CreatedAt:  game.CreatedAt,
+		ExpiresAt:  game.ExpiresAt,
+		FinishedAt: game.FinishedAt,
+		Settings:   game.Settings,
+		Players:    make([]*GameDetailsPlayer, len(game.Players)),
+		SeriesId:   game.SeriesId,
+		RematchOf:  game.RematchOf,
+	}
+	for i, p := range game.Players {
+		nickname, ok := nicknames[p.UserId]
+		if !ok {
+			user, err := s.storage.GetUserById(ctx, p.UserId)
+			if err != nil {
+				return nil, fmt.Errorf("get user (%s): %w", p.UserId, err)
+			}
+			nickname = user.Nickname
+			nicknames[p.UserId] = nickname
+		}
+		details.Players[i] = &GameDetailsPlayer{
+			UserId:   p.UserId,
+			Nickname: nickname,
+			Color:    p.Color,
+			PlayURL:  s.mars.GetPlayerUrl(p.PlayerId),
+		}
+	}
+
+	if game.GameResults != nil && game.GameResults.Raw != nil {
+		resp, err := mars.GetGameResponseFromRaw(game.GameResults.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game response from raw: %w", err)
+		}
+		details.Generation = resp.Game.Generation
+		applyGameResults(details, game.Players, resp.Game.Players)
+	}
+	if game.EloResults != nil {
+		applyEloResults(details, game.EloResults)
+	}
+	if game.GlickoResults != nil {
+		applyGlickoResults(details, game.GlickoResults)
+	}
+	return details, nil
+}
+
+func applyGameResults(details *GameDetails, players []storage.Player, results []mars.GetGamePlayer) {
+	for i, p := range players {
+		for _, r := range results {
+			if r.Id != p.PlayerId {
+				continue
+			}
+
+			place := 1
+			for _, other := range results {
+				if comparePlayers(other, r) > 0 {
+					place++
+				}
+			}
+
+			dp := details.Players[i]
+			dp.Place = place
+			dp.MegaCredits = r.MegaCredits
+			dp.TerraformRating = r.TerraformRating
+			dp.VictoryPoints = r.VictoryPoints
+		}
+	}
+}
+
+func applyEloResults(details *GameDetails, results *storage.EloResults) {
+	for _, dp := range details.Players {
+		for _, r := range results.Players {
+			if r.UserId == dp.UserId {
+				dp.EloChange = &EloChange{
+					OldElo: r.OldElo,
+					NewElo: r.NewElo,
+				}
+			}
+		}
+	}
+}
+
+func applyGlickoResults(details *GameDetails, results *storage.GlickoResults) {
+	for _, dp := range details.Players {
+		for _, r := range results.Players {
+			if r.UserId == dp.UserId {
+				dp.GlickoChange = &GlickoChange{
+					OldRating: r.OldGlicko.Rating,
+					NewRating: r.NewGlicko.Rating,
+				}
+			}
+		}
+	}
+}
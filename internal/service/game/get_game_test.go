@@ -0,0 +1,45 @@
+package game
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestApplyGameResults(t *testing.T) {
+	players := []storage.Player{
+		{UserId: "u1", PlayerId: "p1"},
+		{UserId: "u2", PlayerId: "p2"},
+		{UserId: "u3", PlayerId: "p3"},
+		{UserId: "u4", PlayerId: "p4"},
+	}
+	details := &GameDetails{Players: []*GameDetailsPlayer{
+		{UserId: "u1"},
+		{UserId: "u2"},
+		{UserId: "u3"},
+		{UserId: "u4"},
+	}}
+
+	applyGameResults(details, players, []mars.GetGamePlayer{
+		{Id: "p1", Score: 80, MegaCredits: 10, TerraformRating: 30},
+		{Id: "p2", Score: 90, MegaCredits: 5, TerraformRating: 35},
+		{Id: "p3", Score: 80, MegaCredits: 10, TerraformRating: 32},
+		{Id: "p4", Score: 80, MegaCredits: 20, TerraformRating: 40,
+			VictoryPoints: mars.VictoryPoints{TerraformRating: 40, Total: 80}},
+	})
+	applyEloResults(details, &storage.EloResults{Players: []storage.EloResultsPlayer{
+		{UserId: "u2", PlayerId: "p2", OldElo: 1000, NewElo: 1030},
+	}})
+
+	assert.DeepEqual(t, details.Players, []*GameDetailsPlayer{
+		{UserId: "u1", Place: 3, MegaCredits: 10, TerraformRating: 30},
+		{UserId: "u2", Place: 1, MegaCredits: 5, TerraformRating: 35,
+			EloChange: &EloChange{OldElo: 1000, NewElo: 1030}},
+		{UserId: "u3", Place: 3, MegaCredits: 10, TerraformRating: 32},
+		{UserId: "u4", Place: 2, MegaCredits: 20, TerraformRating: 40,
+			VictoryPoints: mars.VictoryPoints{TerraformRating: 40, Total: 80}},
+	})
+}
@@ -3,22 +3,33 @@ package game
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 )
 
 const finishedWindow = 7 * 24 * time.Hour
 
 type UserGame struct {
+	GameId       string
 	PlayURL      string
 	CreatedAt    time.Time
 	ExpiresAt    time.Time
 	PlayersCount int
 	AwaitsInput  bool
 	HasFinished  bool
+	Settings     *storage.GameSettings
+
+	// Color of the user in the game, WaitingFor lists colors of players the game is waiting for
+	Color      storage.Color
+	Phase      string
+	Generation int
+	Players    []storage.GameStatePlayer
+	WaitingFor []storage.Color
 }
 
 func (s *Service) GetUserGames(inctx context.Context, userId string) ([]*UserGame, error) {
@@ -27,66 +38,38 @@ func (s *Service) GetUserGames(inctx context.Context, userId string) ([]*UserGam
 		return nil, fmt.Errorf("get games from storage: %w", err)
 	}
 
-	awaitInputs := make([]bool, len(games))
+	result := make([]*UserGame, len(games))
 	eg, ctx := errgroup.WithContext(inctx)
-	for idx, game := range games {
-		if game.FinishedAt != nil {
-			// Input can't be awaited
-			continue
-		}
-
+	for idx, g := range games {
 		idx := idx
-		game := game
+		g := g
 
-		if len(game.Players) == 0 || game.Players[0].UserId != userId {
+		if len(g.Players) == 0 || g.Players[0].UserId != userId {
 			return nil, fmt.Errorf("unexpected players in the game")
 		}
-		thisPlayer := game.Players[0]
-
-		eg.Go(func() error {
-			wait, err := s.mars.WaitingFor(ctx, mars.WaitingForRequest{PlayerId: thisPlayer.PlayerId})
-			if err != nil {
-				return fmt.Errorf("waiting for (%s): %w", thisPlayer.PlayerId, err)
-			}
-
-			userIsWaited := false
-			for _, c := range wait.Colors {
-				if c == thisPlayer.Color {
-					userIsWaited = true
-				}
-			}
-			awaitInputs[idx] = userIsWaited
-			return nil
-		})
-	}
-	if err := eg.Wait(); err != nil {
-		return nil, err
-	}
-
-	result := make([]*UserGame, len(games))
-	eg, ctx = errgroup.WithContext(inctx)
-	for idx, g := range games {
-		idx := idx
-		g := g
+		thisPlayer := g.Players[0]
 
 		eg.Go(func() error {
-			if len(g.Players) == 0 || g.Players[0].UserId != userId {
-				return fmt.Errorf("unexpected players in the game")
-			}
-			thisPlayer := g.Players[0]
-
-			game, err := s.mars.GetGame(ctx, mars.GetGameRequest{SpectatorId: g.SpectatorId})
+			state, err := s.gameState(ctx, g, thisPlayer.PlayerId)
 			if err != nil {
-				return fmt.Errorf("get game from mars: %w", err)
+				return fmt.Errorf("game state (%s): %w", g.GameId, err)
 			}
 
 			result[idx] = &UserGame{
+				GameId:       g.GameId,
 				PlayURL:      s.mars.GetPlayerUrl(thisPlayer.PlayerId),
 				CreatedAt:    g.CreatedAt,
 				ExpiresAt:    g.ExpiresAt,
-				PlayersCount: len(game.Game.Players),
-				AwaitsInput:  awaitInputs[idx],
-				HasFinished:  game.Game.HasFinished,
+				PlayersCount: state.PlayersCount,
+				AwaitsInput:  g.FinishedAt == nil && slices.Contains(state.WaitingFor, thisPlayer.Color),
+				HasFinished:  state.Phase == mars.PhaseEnd,
+				Settings:     g.Settings,
+
+				Color:      thisPlayer.Color,
+				Phase:      state.Phase,
+				Generation: state.Generation,
+				Players:    state.Players,
+				WaitingFor: state.WaitingFor,
 			}
 			return nil
 		})
@@ -20,7 +20,7 @@ const (
 
 func (s *Service) ProcessElo(ctx context.Context) error {
 	for {
-		if err := s.storage.UpdateElo(ctx, updateElo); err != nil {
+		if err := s.storage.UpdateElo(ctx, updateRatings); err != nil {
 			logx.Logger(ctx).Error("failed to update elo",
 				slog.Any("error", err))
 		}
@@ -33,6 +33,36 @@ func (s *Service) ProcessElo(ctx context.Context) error {
 	}
 }
 
+// ReplayRatings recalculates ratings of all users from scratch using every finished game
+func (s *Service) ReplayRatings(ctx context.Context, dryRun bool) ([]*storage.RatingReplay, error) {
+	replays, err := s.storage.ReplayRatings(ctx, updateRatings, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay ratings: %w", err)
+	}
+	return replays, nil
+}
+
+func updateRatings(ctx context.Context, state storage.EloUpdateState) (storage.RatingResults, error) {
+	gameResponse, err := mars.GetGameResponseFromRaw(state.Game.GameResults.Raw)
+	if err != nil {
+		return storage.RatingResults{}, fmt.Errorf("failed to get game response from raw: %w", err)
+	}
+
+	eloResults, err := updateElo(ctx, state)
+	if err != nil {
+		return storage.RatingResults{}, fmt.Errorf("failed to update elo: %w", err)
+	}
+	glickoResults, err := updateGlicko(ctx, state)
+	if err != nil {
+		return storage.RatingResults{}, fmt.Errorf("failed to update glicko: %w", err)
+	}
+	return storage.RatingResults{
+		Elo:     eloResults,
+		Glicko:  glickoResults,
+		Buckets: ratingBuckets(gameResponse.Game),
+	}, nil
+}
+
 func updateElo(_ context.Context, state storage.EloUpdateState) (storage.EloResults, error) {
 	gameResponse, err := mars.GetGameResponseFromRaw(state.Game.GameResults.Raw)
 	if err != nil {
@@ -36,14 +36,19 @@ func (s *Service) ProcessFinishedGames(ctx context.Context) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-time.After(s.cfg.ScanInterval):
-		case <-s.finishedGames:
-			// Here we can just use this gameId to update a specific game
+		case gameId := <-s.finishedGames:
+			// The game state is fresh, so the scan above would skip it
+			if err := s.processGameById(ctx, gameId); err != nil {
+				logx.Logger(ctx).Error("failed to process game",
+					slog.String("id", gameId),
+					slog.Any("error", err))
+			}
 		}
 	}
 }
 
 func (s *Service) getGamesToProcess(ctx context.Context) []*storage.Game {
-	games, err := s.storage.GetActiveGames(ctx)
+	games, err := s.storage.GetActiveGames(ctx, s.cfg.StateMaxAge)
 	if err != nil {
 		if !errors.Is(err, storage.ErrNotFound) {
 			logx.Logger(ctx).Error("failed to get games from storage", slog.Any("error", err))
@@ -53,11 +58,29 @@ func (s *Service) getGamesToProcess(ctx context.Context) []*storage.Game {
 	return games
 }
 
+func (s *Service) processGameById(ctx context.Context, gameId string) error {
+	game, err := s.storage.GetGameById(ctx, gameId)
+	if err != nil {
+		return fmt.Errorf("failed to get game: %s: %w", gameId, err)
+	}
+	if game.FinishedAt != nil {
+		return nil
+	}
+	return s.processGame(ctx, game)
+}
+
 func (s *Service) processGame(ctx context.Context, game *storage.Game) error {
+	if len(game.Players) == 0 {
+		return fmt.Errorf("no players in the game: %s", game.GameId)
+	}
+
 	r, err := s.mars.GetGame(ctx, mars.GetGameRequest{SpectatorId: game.SpectatorId})
 	if err != nil {
 		return fmt.Errorf("failed to get game details: %s: %w", game.GameId, err)
 	}
+	if _, err := s.updateGameState(ctx, game.GameId, game.Players[0].PlayerId, r); err != nil {
+		return fmt.Errorf("failed to update game state: %s: %w", game.GameId, err)
+	}
 
 	if r.Game.HasFinished {
 		if err := s.storage.UpdateGameResults(ctx, game.GameId, &storage.GameResults{Raw: r.Raw}); err != nil {
@@ -0,0 +1,135 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+// Glicko-2 constants, see http://www.glicko.net/glicko/glicko2.pdf
+const (
+	glickoScale       = 173.7178
+	glickoBaseRating  = float64(1500)
+	glickoTau         = 0.5
+	glickoConvergence = 0.000001
+)
+
+// updateGlicko treats a game as a single rating period in which every player
+// has played every other player. Outcomes are derived the same way as for Elo.
+func updateGlicko(_ context.Context, state storage.EloUpdateState) (storage.GlickoResults, error) {
+	gameResponse, err := mars.GetGameResponseFromRaw(state.Game.GameResults.Raw)
+	if err != nil {
+		return storage.GlickoResults{}, fmt.Errorf("failed to get game response from raw: %w", err)
+	}
+
+	if len(gameResponse.Game.Players) < 2 {
+		return storage.GlickoResults{}, fmt.Errorf("not enough players")
+	}
+
+	players := make([]storage.GlickoResultsPlayer, len(gameResponse.Game.Players))
+	for i, player := range gameResponse.Game.Players {
+		user, ok := findUser(state, player.Id)
+		if !ok {
+			return storage.GlickoResults{}, fmt.Errorf("player %s not found in game", player.Id)
+		}
+
+		players[i] = storage.GlickoResultsPlayer{
+			PlayerId:  player.Id,
+			UserId:    user.UserId,
+			OldGlicko: user.Glicko,
+		}
+	}
+
+	for i := range players {
+		opponents := make([]glickoOpponent, 0, len(players)-1)
+		for j := range players {
+			if i == j {
+				continue
+			}
+			opponents = append(opponents, glickoOpponent{
+				Glicko: players[j].OldGlicko,
+				Score:  getLeftScore(gameResponse.Game.Players[i], gameResponse.Game.Players[j]),
+			})
+		}
+		players[i].NewGlicko = glickoPeriod(players[i].OldGlicko, opponents)
+	}
+
+	return storage.GlickoResults{Players: players}, nil
+}
+
+type glickoOpponent struct {
+	Glicko storage.Glicko
+	Score  float64
+}
+
+func glickoPeriod(player storage.Glicko, opponents []glickoOpponent) storage.Glicko {
+	mu := (player.Rating - glickoBaseRating) / glickoScale
+	phi := player.Deviation / glickoScale
+
+	var vInv, deltaSum float64
+	for _, o := range opponents {
+		muJ := (o.Glicko.Rating - glickoBaseRating) / glickoScale
+		gJ := glickoG(o.Glicko.Deviation / glickoScale)
+		eJ := 1. / (1. + math.Exp(-gJ*(mu-muJ)))
+
+		vInv += gJ * gJ * eJ * (1. - eJ)
+		deltaSum += gJ * (o.Score - eJ)
+	}
+	v := 1. / vInv
+	delta := v * deltaSum
+
+	sigma := glickoVolatility(phi, player.Volatility, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1. / math.Sqrt(1./(phiStar*phiStar)+1./v)
+	newMu := mu + newPhi*newPhi*deltaSum
+
+	return storage.Glicko{
+		Rating:     newMu*glickoScale + glickoBaseRating,
+		Deviation:  newPhi * glickoScale,
+		Volatility: sigma,
+	}
+}
+
+func glickoG(phi float64) float64 {
+	return 1. / math.Sqrt(1.+3.*phi*phi/(math.Pi*math.Pi))
+}
+
+// glickoVolatility finds the new volatility using the Illinois algorithm (step 5 of the paper).
+func glickoVolatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		num := ex * (delta*delta - phi*phi - v - ex)
+		den := 2. * (phi*phi + v + ex) * (phi*phi + v + ex)
+		return num/den - (x-a)/(glickoTau*glickoTau)
+	}
+
+	bigA := a
+	var bigB float64
+	if delta*delta > phi*phi+v {
+		bigB = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.
+		for f(a-k*glickoTau) < 0 {
+			k++
+		}
+		bigB = a - k*glickoTau
+	}
+
+	fA, fB := f(bigA), f(bigB)
+	for math.Abs(bigB-bigA) > glickoConvergence {
+		bigC := bigA + (bigA-bigB)*fA/(fB-fA)
+		fC := f(bigC)
+		if fC*fB <= 0 {
+			bigA, fA = bigB, fB
+		} else {
+			fA /= 2
+		}
+		bigB, fB = bigC, fC
+	}
+	return math.Exp(bigA / 2)
+}
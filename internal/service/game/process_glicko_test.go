@@ -0,0 +1,77 @@
+package game
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestGlickoPeriod(t *testing.T) {
+	// Example from the Glicko-2 paper
+	got := glickoPeriod(storage.Glicko{Rating: 1500, Deviation: 200, Volatility: 0.06}, []glickoOpponent{
+		{Glicko: storage.Glicko{Rating: 1400, Deviation: 30, Volatility: 0.06}, Score: 1},
+		{Glicko: storage.Glicko{Rating: 1550, Deviation: 100, Volatility: 0.06}, Score: 0},
+		{Glicko: storage.Glicko{Rating: 1700, Deviation: 300, Volatility: 0.06}, Score: 0},
+	})
+
+	assert.Assert(t, got.Rating > 1464.0 && got.Rating < 1464.1, "rating: %f", got.Rating)
+	assert.Assert(t, got.Deviation > 151.5 && got.Deviation < 151.6, "deviation: %f", got.Deviation)
+	assert.Assert(t, got.Volatility > 0.05999 && got.Volatility < 0.06, "volatility: %f", got.Volatility)
+}
+
+func TestUpdateGlicko(t *testing.T) {
+	initial := storage.Glicko{Rating: 1500, Deviation: 350, Volatility: 0.06}
+	state := storage.EloUpdateState{
+		Game: storage.Game{
+			Players: []storage.Player{
+				{UserId: "u1", PlayerId: "p1"},
+				{UserId: "u2", PlayerId: "p2"},
+				{UserId: "u3", PlayerId: "p3"},
+			},
+			GameResults: &storage.GameResults{
+				Raw: map[string]any{"players": []map[string]any{
+					{
+						"id": "p1",
+						"victoryPointsBreakdown": map[string]any{
+							"total": 42,
+						},
+					},
+					{
+						"id": "p2",
+						"victoryPointsBreakdown": map[string]any{
+							"total": 40,
+						},
+					},
+					{
+						"id": "p3",
+						"victoryPointsBreakdown": map[string]any{
+							"total": 40,
+						},
+					},
+				}},
+			},
+		},
+		Users: []storage.EloStateUser{
+			{UserId: "u1", Glicko: initial},
+			{UserId: "u2", Glicko: initial},
+			{UserId: "u3", Glicko: initial},
+		},
+	}
+
+	got, err := updateGlicko(context.Background(), state)
+	assert.NilError(t, err)
+	assert.Equal(t, len(got.Players), 3)
+
+	winner, second, third := got.Players[0], got.Players[1], got.Players[2]
+	assert.Equal(t, winner.UserId, "u1")
+	assert.Equal(t, winner.OldGlicko, initial)
+	assert.Assert(t, winner.NewGlicko.Rating > initial.Rating)
+	assert.Assert(t, second.NewGlicko.Rating < initial.Rating)
+	assert.Equal(t, second.NewGlicko, third.NewGlicko)
+	for _, p := range got.Players {
+		assert.Assert(t, p.NewGlicko.Deviation < initial.Deviation)
+	}
+}
@@ -0,0 +1,182 @@
+package game
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+// ProcessMatchmaking creates games for compatible players waiting in the matchmaking queue,
+// the notifier lets the matched players know about their game
+func (s *Service) ProcessMatchmaking(ctx context.Context, notifier Notifier) error {
+	for {
+		s.matchQueue(ctx, notifier)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.MatchInterval):
+		}
+	}
+}
+
+func (s *Service) matchQueue(ctx context.Context, notifier Notifier) {
+	queue, err := s.storage.GetQueue(ctx, s.cfg.QueueTTL)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			logx.Logger(ctx).Error("failed to get queue", slog.Any("error", err))
+		}
+		return
+	}
+
+	for _, group := range matchPlayers(queue) {
+		if err := s.startMatch(ctx, group, notifier); err != nil {
+			logx.Logger(ctx).Error("failed to start match", slog.Any("error", err))
+		}
+	}
+}
+
+func (s *Service) startMatch(ctx context.Context, group []*storage.QueueEntry, notifier Notifier) error {
+	if err := s.storage.ClaimQueueEntries(ctx, group); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			// Somebody has left or changed preferences, the rest are matched again next time
+			return nil
+		}
+		return fmt.Errorf("failed to claim queue entries: %w", err)
+	}
+
+	users := make([]*storage.User, len(group))
+	for i, e := range group {
+		users[i] = &storage.User{UserId: e.UserId, Nickname: e.Nickname, Color: e.Color}
+	}
+	gameId, err := s.CreateGame(ctx, users, matchSettings(group))
+	if err != nil {
+		if err := s.storage.RestoreQueueEntries(ctx, group); err != nil {
+			logx.Logger(ctx).Error("failed to restore queue entries", slog.Any("error", err))
+		}
+		return fmt.Errorf("failed to create game: %w", err)
+	}
+	logx.Logger(ctx).Info("players matched", slog.String("game", gameId), slog.Any("users", users))
+
+	for _, u := range users {
+		notifier.RefreshUser(ctx, u.UserId)
+	}
+	return nil
+}
+
+// matchPlayers groups compatible players into games. Players who have waited longer are matched first,
+// each of them with the closest by Elo players into the largest game they agree to.
+func matchPlayers(queue []*storage.QueueEntry) [][]*storage.QueueEntry {
+	matched := make(map[string]bool)
+	var groups [][]*storage.QueueEntry
+	for _, seed := range queue {
+		if matched[seed.UserId] {
+			continue
+		}
+
+		var candidates []*storage.QueueEntry
+		for _, e := range queue {
+			if e.UserId != seed.UserId && !matched[e.UserId] && isCompatible(seed, e) {
+				candidates = append(candidates, e)
+			}
+		}
+		slices.SortStableFunc(candidates, func(a, b *storage.QueueEntry) int {
+			return cmp.Compare(eloDistance(seed, a), eloDistance(seed, b))
+		})
+
+		counts := slices.Clone(seed.Preferences.PlayersCounts)
+		slices.Sort(counts)
+		slices.Reverse(counts)
+		for _, count := range counts {
+			group := buildGroup(seed, candidates, count)
+			if group == nil {
+				continue
+			}
+			for _, e := range group {
+				matched[e.UserId] = true
+			}
+			groups = append(groups, group)
+			break
+		}
+	}
+	return groups
+}
+
+// buildGroup takes candidates in order while they are compatible with everyone taken before
+func buildGroup(seed *storage.QueueEntry, candidates []*storage.QueueEntry, count int) []*storage.QueueEntry {
+	if count < 2 {
+		return nil
+	}
+
+	group := []*storage.QueueEntry{seed}
+	for _, c := range candidates {
+		if len(group) == count {
+			break
+		}
+		if !slices.Contains(c.Preferences.PlayersCounts, count) {
+			continue
+		}
+		if !slices.ContainsFunc(group, func(e *storage.QueueEntry) bool { return !isCompatible(e, c) }) {
+			group = append(group, c)
+		}
+	}
+	if len(group) < count {
+		return nil
+	}
+	return group
+}
+
+func isCompatible(a *storage.QueueEntry, b *storage.QueueEntry) bool {
+	as, bs := a.Preferences.Settings, b.Preferences.Settings
+	if as.Board != "" && bs.Board != "" && as.Board != bs.Board {
+		return false
+	}
+	if as.CorporateEra != bs.CorporateEra || as.Prelude != bs.Prelude || as.VenusNext != bs.VenusNext ||
+		as.SolarPhase != bs.SolarPhase || as.Colonies != bs.Colonies {
+		return false
+	}
+
+	distance := eloDistance(a, b)
+	if a.Preferences.RatingRange > 0 && distance > a.Preferences.RatingRange {
+		return false
+	}
+	if b.Preferences.RatingRange > 0 && distance > b.Preferences.RatingRange {
+		return false
+	}
+	return true
+}
+
+func eloDistance(a *storage.QueueEntry, b *storage.QueueEntry) int64 {
+	if a.Elo > b.Elo {
+		return a.Elo - b.Elo
+	}
+	return b.Elo - a.Elo
+}
+
+// matchSettings uses the board somebody in the group has asked for or a random one
+func matchSettings(group []*storage.QueueEntry) mars.GameSettings {
+	settings := group[0].Preferences.Settings
+	board := mars.ClassicBoards[rand.IntN(len(mars.ClassicBoards))]
+	for _, e := range group {
+		if e.Preferences.Settings.Board != "" {
+			board = mars.Board(e.Preferences.Settings.Board)
+		}
+	}
+	return mars.GameSettings{
+		Board:        board,
+		CorporateEra: settings.CorporateEra,
+		Prelude:      settings.Prelude,
+		VenusNext:    settings.VenusNext,
+		SolarPhase:   settings.SolarPhase,
+		Colonies:     settings.Colonies,
+	}
+}
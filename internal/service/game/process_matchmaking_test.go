@@ -0,0 +1,96 @@
+package game
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestMatchPlayers(t *testing.T) {
+	entry := func(userId string, elo int64, counts ...int) *storage.QueueEntry {
+		return &storage.QueueEntry{
+			UserId:      userId,
+			Elo:         elo,
+			Preferences: storage.MatchmakingPreferences{PlayersCounts: counts},
+		}
+	}
+	withSettings := func(e *storage.QueueEntry, settings storage.GameSettings) *storage.QueueEntry {
+		e.Preferences.Settings = settings
+		return e
+	}
+	withRange := func(e *storage.QueueEntry, ratingRange int64) *storage.QueueEntry {
+		e.Preferences.RatingRange = ratingRange
+		return e
+	}
+
+	tests := []struct {
+		name  string
+		queue []*storage.QueueEntry
+		want  [][]string
+	}{
+		{
+			name:  "closest elo",
+			queue: []*storage.QueueEntry{entry("u1", 1000, 2), entry("u2", 1300, 2), entry("u3", 1010, 2)},
+			want:  [][]string{{"u1", "u3"}},
+		},
+		{
+			name: "largest game",
+			queue: []*storage.QueueEntry{
+				entry("u1", 1000, 2, 3), entry("u2", 1000, 2, 3), entry("u3", 1000, 3), entry("u4", 1000, 2),
+			},
+			want: [][]string{{"u1", "u2", "u3"}},
+		},
+		{
+			name: "longest waiting first",
+			queue: []*storage.QueueEntry{
+				entry("u1", 1000, 2), entry("u2", 1500, 2), entry("u3", 1400, 2), entry("u4", 1100, 2),
+			},
+			want: [][]string{{"u1", "u4"}, {"u2", "u3"}},
+		},
+		{
+			name: "different expansions",
+			queue: []*storage.QueueEntry{
+				withSettings(entry("u1", 1000, 2), storage.GameSettings{Prelude: true}),
+				entry("u2", 1000, 2),
+				withSettings(entry("u3", 1200, 2), storage.GameSettings{Prelude: true}),
+			},
+			want: [][]string{{"u1", "u3"}},
+		},
+		{
+			name: "boards",
+			queue: []*storage.QueueEntry{
+				withSettings(entry("u1", 1000, 3), storage.GameSettings{Board: "hellas"}),
+				withSettings(entry("u2", 1000, 3), storage.GameSettings{Board: "elysium"}),
+				entry("u3", 1000, 3),
+				withSettings(entry("u4", 1000, 3), storage.GameSettings{Board: "hellas"}),
+			},
+			want: [][]string{{"u1", "u3", "u4"}},
+		},
+		{
+			name: "rating range",
+			queue: []*storage.QueueEntry{
+				withRange(entry("u1", 1000, 2), 100), entry("u2", 1150, 2), withRange(entry("u3", 1200, 2), 100),
+			},
+			want: [][]string{{"u2", "u3"}},
+		},
+		{
+			name:  "not enough players",
+			queue: []*storage.QueueEntry{entry("u1", 1000, 3), entry("u2", 1000, 3), entry("u3", 1000, 1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]string
+			for _, group := range matchPlayers(tt.queue) {
+				var ids []string
+				for _, e := range group {
+					ids = append(ids, e.UserId)
+				}
+				got = append(got, ids)
+			}
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+)
+
+type Expansion string
+
+const (
+	ExpansionCorporateEra Expansion = "corporate_era"
+	ExpansionPrelude      Expansion = "prelude"
+	ExpansionPrelude2     Expansion = "prelude2"
+	ExpansionVenusNext    Expansion = "venus_next"
+	ExpansionColonies     Expansion = "colonies"
+	ExpansionTurmoil      Expansion = "turmoil"
+	ExpansionPromo        Expansion = "promo"
+	ExpansionCommunity    Expansion = "community"
+	ExpansionAres         Expansion = "ares"
+	ExpansionMoon         Expansion = "moon"
+	ExpansionPathfinders  Expansion = "pathfinders"
+	ExpansionCeos         Expansion = "ceos"
+	ExpansionUnderworld   Expansion = "underworld"
+)
+
+func PlayersBucket(playersCount int) string {
+	return fmt.Sprintf("players:%d", playersCount)
+}
+
+func BoardBucket(board mars.Board) string {
+	return fmt.Sprintf("board:%s", board)
+}
+
+func ExpansionBucket(e Expansion) string {
+	return fmt.Sprintf("expansion:%s", e)
+}
+
+// ratingBuckets returns keys of all rating buckets the game contributes to.
+// Each bucket covers a single dimension of the game settings.
+func ratingBuckets(game mars.GetGameModel) []string {
+	buckets := []string{PlayersBucket(len(game.Players))}
+	if game.Options.Board != "" {
+		buckets = append(buckets, BoardBucket(game.Options.Board))
+	}
+	for _, e := range Expansions(game.Options) {
+		buckets = append(buckets, ExpansionBucket(e))
+	}
+	return buckets
+}
+
+// Expansions lists expansions enabled in the game options
+func Expansions(opts mars.GameOptions) []Expansion {
+	var expansions []Expansion
+	for _, e := range []struct {
+		enabled   bool
+		expansion Expansion
+	}{
+		{opts.CorporateEra, ExpansionCorporateEra},
+		{opts.Prelude, ExpansionPrelude},
+		{opts.Prelude2, ExpansionPrelude2},
+		{opts.VenusNext, ExpansionVenusNext},
+		{opts.Colonies, ExpansionColonies},
+		{opts.Turmoil, ExpansionTurmoil},
+		{opts.Promo, ExpansionPromo},
+		{opts.Community, ExpansionCommunity},
+		{opts.Ares, ExpansionAres},
+		{opts.Moon, ExpansionMoon},
+		{opts.Pathfinders, ExpansionPathfinders},
+		{opts.Ceos, ExpansionCeos},
+		{opts.Underworld, ExpansionUnderworld},
+	} {
+		if e.enabled {
+			expansions = append(expansions, e.expansion)
+		}
+	}
+	return expansions
+}
@@ -0,0 +1,49 @@
+package game
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/mars"
+)
+
+func TestRatingBuckets(t *testing.T) {
+	tests := []struct {
+		name string
+		game mars.GetGameModel
+		want []string
+	}{
+		{
+			name: "no options",
+			game: mars.GetGameModel{
+				Players: []mars.GetGamePlayer{{Id: "p1"}, {Id: "p2"}},
+			},
+			want: []string{"players:2"},
+		},
+		{
+			name: "board and expansions",
+			game: mars.GetGameModel{
+				Options: mars.GameOptions{
+					Board:        mars.BoardHellas,
+					CorporateEra: true,
+					VenusNext:    true,
+					Colonies:     true,
+				},
+				Players: []mars.GetGamePlayer{{Id: "p1"}, {Id: "p2"}, {Id: "p3"}, {Id: "p4"}, {Id: "p5"}},
+			},
+			want: []string{
+				"players:5",
+				"board:hellas",
+				"expansion:corporate_era",
+				"expansion:venus_next",
+				"expansion:colonies",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, tt.want, ratingBuckets(tt.game))
+		})
+	}
+}
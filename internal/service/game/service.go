@@ -10,14 +10,27 @@ import (
 
 type Config struct {
 	ScanInterval time.Duration
+	StateMaxAge  time.Duration // Stored game state older than this is requested from the Mars server again
+	// MatchInterval is how often the matchmaking queue is processed
+	MatchInterval time.Duration
+	// QueueTTL is how long players wait in the matchmaking queue before they are ignored
+	QueueTTL time.Duration
 }
 
 type Storage interface {
+	ClaimQueueEntries(ctx context.Context, entries []*storage.QueueEntry) error
 	CreateGame(ctx context.Context, game *storage.Game) error
-	GetActiveGames(ctx context.Context) ([]*storage.Game, error)
+	GetActiveGames(ctx context.Context, staleAge time.Duration) ([]*storage.Game, error)
+	GetFinishedGames(ctx context.Context, req storage.GetFinishedGames) ([]*storage.Game, error)
+	GetGameById(ctx context.Context, gameId string) (*storage.Game, error)
 	GetGamesByUserId(ctx context.Context, userId string, finishedWindow time.Duration) ([]*storage.Game, error)
+	GetQueue(ctx context.Context, maxAge time.Duration) ([]*storage.QueueEntry, error)
+	GetUserById(ctx context.Context, userId string) (*storage.User, error)
+	ReplayRatings(ctx context.Context, updater storage.EloUpdater, dryRun bool) ([]*storage.RatingReplay, error)
+	RestoreQueueEntries(ctx context.Context, entries []*storage.QueueEntry) error
 	UpdateElo(ctx context.Context, updater storage.EloUpdater) error
 	UpdateGameResults(ctx context.Context, gameId string, results *storage.GameResults) error
+	UpdateGameState(ctx context.Context, gameId string, state storage.GameState) error
 }
 
 type MarsClient interface {
@@ -27,6 +40,11 @@ type MarsClient interface {
 	WaitingFor(ctx context.Context, req mars.WaitingForRequest) (mars.WaitingForResponse, error)
 }
 
+// Notifier refreshes game lists of the players of games created by the service
+type Notifier interface {
+	RefreshUser(ctx context.Context, userId string)
+}
+
 type Service struct {
 	cfg     Config
 	storage Storage
@@ -0,0 +1,83 @@
+package notifications
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+)
+
+// gameAlert describes the game that started awaiting the user's input.
+// Games that were already awaiting input when the last notification was sent are described
+// only when there is no new one.
+func gameAlert(active []*game.UserGame, sentGames []string, badge int) push.Notification {
+	g := active[0]
+	for _, a := range active {
+		if !slices.Contains(sentGames, a.GameId) {
+			g = a
+			break
+		}
+	}
+
+	body := waitingText(g)
+	if g.Generation > 0 {
+		body = fmt.Sprintf("Generation %d, %s phase. %s", g.Generation, phaseText(g.Phase), body)
+	}
+	if others := len(active) - 1; others > 0 {
+		gameText := "game is"
+		if others > 1 {
+			gameText = "games are"
+		}
+		body += fmt.Sprintf(" %d more %s awaiting for your decision.", others, gameText)
+	}
+
+	return push.Notification{
+		Title:      "Mars awaits you!",
+		Subtitle:   playersText(g),
+		Body:       body,
+		Badge:      badge,
+		Sound:      "default",
+		ThreadId:   g.GameId,
+		CollapseId: g.GameId,
+		URL:        g.PlayURL,
+	}
+}
+
+func playersText(g *game.UserGame) string {
+	names := make([]string, 0, len(g.Players))
+	for _, p := range g.Players {
+		if p.Color != g.Color {
+			names = append(names, p.Name)
+		}
+	}
+	if len(names) == 0 {
+		if g.PlayersCount == 1 {
+			return "Solo game"
+		}
+		return ""
+	}
+	return "Game with " + joinNames(names)
+}
+
+func waitingText(g *game.UserGame) string {
+	names := []string{"you"}
+	for _, p := range g.Players {
+		if p.Color != g.Color && slices.Contains(g.WaitingFor, p.Color) {
+			names = append(names, p.Name)
+		}
+	}
+	return "Waiting for " + joinNames(names) + "."
+}
+
+func phaseText(phase string) string {
+	return strings.ReplaceAll(phase, "_", " ")
+}
+
+func joinNames(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
@@ -0,0 +1,104 @@
+package notifications
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestGameAlert(t *testing.T) {
+	players := []storage.GameStatePlayer{
+		{Name: "Squirrel", Color: storage.ColorRed},
+		{Name: "Andy", Color: storage.ColorBlue},
+		{Name: "Bob", Color: storage.ColorGreen},
+	}
+	g1 := &game.UserGame{
+		GameId:       "g1",
+		PlayURL:      "https://mars.example.com/player?id=p1",
+		PlayersCount: 3,
+		Color:        storage.ColorRed,
+		Phase:        "initial_drafting",
+		Generation:   1,
+		Players:      players,
+		WaitingFor:   []storage.Color{storage.ColorRed, storage.ColorGreen},
+	}
+	g2 := &game.UserGame{
+		GameId:       "g2",
+		PlayURL:      "https://mars.example.com/player?id=p2",
+		PlayersCount: 2,
+		Color:        storage.ColorBlue,
+		Players:      players[:2],
+		WaitingFor:   []storage.Color{storage.ColorBlue},
+	}
+	solo := &game.UserGame{
+		GameId:       "g3",
+		PlayURL:      "https://mars.example.com/player?id=p3",
+		PlayersCount: 1,
+		Color:        storage.ColorRed,
+		Phase:        "action",
+		Generation:   12,
+		Players:      players[:1],
+		WaitingFor:   []storage.Color{storage.ColorRed},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		active []*game.UserGame
+		sent   []string
+		want   push.Notification
+	}{
+		{
+			name:   "single game",
+			active: []*game.UserGame{g1},
+			want: push.Notification{
+				Title:      "Mars awaits you!",
+				Subtitle:   "Game with Andy and Bob",
+				Body:       "Generation 1, initial drafting phase. Waiting for you and Bob.",
+				Badge:      1,
+				Sound:      "default",
+				ThreadId:   "g1",
+				CollapseId: "g1",
+				URL:        "https://mars.example.com/player?id=p1",
+			},
+		},
+		{
+			name:   "new game is described",
+			active: []*game.UserGame{g1, g2},
+			sent:   []string{"g1"},
+			want: push.Notification{
+				Title:      "Mars awaits you!",
+				Subtitle:   "Game with Squirrel",
+				Body:       "Waiting for you. 1 more game is awaiting for your decision.",
+				Badge:      2,
+				Sound:      "default",
+				ThreadId:   "g2",
+				CollapseId: "g2",
+				URL:        "https://mars.example.com/player?id=p2",
+			},
+		},
+		{
+			name:   "solo game",
+			active: []*game.UserGame{g1, g2, solo},
+			sent:   []string{"g1", "g2"},
+			want: push.Notification{
+				Title:      "Mars awaits you!",
+				Subtitle:   "Solo game",
+				Body:       "Generation 12, action phase. Waiting for you. 2 more games are awaiting for your decision.",
+				Badge:      3,
+				Sound:      "default",
+				ThreadId:   "g3",
+				CollapseId: "g3",
+				URL:        "https://mars.example.com/player?id=p3",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gameAlert(tc.active, tc.sent, len(tc.active))
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
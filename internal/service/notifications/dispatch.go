@@ -0,0 +1,261 @@
+package notifications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/apn"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+const (
+	dispatchBatchSize = 50
+	// dispatchLease must be longer than a delivery of a batch takes
+	dispatchLease = 5 * time.Minute
+
+	maxDeliveryAttempts = 10
+	retryBaseDelay      = 30 * time.Second
+	retryMaxDelay       = 6 * time.Hour
+
+	pruneInterval = time.Hour
+)
+
+// outbox creates messages of the notification for every enabled device of the user
+func (s *Service) outbox(state storage.UserNotificationState, n push.Notification) []storage.OutboxMessage {
+	var messages []storage.OutboxMessage
+	for _, d := range state.Devices {
+		if !platformEnabled(state.Settings, d.Platform) {
+			continue
+		}
+		if _, ok := s.getNotifier(d); !ok {
+			continue
+		}
+		if n.Background && d.Platform != storage.DevicePlatformIOS {
+			continue
+		}
+		messages = append(messages, storage.OutboxMessage{
+			Token:   d.Token,
+			Payload: toPayload(n),
+		})
+	}
+	return messages
+}
+
+// wakeDispatcher makes the dispatcher deliver enqueued messages without waiting for the next interval
+func (s *Service) wakeDispatcher() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Service) dispatch(ctx context.Context) error {
+	for {
+		for s.dispatchBatch(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.wake:
+		case <-time.After(s.cfg.DispatchInterval):
+		}
+	}
+}
+
+// dispatchBatch delivers due messages and reports whether there might be more of them
+func (s *Service) dispatchBatch(ctx context.Context) bool {
+	messages, err := s.deps.Storage.ClaimOutbox(ctx, dispatchBatchSize, dispatchLease)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			logx.Logger(ctx).Error("failed to claim outbox", slog.Any("error", err))
+		}
+		return false
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(s.cfg.WorkersCount)
+	for _, m := range messages {
+		eg.Go(func() error {
+			if err := s.deps.Storage.UpdateOutboxMessage(ctx, s.deliver(ctx, m)); err != nil {
+				logx.Logger(ctx).Error("failed to update outbox message",
+					slog.Int64("id", m.Id), slog.Any("error", err))
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	return len(messages) == dispatchBatchSize
+}
+
+// deliver pushes the message and returns its new status
+func (s *Service) deliver(ctx context.Context, m *storage.OutboxMessage) storage.UpdateOutboxMessage {
+	now := s.now()
+	result := storage.UpdateOutboxMessage{
+		Id:            m.Id,
+		Status:        storage.OutboxStatusDelivered,
+		NextAttemptAt: now,
+	}
+	if m.Device == nil {
+		result.Status = storage.OutboxStatusFailed
+		result.LastError = "device was removed"
+		return result
+	}
+
+	device, err := s.pushDevice(ctx, m, *m.Device)
+	if device.Environment != m.Device.Environment && (err == nil || !errors.Is(err, push.ErrInvalidToken)) {
+		if err := s.deps.Storage.UpdateDeviceEnvironment(ctx, m.UserId, m.Token, device.Environment); err != nil {
+			logx.Logger(ctx).Error("failed to update device environment",
+				slog.String("uid", m.UserId), slog.Any("error", err))
+		}
+	}
+	if err == nil {
+		return result
+	}
+
+	result.LastError = err.Error()
+	switch {
+	case errors.Is(err, push.ErrInvalidToken):
+		s.removeDevice(ctx, m, err)
+		result.Status = storage.OutboxStatusFailed
+	case errors.Is(err, errNoNotifier) || errors.Is(err, push.ErrPermanent) || m.Attempts+1 >= maxDeliveryAttempts:
+		logx.Logger(ctx).Error("failed to push notification",
+			slog.String("uid", m.UserId), slog.Int64("id", m.Id), slog.Any("error", err))
+		result.Status = storage.OutboxStatusFailed
+	default:
+		logx.Logger(ctx).Warn("push notification will be retried",
+			slog.String("uid", m.UserId), slog.Int64("id", m.Id), slog.Any("error", err))
+		result.Status = storage.OutboxStatusPending
+		result.NextAttemptAt = now.Add(retryDelay(m.Attempts, err))
+	}
+	return result
+}
+
+// prune deletes delivered and failed messages and delivery attempts once they are older than their retention
+func (s *Service) prune(ctx context.Context) error {
+	for {
+		if s.cfg.OutboxRetention > 0 {
+			pruned, err := s.deps.Storage.PruneOutbox(ctx, s.now().Add(-s.cfg.OutboxRetention))
+			if err != nil {
+				logx.Logger(ctx).Error("failed to prune outbox", slog.Any("error", err))
+			} else if pruned > 0 {
+				logx.Logger(ctx).Info("pruned outbox", slog.Int64("messages", pruned))
+			}
+		}
+		if s.cfg.AttemptRetention > 0 {
+			pruned, err := s.deps.Storage.PruneDeliveryAttempts(ctx, s.now().Add(-s.cfg.AttemptRetention))
+			if err != nil {
+				logx.Logger(ctx).Error("failed to prune delivery attempts", slog.Any("error", err))
+			} else if pruned > 0 {
+				logx.Logger(ctx).Info("pruned delivery attempts", slog.Int64("attempts", pruned))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pruneInterval):
+		}
+	}
+}
+
+// removeDevice deletes the device of the message after its token was rejected
+func (s *Service) removeDevice(ctx context.Context, m *storage.OutboxMessage, reason error) {
+	logx.Logger(ctx).Info("removing invalid device",
+		slog.String("uid", m.UserId), slog.String("platform", string(m.Device.Platform)))
+
+	var err error
+	if at, ok := apn.UnregisteredAt(reason); ok {
+		err = s.deps.Storage.DeleteUnregisteredDevice(ctx, m.UserId, m.Token, at)
+	} else {
+		err = s.deps.Storage.DeleteDevice(ctx, m.UserId, m.Token)
+	}
+	if err != nil {
+		logx.Logger(ctx).Error("failed to delete device", slog.String("uid", m.UserId), slog.Any("error", err))
+	}
+}
+
+// push sends the message with the notifier and records the attempt in the delivery log
+func (s *Service) push(ctx context.Context, m *storage.OutboxMessage, notifier Notifier, device storage.Device) error {
+	start := s.now()
+	result, err := notifier.Push(ctx, device.Token, fromPayload(m.Payload))
+	attempt := storage.DeliveryAttempt{
+		OutboxId:    m.Id,
+		UserId:      m.UserId,
+		Token:       device.Token,
+		Platform:    device.Platform,
+		Environment: device.Environment,
+		PayloadHash: payloadHash(m.Payload),
+		MessageId:   result.MessageId,
+		Status:      result.Status,
+		Reason:      result.Reason,
+		Latency:     s.now().Sub(start),
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	if err := s.deps.Storage.InsertDeliveryAttempt(ctx, attempt); err != nil {
+		logx.Logger(ctx).Error("failed to insert delivery attempt",
+			slog.String("uid", m.UserId), slog.Int64("id", m.Id), slog.Any("error", err))
+	}
+	return err
+}
+
+// payloadHash is a short fingerprint to tell apart payloads of the delivery log
+func payloadHash(p storage.PushPayload) string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// retryDelay grows exponentially with attempts unless the push service asked for a specific delay
+func retryDelay(attempts int, err error) time.Duration {
+	if after, ok := push.RetryAfter(err); ok && after > 0 {
+		return after
+	}
+	delay := retryBaseDelay
+	for i := 0; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
+
+func toPayload(n push.Notification) storage.PushPayload {
+	return storage.PushPayload{
+		Title:      n.Title,
+		Subtitle:   n.Subtitle,
+		Body:       n.Body,
+		Badge:      n.Badge,
+		Sound:      n.Sound,
+		ThreadId:   n.ThreadId,
+		CollapseId: n.CollapseId,
+		URL:        n.URL,
+		Background: n.Background,
+	}
+}
+
+func fromPayload(p storage.PushPayload) push.Notification {
+	return push.Notification{
+		Title:      p.Title,
+		Subtitle:   p.Subtitle,
+		Body:       p.Body,
+		Badge:      p.Badge,
+		Sound:      p.Sound,
+		ThreadId:   p.ThreadId,
+		CollapseId: p.CollapseId,
+		URL:        p.URL,
+		Background: p.Background,
+	}
+}
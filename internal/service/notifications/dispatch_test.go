@@ -0,0 +1,226 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/apn"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+type fakeNotifier struct {
+	err   error
+	calls int
+}
+
+func (f *fakeNotifier) Push(_ context.Context, _ []byte, _ push.Notification) (push.Result, error) {
+	f.calls++
+	if f.err != nil {
+		return push.Result{}, f.err
+	}
+	return push.Result{MessageId: "message id", Status: http.StatusOK}, nil
+}
+
+type fakeStorage struct {
+	Storage
+
+	attempts     []storage.DeliveryAttempt
+	deleted      [][]byte
+	unregistered []time.Time
+	environments []storage.DeviceTokenType
+}
+
+func (f *fakeStorage) InsertDeliveryAttempt(_ context.Context, attempt storage.DeliveryAttempt) error {
+	f.attempts = append(f.attempts, attempt)
+	return nil
+}
+
+func (f *fakeStorage) DeleteDevice(_ context.Context, _ string, token []byte) error {
+	f.deleted = append(f.deleted, token)
+	return nil
+}
+
+func (f *fakeStorage) DeleteUnregisteredDevice(_ context.Context, _ string, _ []byte, unregisteredAt time.Time) error {
+	f.unregistered = append(f.unregistered, unregisteredAt)
+	return nil
+}
+
+func (f *fakeStorage) UpdateDeviceEnvironment(_ context.Context, _ string, _ []byte,
+	environment storage.DeviceTokenType) error {
+	f.environments = append(f.environments, environment)
+	return nil
+}
+
+func TestService_deliver(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+	device := &storage.Device{
+		Token:       []byte("token"),
+		UserId:      "user",
+		Platform:    storage.DevicePlatformIOS,
+		Environment: storage.DeviceTokenTypeProduction,
+	}
+	message := func(attempts int, d *storage.Device) *storage.OutboxMessage {
+		return &storage.OutboxMessage{
+			Id:       42,
+			UserId:   "user",
+			Token:    []byte("token"),
+			Attempts: attempts,
+			Device:   d,
+		}
+	}
+	retryErr := &push.RetryError{After: time.Minute, Err: errors.New("status 429")}
+	permanentErr := fmt.Errorf("%w: %w", push.ErrPermanent, apn.ErrBadTopic)
+	unregisteredAt := now.Add(-time.Hour)
+	unregisteredErr := fmt.Errorf("%w: %w", apn.ErrUnregistered,
+		&apn.Error{Status: http.StatusGone, Reason: "Unregistered", Timestamp: unregisteredAt})
+
+	for _, tc := range []struct {
+		name         string
+		prodErr      error
+		sandboxErr   error
+		message      *storage.OutboxMessage
+		want         storage.UpdateOutboxMessage
+		attempts     int
+		deleted      bool
+		unregistered []time.Time
+		environments []storage.DeviceTokenType
+	}{
+		{
+			name:     "delivered",
+			message:  message(0, device),
+			want:     storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusDelivered, NextAttemptAt: now},
+			attempts: 1,
+		},
+		{
+			name:         "delivered to other environment",
+			prodErr:      apn.ErrBadDeviceToken,
+			message:      message(0, device),
+			want:         storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusDelivered, NextAttemptAt: now},
+			attempts:     2,
+			environments: []storage.DeviceTokenType{storage.DeviceTokenTypeSandbox},
+		},
+		{
+			name:       "invalid token",
+			prodErr:    apn.ErrBadDeviceToken,
+			sandboxErr: apn.ErrBadDeviceToken,
+			message:    message(0, device),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusFailed, NextAttemptAt: now,
+				LastError: "invalid device token: bad device token"},
+			attempts: 2,
+			deleted:  true,
+		},
+		{
+			name:    "unregistered",
+			prodErr: unregisteredErr,
+			message: message(0, device),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusFailed, NextAttemptAt: now,
+				LastError: unregisteredErr.Error()},
+			attempts:     1,
+			unregistered: []time.Time{unregisteredAt},
+		},
+		{
+			name:    "retry after",
+			prodErr: retryErr,
+			message: message(3, device),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusPending,
+				NextAttemptAt: now.Add(time.Minute), LastError: retryErr.Error()},
+			attempts: 1,
+		},
+		{
+			name:    "backoff",
+			prodErr: errors.New("connection reset"),
+			message: message(2, device),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusPending,
+				NextAttemptAt: now.Add(4 * retryBaseDelay), LastError: "connection reset"},
+			attempts: 1,
+		},
+		{
+			name:    "permanent error",
+			prodErr: permanentErr,
+			message: message(0, device),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusFailed, NextAttemptAt: now,
+				LastError: permanentErr.Error()},
+			attempts: 1,
+		},
+		{
+			name:    "out of attempts",
+			prodErr: errors.New("connection reset"),
+			message: message(maxDeliveryAttempts-1, device),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusFailed, NextAttemptAt: now,
+				LastError: "connection reset"},
+			attempts: 1,
+		},
+		{
+			name:    "removed device",
+			message: message(0, nil),
+			want: storage.UpdateOutboxMessage{Id: 42, Status: storage.OutboxStatusFailed, NextAttemptAt: now,
+				LastError: "device was removed"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st := &fakeStorage{}
+			s := NewService(Config{}, Dependencies{
+				Storage:         st,
+				SandboxNotifier: &fakeNotifier{err: tc.sandboxErr},
+				ProdNotifier:    &fakeNotifier{err: tc.prodErr},
+			})
+			s.now = func() time.Time { return now }
+
+			got := s.deliver(ctx, tc.message)
+			assert.DeepEqual(t, got, tc.want)
+			assert.Equal(t, len(st.attempts), tc.attempts)
+			for _, a := range st.attempts {
+				assert.Equal(t, a.OutboxId, tc.message.Id)
+				assert.Equal(t, a.PayloadHash, payloadHash(tc.message.Payload))
+			}
+			assert.Equal(t, len(st.deleted) == 1, tc.deleted)
+			assert.DeepEqual(t, st.unregistered, tc.unregistered)
+			assert.DeepEqual(t, st.environments, tc.environments)
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, retryDelay(0, errors.New("failed")), retryBaseDelay)
+	assert.Equal(t, retryDelay(3, errors.New("failed")), 8*retryBaseDelay)
+	assert.Equal(t, retryDelay(30, errors.New("failed")), retryMaxDelay)
+	assert.Equal(t, retryDelay(3, &push.RetryError{After: time.Minute}), time.Minute)
+	assert.Equal(t, retryDelay(1, &push.RetryError{}), 2*retryBaseDelay)
+}
+
+func TestService_outbox(t *testing.T) {
+	s := NewService(Config{}, Dependencies{
+		SandboxNotifier: &fakeNotifier{},
+		ProdNotifier:    &fakeNotifier{},
+		WebNotifier:     &fakeNotifier{},
+	})
+	state := storage.UserNotificationState{
+		Devices: []storage.Device{
+			{Token: []byte("phone"), Platform: storage.DevicePlatformIOS},
+			{Token: []byte("browser"), Platform: storage.DevicePlatformWeb},
+			{Token: []byte("android"), Platform: storage.DevicePlatformAndroid},
+		},
+	}
+
+	tokens := func(messages []storage.OutboxMessage) []string {
+		var res []string
+		for _, m := range messages {
+			res = append(res, string(m.Token))
+		}
+		return res
+	}
+	// Android devices are skipped without a notifier, background notifications are sent to iOS only
+	assert.DeepEqual(t, tokens(s.outbox(state, push.Notification{Title: "title"})), []string{"phone", "browser"})
+	assert.DeepEqual(t, tokens(s.outbox(state, push.Notification{Background: true})), []string{"phone"})
+
+	state.Settings.DisabledPlatforms = []storage.DevicePlatform{storage.DevicePlatformIOS}
+	assert.DeepEqual(t, tokens(s.outbox(state, push.Notification{Title: "title"})), []string{"browser"})
+}
@@ -0,0 +1,83 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+// InviteToLobby tells the users they are invited to the lobby.
+// Invitations are delivered without sound during quiet hours.
+func (s *Service) InviteToLobby(ctx context.Context, lobby *storage.Lobby, userIds []string) error {
+	var errs []error
+	for _, uid := range userIds {
+		if err := s.invite(ctx, lobby, uid); err != nil {
+			errs = append(errs, fmt.Errorf("invite %s: %w", uid, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) invite(ctx context.Context, lobby *storage.Lobby, userId string) error {
+	if err := s.deps.Storage.UpdateSentNotification(ctx, userId,
+		func(ctx context.Context, state storage.UserNotificationState) (storage.UserNotificationState, error) {
+			if len(state.Devices) == 0 || state.Settings.BadgeOnly {
+				return state, nil
+			}
+
+			notification := inviteAlert(lobby, state.SentNotification.ActiveGames)
+			if now := s.now(); quietHoursEnd(state.Settings, now).After(now) {
+				notification.Sound = ""
+			}
+
+			state.Outbox = s.outbox(state, notification)
+			return state, nil
+		}); err != nil {
+		return fmt.Errorf("update sent notification: %w", err)
+	}
+	s.wakeDispatcher()
+	return nil
+}
+
+func inviteAlert(lobby *storage.Lobby, badge int) push.Notification {
+	host := "Someone"
+	for _, m := range lobby.Members {
+		if m.UserId == lobby.HostId {
+			host = m.Nickname
+		}
+	}
+
+	return push.Notification{
+		Title:      "Game invitation",
+		Subtitle:   settingsText(lobby.Settings),
+		Body:       fmt.Sprintf("%s invites you to a %d-player game", host, len(lobby.Members)),
+		Badge:      badge,
+		Sound:      "default",
+		ThreadId:   lobby.LobbyId,
+		CollapseId: lobby.LobbyId,
+	}
+}
+
+func settingsText(settings storage.GameSettings) string {
+	parts := []string{"Random board"}
+	if settings.Board != "" {
+		parts[0] = strings.ToUpper(settings.Board[:1]) + settings.Board[1:]
+	}
+	for _, e := range []struct {
+		enabled bool
+		name    string
+	}{
+		{settings.Prelude, "Prelude"},
+		{settings.VenusNext, "Venus Next"},
+		{settings.Colonies, "Colonies"},
+	} {
+		if e.enabled {
+			parts = append(parts, e.name)
+		}
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,66 @@
+package notifications
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestInviteAlert(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		lobby *storage.Lobby
+		want  push.Notification
+	}{
+		{
+			name: "board and expansions",
+			lobby: &storage.Lobby{
+				LobbyId:  "l1",
+				HostId:   "u1",
+				Settings: storage.GameSettings{Board: "hellas", Prelude: true, Colonies: true},
+				Members: []storage.LobbyMember{
+					{UserId: "u1", Nickname: "Squirrel"},
+					{UserId: "u2", Nickname: "Andy"},
+					{UserId: "u3", Nickname: "Bob"},
+				},
+			},
+			want: push.Notification{
+				Title:      "Game invitation",
+				Subtitle:   "Hellas · Prelude · Colonies",
+				Body:       "Squirrel invites you to a 3-player game",
+				Badge:      1,
+				Sound:      "default",
+				ThreadId:   "l1",
+				CollapseId: "l1",
+			},
+		},
+		{
+			name: "random board",
+			lobby: &storage.Lobby{
+				LobbyId:  "l2",
+				HostId:   "u2",
+				Settings: storage.GameSettings{VenusNext: true},
+				Members: []storage.LobbyMember{
+					{UserId: "u2", Nickname: "Andy"},
+					{UserId: "u1", Nickname: "Squirrel"},
+				},
+			},
+			want: push.Notification{
+				Title:      "Game invitation",
+				Subtitle:   "Random board · Venus Next",
+				Body:       "Andy invites you to a 2-player game",
+				Badge:      1,
+				Sound:      "default",
+				ThreadId:   "l2",
+				CollapseId: "l2",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.DeepEqual(t, inviteAlert(tc.lobby, 1), tc.want)
+		})
+	}
+}
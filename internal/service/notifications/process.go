@@ -2,23 +2,30 @@ package notifications
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 
 	"github.com/chestnut42/terraforming-mars-manager/internal/client/apn"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
 	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
 )
 
+var errNoNotifier = errors.New("no notifier for device platform")
+
 func (s *Service) processUser(ctx context.Context, userId string) error {
-	user, err := s.deps.Storage.GetUserById(ctx, userId)
-	if err != nil {
-		return fmt.Errorf("get user: %w", err)
-	}
-	if len(user.DeviceToken) == 0 {
-		logx.Logger(ctx).Debug("user has no device token", slog.String("uid", userId))
-		return nil
+	if _, err := s.deps.Storage.GetDevices(ctx, userId); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			logx.Logger(ctx).Debug("user has no devices", slog.String("uid", userId))
+			return nil
+		}
+		return fmt.Errorf("get devices: %w", err)
 	}
 
 	games, err := s.deps.Game.GetUserGames(ctx, userId)
@@ -26,66 +33,121 @@ func (s *Service) processUser(ctx context.Context, userId string) error {
 		return fmt.Errorf("get games: %w", err)
 	}
 
-	activeCount := 0
+	var active []*game.UserGame
 	for _, g := range games {
 		if g.AwaitsInput {
-			activeCount++
+			active = append(active, g)
 		}
 	}
+	activeCount := len(active)
+	activeIds := make([]string, activeCount)
+	for i, g := range active {
+		activeIds[i] = g.GameId
+	}
+	gameList := gameListHash(games)
 
 	if err := s.deps.Storage.UpdateSentNotification(ctx, userId,
 		func(ctx context.Context, state storage.UserNotificationState) (storage.UserNotificationState, error) {
-			if len(state.DeviceToken) == 0 {
-				logx.Logger(ctx).Debug("user locked with no device token", slog.String("uid", userId))
+			if len(state.Devices) == 0 {
+				logx.Logger(ctx).Debug("user locked with no devices", slog.String("uid", userId))
 				return state, nil
 			}
 
+			// Apps refresh the game list silently, regardless of alerts
+			if gameList != state.SentNotification.GameList {
+				state.Outbox = s.outbox(state, push.Notification{Background: true})
+				state.SentNotification.GameList = gameList
+			}
+
 			if activeCount == state.SentNotification.ActiveGames {
+				state.DeferredUntil = nil
 				return state, nil
 			}
 
 			// If active count decreased, just change the badge.
-			notification := apn.Notification{
+			notification := push.Notification{
 				Badge: activeCount,
 			}
-			if activeCount > state.SentNotification.ActiveGames {
-				gameText := "game is"
-				if activeCount > 1 {
-					gameText = "games are"
-				}
-				notification = apn.Notification{
-					Alert: apn.Alert{
-						Title:    "Mars awaits you!",
-						Subtitle: "",
-						Body:     fmt.Sprintf("%d %s awaiting for your decision", activeCount, gameText),
-					},
-					Badge: activeCount,
-					Sound: "default",
-				}
-			}
-
-			notifier := s.getNotifier(state.DeviceTokenType)
-			if err := notifier.SendNotification(ctx, state.DeviceToken, notification); err != nil {
-				if errors.Is(err, apn.ErrBadDeviceToken) {
-					state.DeviceTokenType = s.nextTokenType(state.DeviceTokenType)
+			if activeCount > state.SentNotification.ActiveGames && !state.Settings.BadgeOnly {
+				now := s.now()
+				if at := alertTime(state, now); now.Before(at) {
+					logx.Logger(ctx).Debug("alert deferred", slog.String("uid", userId), slog.Time("until", at))
+					state.DeferredUntil = &at
 					return state, nil
 				}
-				return storage.UserNotificationState{}, fmt.Errorf("send notification: %w", err)
+
+				notification = gameAlert(active, state.SentNotification.Games, activeCount)
 			}
 
-			state.SentNotification = storage.SentNotification{ActiveGames: activeCount}
+			state.Outbox = append(state.Outbox, s.outbox(state, notification)...)
+			state.SentNotification = storage.SentNotification{
+				ActiveGames: activeCount,
+				Games:       activeIds,
+				GameList:    gameList,
+			}
+			state.DeferredUntil = nil
 			return state, nil
 		}); err != nil {
 		return fmt.Errorf("update sent notification: %w", err)
 	}
+	s.wakeDispatcher()
 	return nil
 }
 
-func (s *Service) getNotifier(t storage.DeviceTokenType) Notifier {
-	if t == storage.DeviceTokenTypeSandbox {
-		return s.deps.SandboxNotifier
+// gameListHash changes whenever a game is added to or removed from the list or changes its status
+func gameListHash(games []*game.UserGame) string {
+	entries := make([]string, len(games))
+	for i, g := range games {
+		status := "in progress"
+		switch {
+		case g.HasFinished:
+			status = "finished"
+		case g.AwaitsInput:
+			status = "awaits input"
+		}
+		entries[i] = g.GameId + ":" + status
+	}
+	slices.Sort(entries)
+
+	sum := sha256.Sum256([]byte(strings.Join(entries, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// pushDevice sends the notification of the outbox message to the device and returns the device
+// with the updated environment. APNs tokens are tried in both environments before considered invalid.
+func (s *Service) pushDevice(ctx context.Context, m *storage.OutboxMessage, device storage.Device) (storage.Device, error) {
+	notifier, ok := s.getNotifier(device)
+	if !ok {
+		return device, errNoNotifier
+	}
+
+	err := s.push(ctx, m, notifier, device)
+	if err == nil || !errors.Is(err, apn.ErrBadDeviceToken) {
+		return device, err
+	}
+
+	device.Environment = s.nextTokenType(device.Environment)
+	notifier, _ = s.getNotifier(device)
+	if err := s.push(ctx, m, notifier, device); err != nil {
+		if errors.Is(err, apn.ErrBadDeviceToken) {
+			return device, fmt.Errorf("%w: %w", push.ErrInvalidToken, err)
+		}
+		return device, err
+	}
+	return device, nil
+}
+
+func (s *Service) getNotifier(device storage.Device) (Notifier, bool) {
+	switch device.Platform {
+	case storage.DevicePlatformAndroid:
+		return s.deps.AndroidNotifier, s.deps.AndroidNotifier != nil
+	case storage.DevicePlatformWeb:
+		return s.deps.WebNotifier, s.deps.WebNotifier != nil
+	}
+	if device.Environment == storage.DeviceTokenTypeSandbox {
+		return s.deps.SandboxNotifier, true
 	}
-	return s.deps.ProdNotifier
+	return s.deps.ProdNotifier, true
 }
 
 func (s *Service) nextTokenType(t storage.DeviceTokenType) storage.DeviceTokenType {
@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+)
+
+func TestGameListHash(t *testing.T) {
+	games := []*game.UserGame{
+		{GameId: "g1", AwaitsInput: true},
+		{GameId: "g2"},
+	}
+	hash := gameListHash(games)
+	assert.Equal(t, gameListHash([]*game.UserGame{games[1], games[0]}), hash)
+
+	for _, changed := range [][]*game.UserGame{
+		{{GameId: "g1"}, {GameId: "g2"}},
+		{{GameId: "g1", AwaitsInput: true}, {GameId: "g2", HasFinished: true}},
+		{{GameId: "g1", AwaitsInput: true}, {GameId: "g2"}, {GameId: "g3"}},
+		{{GameId: "g1", AwaitsInput: true}},
+	} {
+		assert.Assert(t, gameListHash(changed) != hash)
+	}
+}
+
+func TestService_RefreshUser(t *testing.T) {
+	s := NewService(Config{}, Dependencies{})
+	for i := 0; i < refreshQueueSize+1; i++ {
+		s.RefreshUser(context.Background(), "u1")
+	}
+	assert.Equal(t, len(s.refreshes), refreshQueueSize)
+}
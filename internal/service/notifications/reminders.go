@@ -0,0 +1,129 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+var errQuietHours = errors.New("quiet hours")
+
+// scanReminders reminds players that keep a game waiting according to the reminder schedule
+func (s *Service) scanReminders(ctx context.Context) error {
+	for {
+		s.sendReminders(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.ScanInterval):
+		}
+	}
+}
+
+func (s *Service) sendReminders(ctx context.Context) {
+	now := s.now()
+	players, err := s.deps.Storage.GetWaitingPlayers(ctx, now.Add(-s.cfg.ReminderSchedule[0]), len(s.cfg.ReminderSchedule))
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			logx.Logger(ctx).Error("failed to get waiting players", slog.Any("error", err))
+		}
+		return
+	}
+
+	for _, p := range players {
+		due := dueReminders(s.cfg.ReminderSchedule, now.Sub(p.WaitingSince))
+		if due <= p.RemindersSent {
+			continue
+		}
+
+		if err := s.remind(ctx, p.UserId, p.GameId, "Reminder: Mars awaits you!"); err != nil {
+			if !errors.Is(err, errQuietHours) {
+				logx.Logger(ctx).Error("failed to remind player",
+					slog.String("uid", p.UserId), slog.String("game", p.GameId), slog.Any("error", err))
+			}
+			continue
+		}
+		// Reminders missed while the service was down are not sent one by one
+		if err := s.deps.Storage.UpdateRemindersSent(ctx, p.PlayerId, p.WaitingSince, due); err != nil {
+			logx.Logger(ctx).Error("failed to update reminders sent",
+				slog.String("uid", p.UserId), slog.String("game", p.GameId), slog.Any("error", err))
+		}
+	}
+}
+
+// dueReminders returns the number of reminders that should have been sent after waiting for the duration
+func dueReminders(schedule []time.Duration, waiting time.Duration) int {
+	due := 0
+	for _, after := range schedule {
+		if waiting >= after {
+			due++
+		}
+	}
+	return due
+}
+
+// Nudge reminds the users about the game on behalf of another participant.
+// Users in quiet hours are not disturbed.
+func (s *Service) Nudge(ctx context.Context, gameId string, from string, userIds []string) error {
+	var errs []error
+	for _, uid := range userIds {
+		if err := s.remind(ctx, uid, gameId, fmt.Sprintf("%s nudged you", from)); err != nil {
+			if errors.Is(err, errQuietHours) {
+				logx.Logger(ctx).Debug("nudge skipped", slog.String("uid", uid), slog.String("game", gameId))
+				continue
+			}
+			errs = append(errs, fmt.Errorf("remind %s: %w", uid, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// remind sends an alert about the game if it still awaits the user's input.
+// errQuietHours is returned if the user is in quiet hours.
+func (s *Service) remind(ctx context.Context, userId string, gameId string, title string) error {
+	games, err := s.deps.Game.GetUserGames(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("get games: %w", err)
+	}
+	var g *game.UserGame
+	for _, ug := range games {
+		if ug.GameId == gameId && ug.AwaitsInput {
+			g = ug
+		}
+	}
+	if g == nil {
+		logx.Logger(ctx).Debug("game does not await input", slog.String("uid", userId), slog.String("game", gameId))
+		return nil
+	}
+
+	quiet := false
+	if err := s.deps.Storage.UpdateSentNotification(ctx, userId,
+		func(ctx context.Context, state storage.UserNotificationState) (storage.UserNotificationState, error) {
+			if len(state.Devices) == 0 || state.Settings.BadgeOnly {
+				return state, nil
+			}
+			if now := s.now(); quietHoursEnd(state.Settings, now).After(now) {
+				quiet = true
+				return state, nil
+			}
+
+			notification := gameAlert([]*game.UserGame{g}, nil, state.SentNotification.ActiveGames)
+			notification.Title = title
+			state.Outbox = s.outbox(state, notification)
+			return state, nil
+		}); err != nil {
+		return fmt.Errorf("update sent notification: %w", err)
+	}
+	if quiet {
+		return errQuietHours
+	}
+	s.wakeDispatcher()
+	return nil
+}
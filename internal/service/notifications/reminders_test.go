@@ -0,0 +1,23 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestDueReminders(t *testing.T) {
+	schedule := []time.Duration{4 * time.Hour, 12 * time.Hour, 24 * time.Hour}
+	for _, tc := range []struct {
+		waiting time.Duration
+		want    int
+	}{
+		{waiting: time.Hour, want: 0},
+		{waiting: 4 * time.Hour, want: 1},
+		{waiting: 13 * time.Hour, want: 2},
+		{waiting: 72 * time.Hour, want: 3},
+	} {
+		assert.Equal(t, dueReminders(schedule, tc.waiting), tc.want, "waiting %s", tc.waiting)
+	}
+}
@@ -0,0 +1,144 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+// scanGames announces results of the games once their ratings are applied
+func (s *Service) scanGames(ctx context.Context) error {
+	for {
+		for _, gameId := range s.getGamesToAnnounce(ctx) {
+			if err := s.announceGame(ctx, gameId); err != nil {
+				logx.Logger(ctx).Error("failed to announce game", slog.String("id", gameId), slog.Any("error", err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.cfg.ScanInterval):
+		}
+	}
+}
+
+func (s *Service) getGamesToAnnounce(ctx context.Context) []string {
+	games, err := s.deps.Storage.GetUnannouncedGames(ctx)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			logx.Logger(ctx).Error("failed to get unannounced games", slog.Any("error", err))
+		}
+		return nil
+	}
+	return games
+}
+
+// announceGame notifies every participant once, failed deliveries are not retried
+// so that other participants don't get the same notification again.
+func (s *Service) announceGame(ctx context.Context, gameId string) error {
+	details, err := s.deps.Game.GetGame(ctx, gameId)
+	if err != nil {
+		return fmt.Errorf("get game: %w", err)
+	}
+
+	for _, p := range details.Players {
+		if err := s.announceResults(ctx, details, p); err != nil {
+			logx.Logger(ctx).Error("failed to announce results",
+				slog.String("id", gameId), slog.String("uid", p.UserId), slog.Any("error", err))
+		}
+	}
+	if err := s.deps.Storage.UpdateGameAnnounced(ctx, gameId); err != nil {
+		return fmt.Errorf("update game announced: %w", err)
+	}
+
+	// Finished games are not scanned anymore, game lists are refreshed here.
+	// The game is marked announced first, so that a failed refresh doesn't alert anyone again.
+	for _, p := range details.Players {
+		if err := s.NotifyUser(ctx, p.UserId); err != nil {
+			return fmt.Errorf("notify user: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) announceResults(ctx context.Context, details *game.GameDetails, player *game.GameDetailsPlayer) error {
+	if err := s.deps.Storage.UpdateSentNotification(ctx, player.UserId,
+		func(ctx context.Context, state storage.UserNotificationState) (storage.UserNotificationState, error) {
+			if len(state.Devices) == 0 || state.Settings.BadgeOnly || state.Settings.MuteGameResults {
+				return state, nil
+			}
+
+			// The badge is kept as is, the number of awaiting games is not changed by this notification
+			notification := resultsAlert(details, player, state.SentNotification.ActiveGames, s.cfg.RatingSystem)
+
+			// Delivery waits for the minimum delay and the end of quiet hours like game alerts do
+			at := alertTime(state, s.now())
+			state.Outbox = s.outbox(state, notification)
+			for i := range state.Outbox {
+				state.Outbox[i].NotBefore = at
+			}
+			return state, nil
+		}); err != nil {
+		return fmt.Errorf("update sent notification: %w", err)
+	}
+	s.wakeDispatcher()
+	return nil
+}
+
+func resultsAlert(details *game.GameDetails, player *game.GameDetailsPlayer, badge int,
+	ratingSystem storage.RatingSystem) push.Notification {
+	var others []string
+	for _, p := range details.Players {
+		if p.UserId != player.UserId {
+			others = append(others, p.Nickname)
+		}
+	}
+	subtitle := "Solo game"
+	if len(others) > 0 {
+		subtitle = "Game with " + joinNames(others)
+	}
+
+	body := "The game is over"
+	if player.Place > 0 {
+		body = "You placed " + ordinal(player.Place)
+	}
+	switch {
+	case ratingSystem == storage.RatingSystemGlicko2 && player.GlickoChange != nil:
+		body += fmt.Sprintf(", rating %.0f → %.0f", player.GlickoChange.OldRating, player.GlickoChange.NewRating)
+	case ratingSystem != storage.RatingSystemGlicko2 && player.EloChange != nil:
+		body += fmt.Sprintf(", Elo %d → %d", player.EloChange.OldElo, player.EloChange.NewElo)
+	}
+
+	return push.Notification{
+		Title:      "Game finished",
+		Subtitle:   subtitle,
+		Body:       body,
+		Badge:      badge,
+		Sound:      "default",
+		ThreadId:   details.GameId,
+		CollapseId: details.GameId,
+		URL:        player.PlayURL,
+	}
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
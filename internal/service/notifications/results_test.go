@@ -0,0 +1,121 @@
+package notifications
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
+	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestResultsAlert(t *testing.T) {
+	details := &game.GameDetails{
+		GameId: "g1",
+		Players: []*game.GameDetailsPlayer{
+			{UserId: "u1", Nickname: "Squirrel", PlayURL: "https://mars.example.com/player?id=p1", Place: 1,
+				EloChange:    &game.EloChange{OldElo: 1012, NewElo: 1021},
+				GlickoChange: &game.GlickoChange{OldRating: 1500, NewRating: 1562.4}},
+			{UserId: "u2", Nickname: "Andy", PlayURL: "https://mars.example.com/player?id=p2", Place: 2,
+				EloChange: &game.EloChange{OldElo: 1000, NewElo: 991}},
+			{UserId: "u3", Nickname: "Bob", PlayURL: "https://mars.example.com/player?id=p3"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		player int
+		system storage.RatingSystem
+		want   push.Notification
+	}{
+		{
+			name:   "winner",
+			player: 0,
+			system: storage.RatingSystemElo,
+			want: push.Notification{
+				Title:      "Game finished",
+				Subtitle:   "Game with Andy and Bob",
+				Body:       "You placed 1st, Elo 1012 → 1021",
+				Badge:      2,
+				Sound:      "default",
+				ThreadId:   "g1",
+				CollapseId: "g1",
+				URL:        "https://mars.example.com/player?id=p1",
+			},
+		},
+		{
+			name:   "second",
+			player: 1,
+			system: storage.RatingSystemElo,
+			want: push.Notification{
+				Title:      "Game finished",
+				Subtitle:   "Game with Squirrel and Bob",
+				Body:       "You placed 2nd, Elo 1000 → 991",
+				Badge:      2,
+				Sound:      "default",
+				ThreadId:   "g1",
+				CollapseId: "g1",
+				URL:        "https://mars.example.com/player?id=p2",
+			},
+		},
+		{
+			name:   "glicko",
+			player: 0,
+			system: storage.RatingSystemGlicko2,
+			want: push.Notification{
+				Title:      "Game finished",
+				Subtitle:   "Game with Andy and Bob",
+				Body:       "You placed 1st, rating 1500 → 1562",
+				Badge:      2,
+				Sound:      "default",
+				ThreadId:   "g1",
+				CollapseId: "g1",
+				URL:        "https://mars.example.com/player?id=p1",
+			},
+		},
+		{
+			name:   "glicko without results",
+			player: 1,
+			system: storage.RatingSystemGlicko2,
+			want: push.Notification{
+				Title:      "Game finished",
+				Subtitle:   "Game with Squirrel and Bob",
+				Body:       "You placed 2nd",
+				Badge:      2,
+				Sound:      "default",
+				ThreadId:   "g1",
+				CollapseId: "g1",
+				URL:        "https://mars.example.com/player?id=p2",
+			},
+		},
+		{
+			name:   "no results",
+			player: 2,
+			system: storage.RatingSystemElo,
+			want: push.Notification{
+				Title:      "Game finished",
+				Subtitle:   "Game with Squirrel and Andy",
+				Body:       "The game is over",
+				Badge:      2,
+				Sound:      "default",
+				ThreadId:   "g1",
+				CollapseId: "g1",
+				URL:        "https://mars.example.com/player?id=p3",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := resultsAlert(details, details.Players[tc.player], 2, tc.system)
+			assert.DeepEqual(t, got, tc.want)
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	for n, want := range map[int]string{
+		1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd",
+	} {
+		assert.Equal(t, ordinal(n), want)
+	}
+}
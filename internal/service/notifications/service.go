@@ -9,7 +9,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
-	"github.com/chestnut42/terraforming-mars-manager/internal/client/apn"
+	"github.com/chestnut42/terraforming-mars-manager/internal/client/push"
 	"github.com/chestnut42/terraforming-mars-manager/internal/framework/logx"
 	"github.com/chestnut42/terraforming-mars-manager/internal/service/game"
 	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
@@ -18,21 +18,45 @@ import (
 type Config struct {
 	ActivityBuffer time.Duration
 	ScanInterval   time.Duration
+	StateMaxAge    time.Duration
 	WorkersCount   int
+	// ReminderSchedule lists increasing durations of a wait after which a reminder is sent, empty disables reminders
+	ReminderSchedule []time.Duration
+	// DispatchInterval is how often the outbox is checked for messages to retry
+	DispatchInterval time.Duration
+	// OutboxRetention is how long delivered and failed messages are kept, zero keeps them forever
+	OutboxRetention time.Duration
+	// AttemptRetention is how long the delivery log is kept, zero keeps it until its messages are pruned
+	AttemptRetention time.Duration
+	// RatingSystem is the rating shown in the game results
+	RatingSystem storage.RatingSystem
 }
 
 type Storage interface {
-	GetActiveUsers(ctx context.Context, activityBuffer time.Duration) ([]string, error)
-	GetUserById(ctx context.Context, userId string) (*storage.User, error)
+	ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]*storage.OutboxMessage, error)
+	DeleteDevice(ctx context.Context, userId string, token []byte) error
+	DeleteUnregisteredDevice(ctx context.Context, userId string, token []byte, unregisteredAt time.Time) error
+	GetActiveUsers(ctx context.Context, activityBuffer time.Duration, staleAge time.Duration) ([]string, error)
+	GetDevices(ctx context.Context, userId string) ([]storage.Device, error)
+	GetUnannouncedGames(ctx context.Context) ([]string, error)
+	GetWaitingPlayers(ctx context.Context, waitingBefore time.Time, maxReminders int) ([]*storage.WaitingPlayer, error)
+	InsertDeliveryAttempt(ctx context.Context, attempt storage.DeliveryAttempt) error
+	PruneDeliveryAttempts(ctx context.Context, before time.Time) (int64, error)
+	PruneOutbox(ctx context.Context, before time.Time) (int64, error)
+	UpdateDeviceEnvironment(ctx context.Context, userId string, token []byte, environment storage.DeviceTokenType) error
+	UpdateGameAnnounced(ctx context.Context, gameId string) error
+	UpdateOutboxMessage(ctx context.Context, req storage.UpdateOutboxMessage) error
+	UpdateRemindersSent(ctx context.Context, playerId string, waitingSince time.Time, sent int) error
 	UpdateSentNotification(ctx context.Context, userId string, updater storage.SentNotificationUpdater) error
 }
 
 type GameService interface {
+	GetGame(ctx context.Context, gameId string) (*game.GameDetails, error)
 	GetUserGames(ctx context.Context, userId string) ([]*game.UserGame, error)
 }
 
 type Notifier interface {
-	SendNotification(ctx context.Context, device []byte, n apn.Notification) error
+	Push(ctx context.Context, device []byte, n push.Notification) (push.Result, error)
 }
 
 type Dependencies struct {
@@ -40,13 +64,22 @@ type Dependencies struct {
 	Game            GameService
 	SandboxNotifier Notifier
 	ProdNotifier    Notifier
+	// AndroidNotifier and WebNotifier are optional, devices of their platforms are skipped without them
+	AndroidNotifier Notifier
+	WebNotifier     Notifier
 }
 
+// refreshQueueSize bounds game list refreshes waiting for a worker, more are dropped
+const refreshQueueSize = 1024
+
 type Service struct {
 	cfg  Config
 	deps Dependencies
 
-	users chan string
+	users     chan string
+	refreshes chan string
+	wake      chan struct{}
+	now       func() time.Time
 }
 
 func NewService(cfg Config, deps Dependencies) *Service {
@@ -54,7 +87,10 @@ func NewService(cfg Config, deps Dependencies) *Service {
 		cfg:  cfg,
 		deps: deps,
 
-		users: make(chan string),
+		users:     make(chan string),
+		refreshes: make(chan string, refreshQueueSize),
+		wake:      make(chan struct{}, 1),
+		now:       time.Now,
 	}
 }
 
@@ -67,6 +103,15 @@ func (s *Service) NotifyUser(ctx context.Context, userId string) error {
 	}
 }
 
+// RefreshUser queues a game list refresh for the user without waiting for a worker
+func (s *Service) RefreshUser(ctx context.Context, userId string) {
+	select {
+	case s.refreshes <- userId:
+	default:
+		logx.Logger(ctx).Warn("refresh queue is full, refresh dropped", slog.String("uid", userId))
+	}
+}
+
 func (s *Service) Run(ctx context.Context) error {
 	if s.cfg.WorkersCount <= 0 {
 		return fmt.Errorf("workersCount must be greater than zero: %d", s.cfg.WorkersCount)
@@ -76,6 +121,22 @@ func (s *Service) Run(ctx context.Context) error {
 	eg.Go(func() error {
 		return s.scanUsers(ctx)
 	})
+	eg.Go(func() error {
+		return s.scanGames(ctx)
+	})
+	eg.Go(func() error {
+		return s.dispatch(ctx)
+	})
+	if len(s.cfg.ReminderSchedule) > 0 {
+		eg.Go(func() error {
+			return s.scanReminders(ctx)
+		})
+	}
+	if s.cfg.OutboxRetention > 0 || s.cfg.AttemptRetention > 0 {
+		eg.Go(func() error {
+			return s.prune(ctx)
+		})
+	}
 	for i := 0; i < s.cfg.WorkersCount; i++ {
 		eg.Go(func() error {
 			return s.worker(ctx)
@@ -104,17 +165,22 @@ func (s *Service) worker(ctx context.Context) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case uid := <-s.refreshes:
+			s.process(ctx, uid)
 		case uid := <-s.users:
-			err := s.processUser(ctx, uid)
-			if err != nil {
-				logx.Logger(ctx).Error("failed to process user", slog.String("uid", uid), slog.Any("error", err))
-			}
+			s.process(ctx, uid)
 		}
 	}
 }
 
+func (s *Service) process(ctx context.Context, uid string) {
+	if err := s.processUser(ctx, uid); err != nil {
+		logx.Logger(ctx).Error("failed to process user", slog.String("uid", uid), slog.Any("error", err))
+	}
+}
+
 func (s *Service) getUsersToProcess(ctx context.Context) []string {
-	users, err := s.deps.Storage.GetActiveUsers(ctx, s.cfg.ActivityBuffer)
+	users, err := s.deps.Storage.GetActiveUsers(ctx, s.cfg.ActivityBuffer, s.cfg.StateMaxAge)
 	if err != nil {
 		if !errors.Is(err, storage.ErrNotFound) {
 			logx.Logger(ctx).Error("failed to get active users", slog.Any("error", err))
@@ -0,0 +1,54 @@
+package notifications
+
+import (
+	"slices"
+	"time"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+// alertTime returns the earliest time an alert may be sent to the user
+func alertTime(state storage.UserNotificationState, now time.Time) time.Time {
+	at := now.Add(state.Settings.MinDelay)
+	if state.DeferredUntil != nil {
+		at = *state.DeferredUntil
+	}
+	if at.Before(now) {
+		at = now
+	}
+	return quietHoursEnd(state.Settings, at)
+}
+
+// quietHoursEnd returns the end of quiet hours if t is within them, otherwise t
+func quietHoursEnd(settings storage.NotificationSettings, t time.Time) time.Time {
+	qh := settings.QuietHours
+	if qh == nil || qh.StartMinute == qh.EndMinute {
+		return t
+	}
+
+	loc, err := time.LoadLocation(settings.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+
+	quiet := qh.StartMinute <= minute && minute < qh.EndMinute
+	if qh.StartMinute > qh.EndMinute {
+		// The window wraps around midnight
+		quiet = minute >= qh.StartMinute || minute < qh.EndMinute
+	}
+	if !quiet {
+		return t
+	}
+
+	end := time.Date(local.Year(), local.Month(), local.Day(), qh.EndMinute/60, qh.EndMinute%60, 0, 0, loc)
+	if !end.After(local) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+func platformEnabled(settings storage.NotificationSettings, platform storage.DevicePlatform) bool {
+	return !slices.Contains(settings.DisabledPlatforms, platform)
+}
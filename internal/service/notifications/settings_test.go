@@ -0,0 +1,108 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/chestnut42/terraforming-mars-manager/internal/storage"
+)
+
+func TestQuietHoursEnd(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NilError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		settings storage.NotificationSettings
+		t        time.Time
+		want     time.Time
+	}{
+		{
+			name:     "no quiet hours",
+			settings: storage.NotificationSettings{},
+			t:        time.Date(2024, 8, 10, 3, 0, 0, 0, time.UTC),
+			want:     time.Date(2024, 8, 10, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "outside of window",
+			settings: storage.NotificationSettings{
+				QuietHours: &storage.QuietHours{StartMinute: 13 * 60, EndMinute: 14 * 60},
+			},
+			t:    time.Date(2024, 8, 10, 12, 59, 0, 0, time.UTC),
+			want: time.Date(2024, 8, 10, 12, 59, 0, 0, time.UTC),
+		},
+		{
+			name: "within window",
+			settings: storage.NotificationSettings{
+				QuietHours: &storage.QuietHours{StartMinute: 13 * 60, EndMinute: 14*60 + 30},
+			},
+			t:    time.Date(2024, 8, 10, 13, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 8, 10, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "window end is not quiet",
+			settings: storage.NotificationSettings{
+				QuietHours: &storage.QuietHours{StartMinute: 13 * 60, EndMinute: 14 * 60},
+			},
+			t:    time.Date(2024, 8, 10, 14, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 8, 10, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "overnight before midnight",
+			settings: storage.NotificationSettings{
+				QuietHours: &storage.QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60},
+				TimeZone:   "Europe/Berlin",
+			},
+			t:    time.Date(2024, 8, 10, 23, 30, 0, 0, berlin),
+			want: time.Date(2024, 8, 11, 7, 0, 0, 0, berlin),
+		},
+		{
+			name: "overnight after midnight",
+			settings: storage.NotificationSettings{
+				QuietHours: &storage.QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60},
+				TimeZone:   "Europe/Berlin",
+			},
+			// 02:00 in Berlin
+			t:    time.Date(2024, 8, 11, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 8, 11, 7, 0, 0, 0, berlin),
+		},
+		{
+			name: "overnight daytime",
+			settings: storage.NotificationSettings{
+				QuietHours: &storage.QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60},
+				TimeZone:   "Europe/Berlin",
+			},
+			t:    time.Date(2024, 8, 11, 12, 0, 0, 0, berlin),
+			want: time.Date(2024, 8, 11, 12, 0, 0, 0, berlin),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := quietHoursEnd(tc.settings, tc.t)
+			assert.Assert(t, got.Equal(tc.want), "got %s, want %s", got, tc.want)
+		})
+	}
+}
+
+func TestAlertTime(t *testing.T) {
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+	deferred := now.Add(-time.Minute)
+
+	assert.Equal(t, alertTime(storage.UserNotificationState{}, now), now)
+	assert.Equal(t, alertTime(storage.UserNotificationState{
+		Settings: storage.NotificationSettings{MinDelay: 10 * time.Minute},
+	}, now), now.Add(10*time.Minute))
+	// Deferred alert is due
+	assert.Equal(t, alertTime(storage.UserNotificationState{
+		Settings:      storage.NotificationSettings{MinDelay: 10 * time.Minute},
+		DeferredUntil: &deferred,
+	}, now), now)
+	// Minimum delay ends within quiet hours
+	assert.Equal(t, alertTime(storage.UserNotificationState{
+		Settings: storage.NotificationSettings{
+			MinDelay:   30 * time.Minute,
+			QuietHours: &storage.QuietHours{StartMinute: 12*60 + 15, EndMinute: 13 * 60},
+		},
+	}, now), time.Date(2024, 8, 10, 13, 0, 0, 0, time.UTC))
+}
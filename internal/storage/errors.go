@@ -8,7 +8,12 @@ import (
 
 var (
 	ErrAlreadyExists = errors.New("already exists")
+	ErrLobbyFull     = errors.New("lobby is full")
 	ErrNotFound      = errors.New("not found")
+	ErrRateLimited   = errors.New("rate limited")
+
+	errRatingsRejected = errors.New("ratings rejected")
+	errRollback        = errors.New("rollback")
 )
 
 func errIsUniqueViolation(err error) bool {
@@ -29,6 +29,15 @@ const (
 	DeviceTokenTypeProduction DeviceTokenType = "prod"
 )
 
+type DevicePlatform string
+
+const (
+	DevicePlatformIOS     DevicePlatform = "ios"
+	DevicePlatformAndroid DevicePlatform = "android"
+	// DevicePlatformWeb devices hold a JSON encoded browser push subscription as token
+	DevicePlatformWeb DevicePlatform = "web"
+)
+
 type UserType string
 
 const (
@@ -36,16 +45,38 @@ const (
 	UserTypeActive UserType = "active" // Users that has changed their username and are ready to play
 )
 
+type RatingSystem string
+
+const (
+	RatingSystemElo     RatingSystem = "elo"
+	RatingSystemGlicko2 RatingSystem = "glicko2"
+)
+
+type Glicko struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+}
+
 type User struct {
-	UserId          string
-	Nickname        string
-	Color           Color
-	CreatedAt       time.Time
-	DeviceToken     []byte
-	DeviceTokenType DeviceTokenType
-	LastIp          string
-	Type            UserType
-	Elo             int64
+	UserId    string
+	Nickname  string
+	Color     Color
+	CreatedAt time.Time
+	LastIp    string
+	Type      UserType
+	Elo       int64
+	Glicko    Glicko
+}
+
+// Device is a device of a user registered for push notifications
+type Device struct {
+	Token       []byte
+	UserId      string
+	Platform    DevicePlatform
+	Environment DeviceTokenType
+	AppVersion  string
+	LastSeenAt  time.Time
 }
 
 type Game struct {
@@ -55,7 +86,77 @@ type Game struct {
 	ExpiresAt   time.Time
 	FinishedAt  *time.Time
 	Players     []Player
+	Settings    *GameSettings
+	State       *GameState
 	GameResults *GameResults
+	EloResults  *EloResults
+	// GlickoResults are set together with EloResults
+	GlickoResults *GlickoResults
+	// SeriesId is the id of the first game of the rematch series, empty if the game is not in a series
+	SeriesId  string
+	RematchOf string
+}
+
+// GameState is the last known state of a game on the Mars server
+type GameState struct {
+	WaitingFor   []Color           `json:"waitingFor"`
+	Phase        string            `json:"phase"`
+	Generation   int               `json:"generation,omitempty"`
+	PlayersCount int               `json:"playersCount"`
+	Players      []GameStatePlayer `json:"players,omitempty"`
+	UpdatedAt    time.Time         `json:"-"`
+}
+
+type GameStatePlayer struct {
+	Name  string `json:"name"`
+	Color Color  `json:"color"`
+}
+
+// GameSettings are the settings a game was created with.
+// Games created before the settings were persisted have none.
+type GameSettings struct {
+	Board        string  `json:"board"`
+	CorporateEra bool    `json:"corporateEra"`
+	Prelude      bool    `json:"prelude"`
+	VenusNext    bool    `json:"venusNext"`
+	SolarPhase   bool    `json:"solarPhase"`
+	Colonies     bool    `json:"colonies"`
+	Seed         float64 `json:"seed"`
+	FirstUserId  string  `json:"firstUserId"`
+	// Set for games created with the full options of the Mars server
+	Advanced *AdvancedSettings `json:"advanced,omitempty"`
+}
+
+type AdvancedSettings struct {
+	Prelude2                     bool     `json:"prelude2"`
+	Turmoil                      bool     `json:"turmoil"`
+	Promo                        bool     `json:"promo"`
+	Community                    bool     `json:"community"`
+	Ares                         bool     `json:"ares"`
+	Moon                         bool     `json:"moon"`
+	Pathfinders                  bool     `json:"pathfinders"`
+	Ceos                         bool     `json:"ceos"`
+	Underworld                   bool     `json:"underworld"`
+	Undo                         bool     `json:"undo"`
+	ShowTimers                   bool     `json:"showTimers"`
+	FastMode                     bool     `json:"fastMode"`
+	ShowOtherPlayersVP           bool     `json:"showOtherPlayersVP"`
+	Draft                        bool     `json:"draft"`
+	InitialDraft                 bool     `json:"initialDraft"`
+	PreludeDraft                 bool     `json:"preludeDraft"`
+	StartingCorporations         int      `json:"startingCorporations,omitempty"`
+	StartingCeos                 int      `json:"startingCeos,omitempty"`
+	ShuffleMap                   bool     `json:"shuffleMap"`
+	RandomMA                     string   `json:"randomMA,omitempty"`
+	IncludeVenusMA               bool     `json:"includeVenusMA"`
+	IncludeFanMA                 bool     `json:"includeFanMA"`
+	RemoveNegativeGlobalEvents   bool     `json:"removeNegativeGlobalEvents"`
+	AltVenusBoard                bool     `json:"altVenusBoard"`
+	RequiresVenusTrackCompletion bool     `json:"requiresVenusTrackCompletion"`
+	RequiresMoonTrackCompletion  bool     `json:"requiresMoonTrackCompletion"`
+	TwoCorpsVariant              bool     `json:"twoCorpsVariant"`
+	BannedCards                  []string `json:"bannedCards,omitempty"`
+	IncludedCards                []string `json:"includedCards,omitempty"`
 }
 
 type Player struct {
@@ -64,14 +165,190 @@ type Player struct {
 	Color    Color
 }
 
+// WaitingPlayer is a player the game has been waiting for since WaitingSince
+type WaitingPlayer struct {
+	GameId        string
+	UserId        string
+	PlayerId      string
+	WaitingSince  time.Time
+	RemindersSent int
+}
+
+type LobbyStatus string
+
+const (
+	LobbyStatusOpen     LobbyStatus = "open"
+	LobbyStatusStarting LobbyStatus = "starting" // The game is being created
+	LobbyStatusStarted  LobbyStatus = "started"
+	LobbyStatusExpired  LobbyStatus = "expired" // Never stored, open lobbies past their expiry are reported as expired
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+)
+
+// Lobby gathers players for a game which is created when they all accept
+type Lobby struct {
+	LobbyId string
+	HostId  string
+	// Settings Board is empty if the board is chosen at random on start
+	Settings  GameSettings
+	Status    LobbyStatus
+	GameId    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// Members go in the order of invitation, the host is the first one
+	Members []LobbyMember
+}
+
+type LobbyMember struct {
+	UserId      string
+	Nickname    string
+	Color       Color
+	Status      InvitationStatus
+	RespondedAt *time.Time
+}
+
+// MatchmakingPreferences are the games a queued player agrees to play
+type MatchmakingPreferences struct {
+	// PlayersCounts are acceptable numbers of players in the game
+	PlayersCounts []int `json:"playersCounts"`
+	// Settings Board is empty if any board will do, expansions must be the same for all players
+	Settings GameSettings `json:"settings"`
+	// RatingRange is the maximum Elo difference to other players, any if zero
+	RatingRange int64 `json:"ratingRange,omitempty"`
+}
+
+// QueueEntry is a player waiting for matchmaking since EnqueuedAt
+type QueueEntry struct {
+	UserId      string
+	Nickname    string
+	Color       Color
+	Elo         int64
+	Preferences MatchmakingPreferences
+	EnqueuedAt  time.Time
+}
+
+// Preset is a named set of game settings, it can be shared with other users
+type Preset struct {
+	PresetId string
+	OwnerId  string
+	Name     string
+	// Settings have no seed and first player, the board is random if empty
+	Settings  GameSettings
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Users can use the preset, the owner is the first one
+	Users []PresetUser
+}
+
+type PresetUser struct {
+	UserId   string
+	Nickname string
+}
+
 type SentNotification struct {
 	ActiveGames int `json:"ag"`
+	// Games are ids of the games that were awaiting input
+	Games []string `json:"g,omitempty"`
+	// GameList is a hash of the game list the user devices were last told to refresh for
+	GameList string `json:"gl,omitempty"`
+}
+
+// NotificationSettings are preferences of a user, zero value sends every notification right away
+type NotificationSettings struct {
+	DisabledPlatforms []DevicePlatform `json:"disabledPlatforms,omitempty"`
+	QuietHours        *QuietHours      `json:"quietHours,omitempty"`
+	// TimeZone is an IANA time zone name quiet hours are in, UTC if empty
+	TimeZone  string        `json:"timeZone,omitempty"`
+	BadgeOnly bool          `json:"badgeOnly,omitempty"`
+	MinDelay  time.Duration `json:"minDelay,omitempty"`
+	// MuteGameResults disables notifications about finished games
+	MuteGameResults bool `json:"muteGameResults,omitempty"`
+}
+
+// QuietHours is a daily window in minutes since midnight, the window may wrap around midnight
+type QuietHours struct {
+	StartMinute int `json:"startMinute"`
+	EndMinute   int `json:"endMinute"`
 }
 
 type UserNotificationState struct {
-	DeviceToken      []byte
-	DeviceTokenType  DeviceTokenType
+	Devices          []Device
+	Settings         NotificationSettings
 	SentNotification SentNotification
+	// DeferredUntil is set when an alert waits for the quiet hours end or the minimum delay
+	DeferredUntil *time.Time
+	// Outbox messages are enqueued together with the state, only Token and Payload are used
+	Outbox []OutboxMessage
+}
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"
+	OutboxStatusDelivered OutboxStatus = "delivered"
+	OutboxStatusFailed    OutboxStatus = "failed"
+)
+
+// OutboxMessage is a notification to a single device waiting to be delivered
+type OutboxMessage struct {
+	Id        int64
+	UserId    string
+	Token     []byte
+	Payload   PushPayload
+	Status    OutboxStatus
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+	// NotBefore defers the first delivery attempt, zero means right away
+	NotBefore time.Time
+	// Device is set by ClaimOutbox, it is nil if the device was removed since then
+	Device *Device
+}
+
+// DeliveryAttempt is a single request to a push service made for an outbox message
+type DeliveryAttempt struct {
+	Id          int64
+	OutboxId    int64
+	UserId      string
+	Token       []byte
+	Platform    DevicePlatform
+	Environment DeviceTokenType
+	// PayloadHash identifies the delivered content without storing it twice
+	PayloadHash string
+	MessageId   string
+	// Status is the HTTP status of the response, zero if there was no response
+	Status    int
+	Reason    string
+	Error     string
+	Latency   time.Duration
+	CreatedAt time.Time
+}
+
+// PushPayload is the content of an outbox message
+type PushPayload struct {
+	Title      string `json:"title,omitempty"`
+	Subtitle   string `json:"subtitle,omitempty"`
+	Body       string `json:"body,omitempty"`
+	Badge      int    `json:"badge"`
+	Sound      string `json:"sound,omitempty"`
+	ThreadId   string `json:"threadId,omitempty"`
+	CollapseId string `json:"collapseId,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Background bool   `json:"background,omitempty"`
+}
+
+// UpdateOutboxMessage records a delivery attempt
+type UpdateOutboxMessage struct {
+	Id            int64
+	Status        OutboxStatus
+	NextAttemptAt time.Time
+	LastError     string
 }
 
 type SentNotificationUpdater func(ctx context.Context, state UserNotificationState) (UserNotificationState, error)
@@ -97,9 +374,41 @@ type EloResults struct {
 	Players []EloResultsPlayer
 }
 
+type GlickoResultsPlayer struct {
+	PlayerId  string
+	UserId    string
+	OldGlicko Glicko
+	NewGlicko Glicko
+}
+
+type GlickoResults struct {
+	Players []GlickoResultsPlayer
+}
+
+// RatingResults holds the outcome of every rating system for a single game.
+type RatingResults struct {
+	Elo    EloResults
+	Glicko GlickoResults
+
+	// Buckets lists rating buckets (e.g. player count or board) the game belongs to.
+	// The updater is called once more for each bucket with the bucket ratings of the players.
+	Buckets []string
+}
+
+type RatingHistory struct {
+	UserId    string
+	GameId    string
+	OldElo    int64
+	NewElo    int64
+	OldGlicko Glicko
+	NewGlicko Glicko
+	CreatedAt time.Time
+}
+
 type EloStateUser struct {
 	UserId string
 	Elo    int64
+	Glicko Glicko
 }
 
 type EloUpdateState struct {
@@ -107,7 +416,7 @@ type EloUpdateState struct {
 	Users []EloStateUser
 }
 
-type EloUpdater func(ctx context.Context, state EloUpdateState) (EloResults, error)
+type EloUpdater func(ctx context.Context, state EloUpdateState) (RatingResults, error)
 
 func (sn *SentNotification) Value() (driver.Value, error) {
 	return json.Marshal(sn)
@@ -127,6 +436,116 @@ func (sn *SentNotification) Scan(value interface{}) error {
 	return json.Unmarshal(b, &sn)
 }
 
+func (ns *NotificationSettings) Value() (driver.Value, error) {
+	v, err := json.Marshal(ns)
+	if err != nil {
+		return nil, fmt.Errorf("marshal NotificationSettings failed: %v", err)
+	}
+	return v, nil
+}
+
+func (ns *NotificationSettings) Scan(value interface{}) error {
+	if value == nil {
+		*ns = NotificationSettings{}
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, ns)
+}
+
+func (pp *PushPayload) Value() (driver.Value, error) {
+	v, err := json.Marshal(pp)
+	if err != nil {
+		return nil, fmt.Errorf("marshal PushPayload failed: %v", err)
+	}
+	return v, nil
+}
+
+func (pp *PushPayload) Scan(value interface{}) error {
+	if value == nil {
+		*pp = PushPayload{}
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, pp)
+}
+
+func (mp *MatchmakingPreferences) Value() (driver.Value, error) {
+	v, err := json.Marshal(mp)
+	if err != nil {
+		return nil, fmt.Errorf("marshal MatchmakingPreferences failed: %v", err)
+	}
+	return v, nil
+}
+
+func (mp *MatchmakingPreferences) Scan(value interface{}) error {
+	if value == nil {
+		*mp = MatchmakingPreferences{}
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, mp)
+}
+
+func (gs *GameSettings) Value() (driver.Value, error) {
+	v, err := json.Marshal(gs)
+	if err != nil {
+		return nil, fmt.Errorf("marshal GameSettings failed: %v", err)
+	}
+	return v, nil
+}
+
+func (gs *GameSettings) Scan(value interface{}) error {
+	if value == nil {
+		*gs = GameSettings{}
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, gs)
+}
+
+func (gs *GameState) Value() (driver.Value, error) {
+	v, err := json.Marshal(gs)
+	if err != nil {
+		return nil, fmt.Errorf("marshal GameState failed: %v", err)
+	}
+	return v, nil
+}
+
+func (gs *GameState) Scan(value interface{}) error {
+	if value == nil {
+		*gs = GameState{}
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, gs)
+}
+
 type GameResults struct {
 	Raw map[string]any
 }
@@ -170,3 +589,21 @@ func (er *EloResults) Scan(value interface{}) error {
 
 	return json.Unmarshal(b, &er)
 }
+
+func (gr *GlickoResults) Value() (driver.Value, error) {
+	return json.Marshal(gr)
+}
+
+func (gr *GlickoResults) Scan(value interface{}) error {
+	if value == nil {
+		*gr = GlickoResults{}
+		return nil
+	}
+
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &gr)
+}
@@ -11,48 +11,323 @@ import (
 type Storage struct {
 	db *sql.DB
 
-	getActiveGames        *sql.Stmt
-	getActiveUsers        *sql.Stmt
-	getGameByPlayerId     *sql.Stmt
-	getGamePlayersAndElo  *sql.Stmt
-	getGamesByUserId      *sql.Stmt
-	getLeaderboard        *sql.Stmt
-	getOldestFinishedGame *sql.Stmt
-	getUserById           *sql.Stmt
-	getUserByNickname     *sql.Stmt
-	insertGame            *sql.Stmt
-	insertPlayer          *sql.Stmt
-	lockUser              *sql.Stmt
-	searchUsers           *sql.Stmt
-	updateDeviceToken     *sql.Stmt
-	updateGameEloResults  *sql.Stmt
-	updateGameResults     *sql.Stmt
-	updateLockedUser      *sql.Stmt
-	updateUser            *sql.Stmt
-	updateUserElo         *sql.Stmt
-	upsertUser            *sql.Stmt
+	claimLobby                 *sql.Stmt
+	claimOutbox                *sql.Stmt
+	claimQueueEntry            *sql.Stmt
+	claimRematch               *sql.Stmt
+	clearLegacyDevice          *sql.Stmt
+	deleteDeliveryAttempts     *sql.Stmt
+	deleteDevice               *sql.Stmt
+	deleteOutbox               *sql.Stmt
+	deletePreset               *sql.Stmt
+	deletePresetUsers          *sql.Stmt
+	deleteQueueEntry           *sql.Stmt
+	deleteUnregisteredDevice   *sql.Stmt
+	getActiveGames             *sql.Stmt
+	getActiveUsers             *sql.Stmt
+	getBucketGlickoLeaderboard *sql.Stmt
+	getBucketLeaderboard       *sql.Stmt
+	getDeliveryAttempts        *sql.Stmt
+	getDevicesByUserId         *sql.Stmt
+	getFinishedGames           *sql.Stmt
+	getFinishedGamesByUserId   *sql.Stmt
+	getGameById                *sql.Stmt
+	getGameByPlayerId          *sql.Stmt
+	getGamePlayersAndElo       *sql.Stmt
+	getGamesByUserId           *sql.Stmt
+	getGlickoLeaderboard       *sql.Stmt
+	getLeaderboard             *sql.Stmt
+	getLobbiesByUserId         *sql.Stmt
+	getLobbyById               *sql.Stmt
+	getLobbyMemberIds          *sql.Stmt
+	getNotificationSettings    *sql.Stmt
+	getOldestFinishedGame      *sql.Stmt
+	getPresetById              *sql.Stmt
+	getPresetsByUserId         *sql.Stmt
+	getQueue                   *sql.Stmt
+	getQueueEntry              *sql.Stmt
+	getRatingHistory           *sql.Stmt
+	getRematchId               *sql.Stmt
+	getUnannouncedGames        *sql.Stmt
+	getUserById                *sql.Stmt
+	getUserByNickname          *sql.Stmt
+	getUserRatings             *sql.Stmt
+	getWaitingPlayers          *sql.Stmt
+	insertBucketRating         *sql.Stmt
+	insertDeliveryAttempt      *sql.Stmt
+	insertGame                 *sql.Stmt
+	insertLobby                *sql.Stmt
+	insertLobbyMember          *sql.Stmt
+	insertOutboxMessage        *sql.Stmt
+	insertPlayer               *sql.Stmt
+	insertPreset               *sql.Stmt
+	insertPresetUser           *sql.Stmt
+	insertQueueEntry           *sql.Stmt
+	insertRatingHistory        *sql.Stmt
+	lockBucketRating           *sql.Stmt
+	lockLobby                  *sql.Stmt
+	lockUser                   *sql.Stmt
+	releaseLobby               *sql.Stmt
+	releaseRematch             *sql.Stmt
+	resetBucketRatings         *sql.Stmt
+	resetGameRatings           *sql.Stmt
+	resetRatingHistory         *sql.Stmt
+	resetUserRatings           *sql.Stmt
+	searchUsers                *sql.Stmt
+	updateBucketRating         *sql.Stmt
+	updateDeviceEnvironment    *sql.Stmt
+	updateGameAnnounced        *sql.Stmt
+	updateGameEloResults       *sql.Stmt
+	updateGameNudged           *sql.Stmt
+	updateGameRematchOf        *sql.Stmt
+	updateGameResults          *sql.Stmt
+	updateGameState            *sql.Stmt
+	updateLegacyDevice         *sql.Stmt
+	updateLobbyMember          *sql.Stmt
+	updateLockedUser           *sql.Stmt
+	updateNotificationSettings *sql.Stmt
+	updateOutboxMessage        *sql.Stmt
+	updatePreset               *sql.Stmt
+	updateRemindersSent        *sql.Stmt
+	updateUser                 *sql.Stmt
+	updateUserElo              *sql.Stmt
+	updateUserGlicko           *sql.Stmt
+	upsertDevice               *sql.Stmt
+	upsertQueueEntry           *sql.Stmt
+	upsertUser                 *sql.Stmt
 
 	nowFunc func() time.Time
 }
 
 func New(db *sql.DB) (*Storage, error) {
+	// Open lobbies past their expiry can't be claimed, lobbies claimed before $3 are reclaimed
+	claimLobby, err := db.Prepare(`
+		UPDATE manager_lobbies SET status = 'starting', claimed_at = $2, updated_at = $2
+			WHERE id = $1 AND ((status = 'open' AND expires_at > $2) OR (status = 'starting' AND claimed_at <= $3))
+			RETURNING claimed_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare claimLobby: %w", err)
+	}
+
+	// Claimed messages are leased until $3, so other dispatchers skip them
+	claimOutbox, err := db.Prepare(`
+		WITH claimed AS (
+			SELECT id FROM manager_outbox
+				WHERE status = 'pending' AND next_attempt_at <= $1
+				ORDER BY next_attempt_at, id LIMIT $2
+				FOR UPDATE SKIP LOCKED
+		), updated AS (
+			UPDATE manager_outbox SET next_attempt_at = $3 FROM claimed WHERE manager_outbox.id = claimed.id
+			RETURNING manager_outbox.id, manager_outbox.user_id, manager_outbox.token, manager_outbox.payload,
+			          manager_outbox.status, manager_outbox.attempts, manager_outbox.last_error,
+			          manager_outbox.created_at
+		)
+		SELECT updated.id, updated.user_id, updated.token, updated.payload, updated.status, updated.attempts,
+		       updated.last_error, updated.created_at,
+		       manager_devices.platform, manager_devices.environment, manager_devices.app_version,
+		       manager_devices.last_seen_at
+			FROM updated LEFT JOIN manager_devices
+				ON manager_devices.token = updated.token AND manager_devices.user_id = updated.user_id
+			ORDER BY updated.id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare claimOutbox: %w", err)
+	}
+
+	// The entry is claimed only if the player has not enqueued again since it was read
+	claimQueueEntry, err := db.Prepare(`
+		DELETE FROM manager_matchmaking_queue WHERE user_id = $1 AND enqueued_at = $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare claimQueueEntry: %w", err)
+	}
+
+	// Games rematched already can't be claimed, claims made before $3 are taken over
+	claimRematch, err := db.Prepare(`
+		UPDATE manager_games SET rematch_claimed_at = $2
+			WHERE id = $1 AND coalesce(rematch_claimed_at, '-infinity') <= $3 AND NOT EXISTS (
+				SELECT 1 FROM manager_games AS rematch WHERE rematch.rematch_of = $1
+			)
+			RETURNING rematch_claimed_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare claimRematch: %w", err)
+	}
+
+	// The previous release reads a single device of the user from manager_users
+	clearLegacyDevice, err := db.Prepare(`
+		UPDATE manager_users SET device_token = NULL WHERE id = $1 AND device_token = $2 AND NOT EXISTS (
+			SELECT 1 FROM manager_devices WHERE token = $2 AND user_id = $1
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare clearLegacyDevice: %w", err)
+	}
+
+	deleteDeliveryAttempts, err := db.Prepare(`
+		DELETE FROM manager_delivery_attempts WHERE created_at < $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deleteDeliveryAttempts: %w", err)
+	}
+
+	deleteDevice, err := db.Prepare(`
+		DELETE FROM manager_devices WHERE token = $1 AND user_id = $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deleteDevice: %w", err)
+	}
+
+	deleteOutbox, err := db.Prepare(`
+		DELETE FROM manager_outbox WHERE status <> 'pending' AND updated_at < $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deleteOutbox: %w", err)
+	}
+
+	deletePreset, err := db.Prepare(`
+		DELETE FROM manager_presets WHERE id = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deletePreset: %w", err)
+	}
+
+	deletePresetUsers, err := db.Prepare(`
+		DELETE FROM manager_preset_users WHERE preset_id = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deletePresetUsers: %w", err)
+	}
+
+	deleteQueueEntry, err := db.Prepare(`
+		DELETE FROM manager_matchmaking_queue WHERE user_id = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deleteQueueEntry: %w", err)
+	}
+
+	// A device registered after its token was reported unregistered has a new valid token
+	deleteUnregisteredDevice, err := db.Prepare(`
+		DELETE FROM manager_devices WHERE token = $1 AND user_id = $2 AND last_seen_at <= $3
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare deleteUnregisteredDevice: %w", err)
+	}
+
 	getActiveGames, err := db.Prepare(`
-		SELECT id, spectator_id, created_at, expires_at
-			FROM manager_games WHERE results is null and expires_at > $1
+		SELECT manager_games.id, manager_games.spectator_id, manager_games.created_at, manager_games.expires_at,
+		       manager_games.state, manager_games.state_updated_at,
+		       manager_game_players.user_id, manager_game_players.player_id, manager_game_players.color
+			FROM manager_game_players INNER JOIN manager_games ON manager_game_players.game_id = manager_games.id
+			WHERE manager_games.results is null and manager_games.expires_at > $1
+				AND coalesce(manager_games.state_updated_at, '-infinity') < $2
+			ORDER BY manager_games.created_at, manager_games.id
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare getActiveGames: %w", err)
 	}
 
 	getActiveUsers, err := db.Prepare(`
-		SELECT distinct manager_game_players.user_id
+		SELECT manager_game_players.user_id
 			FROM manager_game_players INNER JOIN manager_games ON manager_game_players.game_id = manager_games.id
 			WHERE manager_games.expires_at > $1 and coalesce(manager_games.finished_at, 'infinity') > $1
+				AND coalesce(manager_games.state_updated_at, '-infinity') < $2
+		UNION
+		SELECT id FROM manager_users WHERE notification_deferred_until <= $3
+		ORDER BY 1
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare getActiveUsers: %w", err)
 	}
 
+	getBucketGlickoLeaderboard, err := db.Prepare(`
+		SELECT manager_users.id, manager_users.nickname, manager_users.color, manager_users.created_at,
+		       manager_bucket_ratings.elo, manager_bucket_ratings.glicko_rating,
+		       manager_bucket_ratings.glicko_deviation, manager_bucket_ratings.glicko_volatility
+			FROM manager_bucket_ratings INNER JOIN manager_users ON manager_users.id = manager_bucket_ratings.user_id
+			WHERE manager_users.type = $1 AND manager_bucket_ratings.bucket = $2
+			ORDER BY manager_bucket_ratings.glicko_rating desc LIMIT $3
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getBucketGlickoLeaderboard: %w", err)
+	}
+
+	getBucketLeaderboard, err := db.Prepare(`
+		SELECT manager_users.id, manager_users.nickname, manager_users.color, manager_users.created_at,
+		       manager_bucket_ratings.elo, manager_bucket_ratings.glicko_rating,
+		       manager_bucket_ratings.glicko_deviation, manager_bucket_ratings.glicko_volatility
+			FROM manager_bucket_ratings INNER JOIN manager_users ON manager_users.id = manager_bucket_ratings.user_id
+			WHERE manager_users.type = $1 AND manager_bucket_ratings.bucket = $2
+			ORDER BY manager_bucket_ratings.elo desc LIMIT $3
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getBucketLeaderboard: %w", err)
+	}
+
+	getDeliveryAttempts, err := db.Prepare(`
+		SELECT id, outbox_id, user_id, token, platform, environment, payload_hash, message_id,
+				status, reason, error, latency_ms, created_at
+			FROM manager_delivery_attempts
+			WHERE user_id = $1
+			ORDER BY created_at DESC, id DESC
+			LIMIT $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getDeliveryAttempts: %w", err)
+	}
+
+	getDevicesByUserId, err := db.Prepare(`
+		SELECT token, user_id, platform, environment, app_version, last_seen_at FROM manager_devices
+			WHERE user_id = $1 ORDER BY last_seen_at DESC, token
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getDevicesByUserId: %w", err)
+	}
+
+	getFinishedGames, err := db.Prepare(`
+		SELECT id, spectator_id, created_at, expires_at, finished_at, results
+			FROM manager_games
+			WHERE results is not null AND finished_at is not null
+			ORDER BY finished_at, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getFinishedGames: %w", err)
+	}
+
+	getFinishedGamesByUserId, err := db.Prepare(`
+		WITH page AS (
+			SELECT manager_games.id, manager_games.spectator_id, manager_games.created_at, manager_games.expires_at,
+			       manager_games.finished_at, manager_games.settings, manager_games.results,
+			       manager_games.elo_results, manager_games.glicko_results, manager_games.series_id,
+			       manager_games.rematch_of
+				FROM manager_game_players INNER JOIN manager_games ON manager_game_players.game_id = manager_games.id
+				WHERE manager_game_players.user_id = $1 AND manager_games.finished_at is not null
+					AND ($2::timestamptz is null OR (manager_games.finished_at, manager_games.id) < ($2, $3))
+				ORDER BY manager_games.finished_at DESC, manager_games.id DESC LIMIT $4
+		)
+		SELECT page.id, page.spectator_id, page.created_at, page.expires_at, page.finished_at, page.settings,
+		       page.results, page.elo_results, page.glicko_results, page.series_id, page.rematch_of,
+		       manager_game_players.user_id, manager_game_players.player_id, manager_game_players.color
+			FROM page INNER JOIN manager_game_players ON manager_game_players.game_id = page.id
+			ORDER BY page.finished_at DESC, page.id DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getFinishedGamesByUserId: %w", err)
+	}
+
+	getGameById, err := db.Prepare(`
+		SELECT manager_games.id, manager_games.spectator_id, manager_games.created_at, manager_games.expires_at,
+		       manager_games.finished_at, manager_games.settings, manager_games.results, manager_games.elo_results,
+		       manager_games.glicko_results, manager_games.series_id, manager_games.rematch_of,
+		       manager_game_players.user_id, manager_game_players.player_id, manager_game_players.color
+			FROM manager_game_players INNER JOIN manager_games ON manager_game_players.game_id = manager_games.id
+			WHERE manager_games.id = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getGameById: %w", err)
+	}
+
 	getGameByPlayerId, err := db.Prepare(`
 		SELECT manager_games.id, manager_games.spectator_id, manager_games.created_at, manager_games.expires_at,
 		       manager_game_players.user_id, manager_game_players.player_id, manager_game_players.color
@@ -65,7 +340,8 @@ func New(db *sql.DB) (*Storage, error) {
 
 	getGamePlayersAndElo, err := db.Prepare(`
 		SELECT manager_game_players.user_id, manager_game_players.player_id, manager_game_players.color,
-		       manager_users.elo
+		       manager_users.elo, manager_users.glicko_rating, manager_users.glicko_deviation,
+		       manager_users.glicko_volatility
 			FROM manager_game_players INNER JOIN manager_users ON manager_users.id = manager_game_players.user_id
 			WHERE manager_game_players.game_id = $1
 	`)
@@ -75,7 +351,7 @@ func New(db *sql.DB) (*Storage, error) {
 
 	getGamesByUserId, err := db.Prepare(`
 		SELECT manager_games.id, manager_games.spectator_id, manager_games.created_at, manager_games.expires_at,
-		       manager_games.finished_at,
+		       manager_games.finished_at, manager_games.settings, manager_games.state, manager_games.state_updated_at,
 		       manager_game_players.user_id, manager_game_players.player_id, manager_game_players.color
 			FROM manager_game_players INNER JOIN manager_games ON manager_game_players.game_id = manager_games.id
 			WHERE manager_game_players.user_id = $1 AND manager_games.expires_at > $2 
@@ -86,8 +362,19 @@ func New(db *sql.DB) (*Storage, error) {
 		return nil, fmt.Errorf("failed to prepare getGamesByUserId: %w", err)
 	}
 
+	getGlickoLeaderboard, err := db.Prepare(`
+		SELECT id, nickname, color, created_at, elo, glicko_rating, glicko_deviation, glicko_volatility
+			FROM manager_users
+		    WHERE type = $1
+			ORDER BY glicko_rating desc LIMIT $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getGlickoLeaderboard: %w", err)
+	}
+
 	getLeaderboard, err := db.Prepare(`
-		SELECT id, nickname, color, created_at, elo FROM manager_users
+		SELECT id, nickname, color, created_at, elo, glicko_rating, glicko_deviation, glicko_volatility
+			FROM manager_users
 		    WHERE type = $1
 			ORDER BY elo desc LIMIT $2
 	`)
@@ -95,8 +382,58 @@ func New(db *sql.DB) (*Storage, error) {
 		return nil, fmt.Errorf("failed to prepare getLeaderboard: %w", err)
 	}
 
+	getLobbiesByUserId, err := db.Prepare(`
+		SELECT manager_lobbies.id, manager_lobbies.host_id, manager_lobbies.settings, manager_lobbies.status,
+		       manager_lobbies.game_id, manager_lobbies.created_at, manager_lobbies.expires_at,
+		       manager_lobby_members.user_id, manager_users.nickname, manager_users.color,
+		       manager_lobby_members.status, manager_lobby_members.responded_at
+			FROM manager_lobbies
+			INNER JOIN manager_lobby_members ON manager_lobby_members.lobby_id = manager_lobbies.id
+			INNER JOIN manager_users ON manager_users.id = manager_lobby_members.user_id
+			WHERE manager_lobbies.id IN (
+				SELECT lobby_id FROM manager_lobby_members WHERE user_id = $1 AND status <> 'declined'
+			) AND (manager_lobbies.status = 'starting' OR
+			       manager_lobbies.status = 'open' AND manager_lobbies.expires_at > $2)
+			ORDER BY manager_lobbies.created_at DESC, manager_lobbies.id,
+			         manager_lobby_members.user_id <> manager_lobbies.host_id,
+			         manager_lobby_members.invited_at, manager_lobby_members.user_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getLobbiesByUserId: %w", err)
+	}
+
+	getLobbyById, err := db.Prepare(`
+		SELECT manager_lobbies.id, manager_lobbies.host_id, manager_lobbies.settings, manager_lobbies.status,
+		       manager_lobbies.game_id, manager_lobbies.created_at, manager_lobbies.expires_at,
+		       manager_lobby_members.user_id, manager_users.nickname, manager_users.color,
+		       manager_lobby_members.status, manager_lobby_members.responded_at
+			FROM manager_lobbies
+			INNER JOIN manager_lobby_members ON manager_lobby_members.lobby_id = manager_lobbies.id
+			INNER JOIN manager_users ON manager_users.id = manager_lobby_members.user_id
+			WHERE manager_lobbies.id = $1
+			ORDER BY manager_lobby_members.user_id <> manager_lobbies.host_id,
+			         manager_lobby_members.invited_at, manager_lobby_members.user_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getLobbyById: %w", err)
+	}
+
+	getLobbyMemberIds, err := db.Prepare(`
+		SELECT user_id FROM manager_lobby_members WHERE lobby_id = $1 AND status <> 'declined'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getLobbyMemberIds: %w", err)
+	}
+
+	getNotificationSettings, err := db.Prepare(`
+		SELECT notification_settings FROM manager_users WHERE id = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getNotificationSettings: %w", err)
+	}
+
 	getOldestFinishedGame, err := db.Prepare(`
-		SELECT id, spectator_id, created_at, expires_at, results
+		SELECT id, spectator_id, created_at, expires_at, finished_at, results
 		    FROM manager_games
 		    WHERE results is not null AND finished_at is not null AND elo_results is null
 			ORDER BY finished_at LIMIT 1
@@ -105,8 +442,90 @@ func New(db *sql.DB) (*Storage, error) {
 		return nil, fmt.Errorf("failed to prepare getFinishedGameForUpdate: %w", err)
 	}
 
+	getPresetById, err := db.Prepare(`
+		SELECT manager_presets.id, manager_presets.owner_id, manager_presets.name, manager_presets.settings,
+		       manager_presets.created_at, manager_presets.updated_at,
+		       manager_preset_users.user_id, manager_users.nickname
+			FROM manager_presets
+			INNER JOIN manager_preset_users ON manager_preset_users.preset_id = manager_presets.id
+			INNER JOIN manager_users ON manager_users.id = manager_preset_users.user_id
+			WHERE manager_presets.id = $1
+			ORDER BY manager_preset_users.user_id <> manager_presets.owner_id, manager_users.nickname
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getPresetById: %w", err)
+	}
+
+	getPresetsByUserId, err := db.Prepare(`
+		SELECT manager_presets.id, manager_presets.owner_id, manager_presets.name, manager_presets.settings,
+		       manager_presets.created_at, manager_presets.updated_at,
+		       manager_preset_users.user_id, manager_users.nickname
+			FROM manager_presets
+			INNER JOIN manager_preset_users ON manager_preset_users.preset_id = manager_presets.id
+			INNER JOIN manager_users ON manager_users.id = manager_preset_users.user_id
+			WHERE manager_presets.id IN (
+				SELECT preset_id FROM manager_preset_users WHERE user_id = $1
+			)
+			ORDER BY manager_presets.name, manager_presets.id,
+			         manager_preset_users.user_id <> manager_presets.owner_id, manager_users.nickname
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getPresetsByUserId: %w", err)
+	}
+
+	getQueue, err := db.Prepare(`
+		SELECT manager_matchmaking_queue.user_id, manager_users.nickname, manager_users.color, manager_users.elo,
+		       manager_matchmaking_queue.preferences, manager_matchmaking_queue.enqueued_at
+			FROM manager_matchmaking_queue INNER JOIN manager_users ON manager_users.id = manager_matchmaking_queue.user_id
+			WHERE manager_matchmaking_queue.enqueued_at > $1
+			ORDER BY manager_matchmaking_queue.enqueued_at, manager_matchmaking_queue.user_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getQueue: %w", err)
+	}
+
+	getQueueEntry, err := db.Prepare(`
+		SELECT manager_matchmaking_queue.user_id, manager_users.nickname, manager_users.color, manager_users.elo,
+		       manager_matchmaking_queue.preferences, manager_matchmaking_queue.enqueued_at
+			FROM manager_matchmaking_queue INNER JOIN manager_users ON manager_users.id = manager_matchmaking_queue.user_id
+			WHERE manager_matchmaking_queue.user_id = $1 AND manager_matchmaking_queue.enqueued_at > $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getQueueEntry: %w", err)
+	}
+
+	getRatingHistory, err := db.Prepare(`
+		SELECT user_id, game_id, old_elo, new_elo,
+		       old_glicko_rating, old_glicko_deviation, old_glicko_volatility,
+		       new_glicko_rating, new_glicko_deviation, new_glicko_volatility,
+		       created_at
+			FROM manager_rating_history
+			WHERE user_id = $1 AND ($2::timestamptz is null OR (created_at, game_id) < ($2, $3))
+			ORDER BY created_at DESC, game_id DESC LIMIT $4
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getRatingHistory: %w", err)
+	}
+
+	getRematchId, err := db.Prepare(`
+		SELECT id FROM manager_games WHERE rematch_of = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getRematchId: %w", err)
+	}
+
+	getUnannouncedGames, err := db.Prepare(`
+		SELECT id FROM manager_games
+			WHERE elo_results is not null AND results_announced_at is null
+			ORDER BY finished_at, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getUnannouncedGames: %w", err)
+	}
+
 	getUserById, err := db.Prepare(`
-		SELECT id, nickname, color, created_at, device_token, device_token_type, last_ip, type, elo 
+		SELECT id, nickname, color, created_at, last_ip, type, elo,
+		       glicko_rating, glicko_deviation, glicko_volatility
 		FROM manager_users WHERE id = $1
 	`)
 	if err != nil {
@@ -114,21 +533,87 @@ func New(db *sql.DB) (*Storage, error) {
 	}
 
 	getUserByNickname, err := db.Prepare(`
-		SELECT id, nickname, color, created_at, device_token, device_token_type, last_ip, type, elo
+		SELECT id, nickname, color, created_at, last_ip, type, elo,
+		       glicko_rating, glicko_deviation, glicko_volatility
 		FROM manager_users WHERE nickname = $1
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare getUsersByNicknames: %w", err)
 	}
 
+	getUserRatings, err := db.Prepare(`
+		SELECT id, nickname, elo, glicko_rating, glicko_deviation, glicko_volatility
+			FROM manager_users ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getUserRatings: %w", err)
+	}
+
+	getWaitingPlayers, err := db.Prepare(`
+		SELECT manager_game_players.game_id, manager_game_players.user_id, manager_game_players.player_id,
+		       manager_game_players.waiting_since, manager_game_players.reminders_sent
+			FROM manager_game_players INNER JOIN manager_games ON manager_game_players.game_id = manager_games.id
+			WHERE manager_game_players.waiting_since <= $1 AND manager_game_players.reminders_sent < $2
+				AND manager_games.finished_at is null AND manager_games.expires_at > $3
+			ORDER BY manager_game_players.waiting_since, manager_game_players.player_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare getWaitingPlayers: %w", err)
+	}
+
+	insertBucketRating, err := db.Prepare(`
+		INSERT INTO manager_bucket_ratings (user_id, bucket) VALUES ($1, $2)
+			ON CONFLICT(user_id, bucket) DO NOTHING
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertBucketRating: %w", err)
+	}
+
+	insertDeliveryAttempt, err := db.Prepare(`
+		INSERT INTO manager_delivery_attempts (outbox_id, user_id, token, platform, environment, payload_hash,
+				message_id, status, reason, error, latency_ms, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertDeliveryAttempt: %w", err)
+	}
+
 	insertGame, err := db.Prepare(`
-		INSERT INTO manager_games (id, spectator_id, created_at, expires_at) 
-			VALUES ($1, $2, $3, $4) 
+		INSERT INTO manager_games (id, spectator_id, created_at, expires_at, settings) 
+			VALUES ($1, $2, $3, $4, $5) 
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare insertGame: %w", err)
 	}
 
+	insertLobby, err := db.Prepare(`
+		INSERT INTO manager_lobbies (id, host_id, settings, status, created_at, expires_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $5)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertLobby: %w", err)
+	}
+
+	// Users who declined can be invited again
+	insertLobbyMember, err := db.Prepare(`
+		INSERT INTO manager_lobby_members (lobby_id, user_id, status, invited_at, responded_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (lobby_id, user_id) DO UPDATE
+				SET status = excluded.status, invited_at = excluded.invited_at, responded_at = excluded.responded_at
+				WHERE manager_lobby_members.status = 'declined'
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertLobbyMember: %w", err)
+	}
+
+	insertOutboxMessage, err := db.Prepare(`
+		INSERT INTO manager_outbox (user_id, token, payload, status, next_attempt_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertOutboxMessage: %w", err)
+	}
+
 	insertPlayer, err := db.Prepare(`
 		INSERT INTO manager_game_players (game_id, user_id, player_id, color)
 			VALUES ($1, $2, $3, $4)
@@ -137,36 +622,169 @@ func New(db *sql.DB) (*Storage, error) {
 		return nil, fmt.Errorf("failed to prepare insertPlayer: %w", err)
 	}
 
+	insertPreset, err := db.Prepare(`
+		INSERT INTO manager_presets (id, owner_id, name, settings, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $5)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertPreset: %w", err)
+	}
+
+	insertPresetUser, err := db.Prepare(`
+		INSERT INTO manager_preset_users (preset_id, user_id) VALUES ($1, $2)
+			ON CONFLICT (preset_id, user_id) DO NOTHING
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertPresetUser: %w", err)
+	}
+
+	// Players who have enqueued again keep the new entry
+	insertQueueEntry, err := db.Prepare(`
+		INSERT INTO manager_matchmaking_queue (user_id, preferences, enqueued_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) DO NOTHING
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertQueueEntry: %w", err)
+	}
+
+	insertRatingHistory, err := db.Prepare(`
+		INSERT INTO manager_rating_history (user_id, game_id, old_elo, new_elo,
+		                                    old_glicko_rating, old_glicko_deviation, old_glicko_volatility,
+		                                    new_glicko_rating, new_glicko_deviation, new_glicko_volatility,
+		                                    created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insertRatingHistory: %w", err)
+	}
+
+	lockBucketRating, err := db.Prepare(`
+		SELECT elo, glicko_rating, glicko_deviation, glicko_volatility FROM manager_bucket_ratings
+			WHERE user_id = $1 AND bucket = $2 FOR UPDATE
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare lockBucketRating: %w", err)
+	}
+
+	lockLobby, err := db.Prepare(`
+		SELECT status, expires_at FROM manager_lobbies WHERE id = $1 FOR UPDATE
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare lockLobby: %w", err)
+	}
+
 	lockUser, err := db.Prepare(`
-		SELECT device_token, device_token_type, sent_notification FROM manager_users
+		SELECT sent_notification, notification_settings, notification_deferred_until FROM manager_users
 			WHERE id = $1 FOR UPDATE
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare lockUser: %w", err)
 	}
 
+	// Only the caller holding the claim can release the lobby
+	releaseLobby, err := db.Prepare(`
+		UPDATE manager_lobbies SET status = $3, game_id = coalesce($4, game_id), claimed_at = NULL, updated_at = $5
+			WHERE id = $1 AND status = 'starting' AND claimed_at = $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare releaseLobby: %w", err)
+	}
+
+	releaseRematch, err := db.Prepare(`
+		UPDATE manager_games SET rematch_claimed_at = NULL WHERE id = $1 AND rematch_claimed_at = $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare releaseRematch: %w", err)
+	}
+
+	resetBucketRatings, err := db.Prepare(`
+		DELETE FROM manager_bucket_ratings
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare resetBucketRatings: %w", err)
+	}
+
+	resetGameRatings, err := db.Prepare(`
+		UPDATE manager_games SET elo_results = null, glicko_results = null
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare resetGameRatings: %w", err)
+	}
+
+	resetRatingHistory, err := db.Prepare(`
+		DELETE FROM manager_rating_history
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare resetRatingHistory: %w", err)
+	}
+
+	resetUserRatings, err := db.Prepare(`
+		UPDATE manager_users SET elo = DEFAULT, glicko_rating = DEFAULT, glicko_deviation = DEFAULT,
+			glicko_volatility = DEFAULT
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare resetUserRatings: %w", err)
+	}
+
 	searchUsers, err := db.Prepare(`
-		SELECT id, nickname, color, created_at, elo FROM manager_users
+		SELECT id, nickname, color, created_at, elo, glicko_rating, glicko_deviation, glicko_volatility
+			FROM manager_users
 			WHERE nickname LIKE $1 AND type = $2 AND id != $3 ORDER BY nickname LIMIT $4
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare searchUsers: %w", err)
 	}
 
-	updateDeviceToken, err := db.Prepare(`
-		UPDATE manager_users SET device_token = $1, device_token_type = $2 WHERE id = $3
+	updateBucketRating, err := db.Prepare(`
+		UPDATE manager_bucket_ratings SET elo = $1, glicko_rating = $2, glicko_deviation = $3,
+			glicko_volatility = $4, games_count = games_count + 1
+			WHERE user_id = $5 AND bucket = $6
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateBucketRating: %w", err)
+	}
+
+	updateDeviceEnvironment, err := db.Prepare(`
+		UPDATE manager_devices SET environment = $1 WHERE token = $2 AND user_id = $3
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateDeviceEnvironment: %w", err)
+	}
+
+	updateGameAnnounced, err := db.Prepare(`
+		UPDATE manager_games SET results_announced_at = $1 WHERE id = $2
 	`)
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare updateDeviceToken: %w", err)
+		return nil, fmt.Errorf("failed to prepare updateGameAnnounced: %w", err)
 	}
 
 	updateGameEloResults, err := db.Prepare(`
-		UPDATE manager_games SET elo_results = $1 WHERE id = $2 AND elo_results is null
+		UPDATE manager_games SET elo_results = $1, glicko_results = $2 WHERE id = $3 AND elo_results is null
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare updateGameEloResults: %w", err)
 	}
 
+	updateGameNudged, err := db.Prepare(`
+		UPDATE manager_games SET nudged_at = $1 WHERE id = $2 AND coalesce(nudged_at, '-infinity') <= $3
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateGameNudged: %w", err)
+	}
+
+	// The series is named after its first game, which joins the series with its first rematch
+	updateGameRematchOf, err := db.Prepare(`
+		WITH series AS (
+			UPDATE manager_games SET series_id = coalesce(series_id, id) WHERE id = $2 RETURNING series_id
+		)
+		UPDATE manager_games SET rematch_of = $2, series_id = (SELECT series_id FROM series)
+			WHERE id = $1
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateGameRematchOf: %w", err)
+	}
+
 	updateGameResults, err := db.Prepare(`
 		UPDATE manager_games SET results = $1, finished_at = $2 WHERE id = $3
 	`)
@@ -174,14 +792,82 @@ func New(db *sql.DB) (*Storage, error) {
 		return nil, fmt.Errorf("failed to prepare updateGameResults: %w", err)
 	}
 
+	// Players the game is waiting for keep their waiting_since, others start over
+	updateGameState, err := db.Prepare(`
+		WITH game AS (
+			UPDATE manager_games SET state = $1, state_updated_at = $2 WHERE id = $3
+			RETURNING id, state
+		)
+		UPDATE manager_game_players SET
+			waiting_since = CASE WHEN game.state->'waitingFor' ? manager_game_players.color
+				THEN coalesce(manager_game_players.waiting_since, $2) END,
+			reminders_sent = CASE WHEN game.state->'waitingFor' ? manager_game_players.color
+				THEN manager_game_players.reminders_sent ELSE 0 END
+			FROM game WHERE manager_game_players.game_id = game.id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateGameState: %w", err)
+	}
+
+	// The token is also cleared from users it belonged to before
+	updateLegacyDevice, err := db.Prepare(`
+		UPDATE manager_users SET device_token = CASE WHEN id = $1 THEN $2 END,
+				device_token_type = CASE WHEN id = $1 THEN $3 ELSE device_token_type END,
+				device_platform = CASE WHEN id = $1 THEN $4 ELSE device_platform END
+			WHERE id = $1 OR device_token = $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateLegacyDevice: %w", err)
+	}
+
+	updateLobbyMember, err := db.Prepare(`
+		UPDATE manager_lobby_members SET status = $3, responded_at = $4
+			WHERE lobby_id = $1 AND user_id = $2 AND status = 'pending' AND EXISTS (
+				SELECT 1 FROM manager_lobbies WHERE id = $1 AND status = 'open' AND expires_at > $4
+			)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateLobbyMember: %w", err)
+	}
+
 	updateLockedUser, err := db.Prepare(`
-		UPDATE manager_users SET device_token = $1, device_token_type = $2, sent_notification = $3 
-			WHERE id = $4
+		UPDATE manager_users SET sent_notification = $1, notification_deferred_until = $2 WHERE id = $3
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare updateLockedUser: %w", err)
 	}
 
+	updateNotificationSettings, err := db.Prepare(`
+		UPDATE manager_users SET notification_settings = $1 WHERE id = $2
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateNotificationSettings: %w", err)
+	}
+
+	updateOutboxMessage, err := db.Prepare(`
+		UPDATE manager_outbox SET status = $1, attempts = attempts + 1, next_attempt_at = $2, last_error = $3,
+			updated_at = $4
+			WHERE id = $5
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateOutboxMessage: %w", err)
+	}
+
+	updatePreset, err := db.Prepare(`
+		UPDATE manager_presets SET name = $2, settings = $3, updated_at = $4 WHERE id = $1
+			RETURNING owner_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updatePreset: %w", err)
+	}
+
+	updateRemindersSent, err := db.Prepare(`
+		UPDATE manager_game_players SET reminders_sent = $1 WHERE player_id = $2 AND waiting_since = $3
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateRemindersSent: %w", err)
+	}
+
 	updateUser, err := db.Prepare(`
 		UPDATE manager_users SET nickname = $1, color = $2, type = $3 WHERE id = $4
 			RETURNING id, nickname, color, created_at
@@ -197,8 +883,36 @@ func New(db *sql.DB) (*Storage, error) {
 		return nil, fmt.Errorf("failed to prepare updateUserElo: %w", err)
 	}
 
-	upsertUser, err := db.Prepare(`
-		INSERT INTO manager_users (id, nickname, color, created_at, last_ip)
+	updateUserGlicko, err := db.Prepare(`
+		UPDATE manager_users SET glicko_rating = $1, glicko_deviation = $2, glicko_volatility = $3
+			WHERE id = $4 and glicko_rating = $5
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare updateUserGlicko: %w", err)
+	}
+
+	upsertDevice, err := db.Prepare(`
+		INSERT INTO manager_devices (token, user_id, platform, environment, app_version, last_seen_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (token) DO UPDATE SET user_id = excluded.user_id, platform = excluded.platform,
+				environment = excluded.environment, app_version = excluded.app_version,
+				last_seen_at = excluded.last_seen_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare upsertDevice: %w", err)
+	}
+
+	upsertQueueEntry, err := db.Prepare(`
+		INSERT INTO manager_matchmaking_queue (user_id, preferences, enqueued_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id) DO UPDATE SET preferences = excluded.preferences, enqueued_at = excluded.enqueued_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare upsertQueueEntry: %w", err)
+	}
+
+	upsertUser, err := db.Prepare(`
+		INSERT INTO manager_users (id, nickname, color, created_at, last_ip)
 			VALUES ($1, $2, $3, $4, $5)
 			ON CONFLICT(id) DO UPDATE SET last_ip = $5
 	`)
@@ -209,26 +923,90 @@ func New(db *sql.DB) (*Storage, error) {
 	return &Storage{
 		db: db,
 
-		getActiveGames:        getActiveGames,
-		getActiveUsers:        getActiveUsers,
-		getGameByPlayerId:     getGameByPlayerId,
-		getGamePlayersAndElo:  getGamePlayersAndElo,
-		getGamesByUserId:      getGamesByUserId,
-		getLeaderboard:        getLeaderboard,
-		getOldestFinishedGame: getOldestFinishedGame,
-		getUserById:           getUserById,
-		getUserByNickname:     getUserByNickname,
-		insertGame:            insertGame,
-		insertPlayer:          insertPlayer,
-		lockUser:              lockUser,
-		searchUsers:           searchUsers,
-		updateDeviceToken:     updateDeviceToken,
-		updateGameEloResults:  updateGameEloResults,
-		updateGameResults:     updateGameResults,
-		updateLockedUser:      updateLockedUser,
-		updateUser:            updateUser,
-		updateUserElo:         updateUserElo,
-		upsertUser:            upsertUser,
+		claimLobby:                 claimLobby,
+		claimOutbox:                claimOutbox,
+		claimQueueEntry:            claimQueueEntry,
+		claimRematch:               claimRematch,
+		clearLegacyDevice:          clearLegacyDevice,
+		deleteDeliveryAttempts:     deleteDeliveryAttempts,
+		deleteDevice:               deleteDevice,
+		deleteOutbox:               deleteOutbox,
+		deletePreset:               deletePreset,
+		deletePresetUsers:          deletePresetUsers,
+		deleteQueueEntry:           deleteQueueEntry,
+		deleteUnregisteredDevice:   deleteUnregisteredDevice,
+		getActiveGames:             getActiveGames,
+		getActiveUsers:             getActiveUsers,
+		getBucketGlickoLeaderboard: getBucketGlickoLeaderboard,
+		getBucketLeaderboard:       getBucketLeaderboard,
+		getDeliveryAttempts:        getDeliveryAttempts,
+		getDevicesByUserId:         getDevicesByUserId,
+		getFinishedGames:           getFinishedGames,
+		getFinishedGamesByUserId:   getFinishedGamesByUserId,
+		getGameById:                getGameById,
+		getGameByPlayerId:          getGameByPlayerId,
+		getGamePlayersAndElo:       getGamePlayersAndElo,
+		getGamesByUserId:           getGamesByUserId,
+		getGlickoLeaderboard:       getGlickoLeaderboard,
+		getLeaderboard:             getLeaderboard,
+		getLobbiesByUserId:         getLobbiesByUserId,
+		getLobbyById:               getLobbyById,
+		getLobbyMemberIds:          getLobbyMemberIds,
+		getNotificationSettings:    getNotificationSettings,
+		getOldestFinishedGame:      getOldestFinishedGame,
+		getPresetById:              getPresetById,
+		getPresetsByUserId:         getPresetsByUserId,
+		getQueue:                   getQueue,
+		getQueueEntry:              getQueueEntry,
+		getRatingHistory:           getRatingHistory,
+		getRematchId:               getRematchId,
+		getUnannouncedGames:        getUnannouncedGames,
+		getUserById:                getUserById,
+		getUserByNickname:          getUserByNickname,
+		getUserRatings:             getUserRatings,
+		getWaitingPlayers:          getWaitingPlayers,
+		insertBucketRating:         insertBucketRating,
+		insertDeliveryAttempt:      insertDeliveryAttempt,
+		insertGame:                 insertGame,
+		insertLobby:                insertLobby,
+		insertLobbyMember:          insertLobbyMember,
+		insertOutboxMessage:        insertOutboxMessage,
+		insertPlayer:               insertPlayer,
+		insertPreset:               insertPreset,
+		insertPresetUser:           insertPresetUser,
+		insertQueueEntry:           insertQueueEntry,
+		insertRatingHistory:        insertRatingHistory,
+		lockBucketRating:           lockBucketRating,
+		lockLobby:                  lockLobby,
+		lockUser:                   lockUser,
+		releaseLobby:               releaseLobby,
+		releaseRematch:             releaseRematch,
+		resetBucketRatings:         resetBucketRatings,
+		resetGameRatings:           resetGameRatings,
+		resetRatingHistory:         resetRatingHistory,
+		resetUserRatings:           resetUserRatings,
+		searchUsers:                searchUsers,
+		updateBucketRating:         updateBucketRating,
+		updateDeviceEnvironment:    updateDeviceEnvironment,
+		updateGameAnnounced:        updateGameAnnounced,
+		updateGameEloResults:       updateGameEloResults,
+		updateGameNudged:           updateGameNudged,
+		updateGameRematchOf:        updateGameRematchOf,
+		updateGameResults:          updateGameResults,
+		updateGameState:            updateGameState,
+		updateLegacyDevice:         updateLegacyDevice,
+		updateLobbyMember:          updateLobbyMember,
+		updateLockedUser:           updateLockedUser,
+		updateNotificationSettings: updateNotificationSettings,
+		updateOutboxMessage:        updateOutboxMessage,
+		updatePreset:               updatePreset,
+		updateRemindersSent:        updateRemindersSent,
+		updateUser:                 updateUser,
+		updateUserElo:              updateUserElo,
+		updateUserGlicko:           updateUserGlicko,
+		upsertDevice:               upsertDevice,
+		upsertQueueEntry:           upsertQueueEntry,
+		upsertUser:                 upsertUser,
 
 		nowFunc: time.Now,
 	}, nil
@@ -240,7 +1018,8 @@ func (s *Storage) GetUserById(ctx context.Context, userId string) (*User, error)
 
 	err := s.getUserById.QueryRowContext(ctx, userId).
 		Scan(&user.UserId, &user.Nickname, &user.Color, &user.CreatedAt,
-			&user.DeviceToken, &user.DeviceTokenType, &lastIp, &user.Type, &user.Elo)
+			&lastIp, &user.Type, &user.Elo,
+			&user.Glicko.Rating, &user.Glicko.Deviation, &user.Glicko.Volatility)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
@@ -257,7 +1036,8 @@ func (s *Storage) GetUserByNickname(ctx context.Context, nickname string) (*User
 
 	err := s.getUserByNickname.QueryRowContext(ctx, nickname).
 		Scan(&user.UserId, &user.Nickname, &user.Color, &user.CreatedAt,
-			&user.DeviceToken, &user.DeviceTokenType, &lastIp, &user.Type, &user.Elo)
+			&lastIp, &user.Type, &user.Elo,
+			&user.Glicko.Rating, &user.Glicko.Deviation, &user.Glicko.Volatility)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
@@ -286,7 +1066,8 @@ func (s *Storage) SearchUsers(ctx context.Context, req SearchUsers) ([]*User, er
 	users := make([]*User, 0, req.Limit)
 	for rows.Next() {
 		user := User{}
-		if err := rows.Scan(&user.UserId, &user.Nickname, &user.Color, &user.CreatedAt, &user.Elo); err != nil {
+		if err := rows.Scan(&user.UserId, &user.Nickname, &user.Color, &user.CreatedAt, &user.Elo,
+			&user.Glicko.Rating, &user.Glicko.Deviation, &user.Glicko.Volatility); err != nil {
 			return nil, fmt.Errorf("failed to query searchUsers: %w", err)
 		}
 		users = append(users, &user)
@@ -300,17 +1081,71 @@ func (s *Storage) SearchUsers(ctx context.Context, req SearchUsers) ([]*User, er
 	return users, nil
 }
 
-func (s *Storage) UpdateDeviceToken(ctx context.Context, userId string, deviceToken []byte, tokenType DeviceTokenType) error {
-	_, err := s.updateDeviceToken.ExecContext(ctx, deviceToken, tokenType, userId)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrNotFound
+type UpdateDeviceToken struct {
+	UserId      string
+	Token       []byte
+	Platform    DevicePlatform
+	Environment DeviceTokenType
+	AppVersion  string
+}
+
+// UpdateDeviceToken registers a device of the user, a token seen before is moved to the user.
+// The device is also written to the columns of manager_users the previous release reads.
+func (s *Storage) UpdateDeviceToken(ctx context.Context, req UpdateDeviceToken) error {
+	now := s.nowFunc()
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.StmtContext(ctx, s.upsertDevice).ExecContext(ctx,
+			req.Token, req.UserId, req.Platform, req.Environment, req.AppVersion, now); err != nil {
+			return fmt.Errorf("failed to upsert device: %w", err)
+		}
+		if _, err := tx.StmtContext(ctx, s.updateLegacyDevice).ExecContext(ctx,
+			req.UserId, req.Token, req.Environment, req.Platform); err != nil {
+			return fmt.Errorf("failed to update legacy device: %w", err)
 		}
+		return nil
+	}); err != nil {
 		return fmt.Errorf("failed to update device token: %w", err)
 	}
 	return nil
 }
 
+func (s *Storage) GetNotificationSettings(ctx context.Context, userId string) (NotificationSettings, error) {
+	var settings NotificationSettings
+	if err := s.getNotificationSettings.QueryRowContext(ctx, userId).Scan(&settings); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NotificationSettings{}, ErrNotFound
+		}
+		return NotificationSettings{}, fmt.Errorf("failed to query notification settings: %w", err)
+	}
+	return settings, nil
+}
+
+func (s *Storage) UpdateNotificationSettings(ctx context.Context, userId string, settings NotificationSettings) error {
+	res, err := s.updateNotificationSettings.ExecContext(ctx, &settings, userId)
+	if err != nil {
+		return fmt.Errorf("failed to update notification settings: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *Storage) GetDevices(ctx context.Context, userId string) ([]Device, error) {
+	devices, err := queryDevices(ctx, s.getDevicesByUserId, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get devices: %w", err)
+	}
+	if len(devices) == 0 {
+		return nil, ErrNotFound
+	}
+	return devices, nil
+}
+
 type UpdateUser struct {
 	UserId   string
 	Nickname string
@@ -358,7 +1193,7 @@ func (s *Storage) CreateGame(ctx context.Context, game *Game) error {
 		insertGame := tx.StmtContext(ctx, s.insertGame)
 		insertPlayer := tx.StmtContext(ctx, s.insertPlayer)
 
-		_, err := insertGame.ExecContext(ctx, &game.GameId, &game.SpectatorId, &now, &game.ExpiresAt)
+		_, err := insertGame.ExecContext(ctx, &game.GameId, &game.SpectatorId, &now, &game.ExpiresAt, game.Settings)
 		if err != nil {
 			return fmt.Errorf("failed to insert game: %w", err)
 		}
@@ -388,10 +1223,13 @@ func (s *Storage) GetGamesByUserId(ctx context.Context, userId string, finishedW
 		game := Game{}
 		player := Player{}
 
+		var stateUpdatedAt *time.Time
 		if err := rows.Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt, &game.FinishedAt,
+			&game.Settings, &game.State, &stateUpdatedAt,
 			&player.UserId, &player.PlayerId, &player.Color); err != nil {
 			return nil, fmt.Errorf("failed to query searchUsers: %w", err)
 		}
+		setStateUpdatedAt(&game, stateUpdatedAt)
 		game.Players = []Player{player}
 		games = append(games, &game)
 	}
@@ -404,6 +1242,93 @@ func (s *Storage) GetGamesByUserId(ctx context.Context, userId string, finishedW
 	return games, nil
 }
 
+type GetFinishedGames struct {
+	UserId string
+	Before *GameHistoryCursor // Returns games finished strictly before the cursor when set
+	Limit  int
+}
+
+type GameHistoryCursor struct {
+	FinishedAt time.Time
+	GameId     string
+}
+
+// GetFinishedGames returns finished games of the user with all their players, most recent first
+func (s *Storage) GetFinishedGames(ctx context.Context, req GetFinishedGames) ([]*Game, error) {
+	var beforeTime *time.Time
+	var beforeGame string
+	if req.Before != nil {
+		beforeTime = &req.Before.FinishedAt
+		beforeGame = req.Before.GameId
+	}
+
+	rows, err := s.getFinishedGamesByUserId.QueryContext(ctx, req.UserId, beforeTime, beforeGame, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query getFinishedGamesByUserId: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	games := make([]*Game, 0, req.Limit)
+	for rows.Next() {
+		game := Game{}
+		player := Player{}
+
+		var seriesId, rematchOf sql.NullString
+		if err := rows.Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt, &game.FinishedAt,
+			&game.Settings, &game.GameResults, &game.EloResults, &game.GlickoResults, &seriesId, &rematchOf,
+			&player.UserId, &player.PlayerId, &player.Color); err != nil {
+			return nil, fmt.Errorf("failed to scan getFinishedGamesByUserId: %w", err)
+		}
+		game.SeriesId = fromStrPtr(seriesId)
+		game.RematchOf = fromStrPtr(rematchOf)
+
+		if len(games) > 0 && games[len(games)-1].GameId == game.GameId {
+			last := games[len(games)-1]
+			last.Players = append(last.Players, player)
+			continue
+		}
+		game.Players = []Player{player}
+		games = append(games, &game)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over getFinishedGamesByUserId: %w", err)
+	}
+	if len(games) == 0 {
+		return nil, ErrNotFound
+	}
+	return games, nil
+}
+
+func (s *Storage) GetGameById(ctx context.Context, gameId string) (*Game, error) {
+	rows, err := s.getGameById.QueryContext(ctx, gameId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query getGameById: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var game Game
+	for rows.Next() {
+		player := Player{}
+
+		var seriesId, rematchOf sql.NullString
+		if err := rows.Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt, &game.FinishedAt,
+			&game.Settings, &game.GameResults, &game.EloResults, &game.GlickoResults, &seriesId, &rematchOf,
+			&player.UserId, &player.PlayerId, &player.Color); err != nil {
+			return nil, fmt.Errorf("failed to scan getGameById: %w", err)
+		}
+		game.SeriesId = fromStrPtr(seriesId)
+		game.RematchOf = fromStrPtr(rematchOf)
+		game.Players = append(game.Players, player)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over getGameById: %w", err)
+	}
+	if len(game.Players) == 0 {
+		return nil, ErrNotFound
+	}
+	return &game, nil
+}
+
 func (s *Storage) GetGameByPlayerId(ctx context.Context, playerId string) (*Game, error) {
 	rows, err := s.getGameByPlayerId.QueryContext(ctx, playerId)
 	if err != nil {
@@ -430,9 +1355,13 @@ func (s *Storage) GetGameByPlayerId(ctx context.Context, playerId string) (*Game
 	return &game, nil
 }
 
-func (s *Storage) GetActiveUsers(ctx context.Context, activityBuffer time.Duration) ([]string, error) {
-	expiration := s.nowFunc().Add(-activityBuffer)
-	rows, err := s.getActiveUsers.QueryContext(ctx, &expiration)
+// GetActiveUsers returns users of the games which are active within activityBuffer
+// and whose state was not updated during the last staleAge
+func (s *Storage) GetActiveUsers(ctx context.Context, activityBuffer time.Duration, staleAge time.Duration) ([]string, error) {
+	now := s.nowFunc()
+	expiration := now.Add(-activityBuffer)
+	staleBefore := now.Add(-staleAge)
+	rows, err := s.getActiveUsers.QueryContext(ctx, &expiration, &staleBefore, &now)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query getActiveUsers: %w", err)
 	}
@@ -459,13 +1388,23 @@ func (s *Storage) GetActiveUsers(ctx context.Context, activityBuffer time.Durati
 func (s *Storage) UpdateSentNotification(ctx context.Context, userId string, updater SentNotificationUpdater) error {
 	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
 		lockUser := tx.StmtContext(ctx, s.lockUser)
+		getDevices := tx.StmtContext(ctx, s.getDevicesByUserId)
 		updateUser := tx.StmtContext(ctx, s.updateLockedUser)
+		updateDevice := tx.StmtContext(ctx, s.updateDeviceEnvironment)
+		deleteDevice := tx.StmtContext(ctx, s.deleteDevice)
+		clearLegacyDevice := tx.StmtContext(ctx, s.clearLegacyDevice)
+		insertOutboxMessage := tx.StmtContext(ctx, s.insertOutboxMessage)
 
 		var state UserNotificationState
 		if err := lockUser.QueryRowContext(ctx, userId).
-			Scan(&state.DeviceToken, &state.DeviceTokenType, &state.SentNotification); err != nil {
+			Scan(&state.SentNotification, &state.Settings, &state.DeferredUntil); err != nil {
 			return fmt.Errorf("failed to query lockUser: %w", err)
 		}
+		devices, err := queryDevices(ctx, getDevices, userId)
+		if err != nil {
+			return fmt.Errorf("failed to query devices: %w", err)
+		}
+		state.Devices = devices
 
 		newState, err := updater(ctx, state)
 		if err != nil {
@@ -473,10 +1412,44 @@ func (s *Storage) UpdateSentNotification(ctx context.Context, userId string, upd
 		}
 
 		if _, err := updateUser.ExecContext(ctx,
-			newState.DeviceToken, newState.DeviceTokenType, newState.SentNotification,
-			userId); err != nil {
+			newState.SentNotification, newState.DeferredUntil, userId); err != nil {
 			return fmt.Errorf("failed to update user: %w", err)
 		}
+
+		// Devices removed by the updater are deleted, the rest may change their environment
+		kept := make(map[string]Device, len(newState.Devices))
+		for _, d := range newState.Devices {
+			kept[string(d.Token)] = d
+		}
+		for _, d := range devices {
+			newDevice, ok := kept[string(d.Token)]
+			if !ok {
+				if _, err := deleteDevice.ExecContext(ctx, d.Token, userId); err != nil {
+					return fmt.Errorf("failed to delete device: %w", err)
+				}
+				if _, err := clearLegacyDevice.ExecContext(ctx, userId, d.Token); err != nil {
+					return fmt.Errorf("failed to clear legacy device: %w", err)
+				}
+				continue
+			}
+			if newDevice.Environment != d.Environment {
+				if _, err := updateDevice.ExecContext(ctx, newDevice.Environment, d.Token, userId); err != nil {
+					return fmt.Errorf("failed to update device: %w", err)
+				}
+			}
+		}
+
+		now := s.nowFunc()
+		for _, m := range newState.Outbox {
+			nextAttemptAt := now
+			if m.NotBefore.After(now) {
+				nextAttemptAt = m.NotBefore
+			}
+			if _, err := insertOutboxMessage.ExecContext(ctx,
+				userId, m.Token, &m.Payload, OutboxStatusPending, nextAttemptAt, now); err != nil {
+				return fmt.Errorf("failed to insert outbox message: %w", err)
+			}
+		}
 		return nil
 	}); err != nil {
 		return fmt.Errorf("failed to update sent notification: %w", err)
@@ -484,9 +1457,158 @@ func (s *Storage) UpdateSentNotification(ctx context.Context, userId string, upd
 	return nil
 }
 
-func (s *Storage) GetActiveGames(ctx context.Context) ([]*Game, error) {
+// ClaimOutbox returns pending messages that are due, they are not returned again until the lease expires
+func (s *Storage) ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]*OutboxMessage, error) {
+	now := s.nowFunc()
+	rows, err := s.claimOutbox.QueryContext(ctx, now, limit, now.Add(lease))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query claimOutbox: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var messages []*OutboxMessage
+	for rows.Next() {
+		m := OutboxMessage{}
+		var platform, environment, appVersion sql.NullString
+		var lastSeenAt *time.Time
+		if err := rows.Scan(&m.Id, &m.UserId, &m.Token, &m.Payload, &m.Status, &m.Attempts, &m.LastError,
+			&m.CreatedAt, &platform, &environment, &appVersion, &lastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		if platform.Valid && lastSeenAt != nil {
+			m.Device = &Device{
+				Token:       m.Token,
+				UserId:      m.UserId,
+				Platform:    DevicePlatform(platform.String),
+				Environment: DeviceTokenType(environment.String),
+				AppVersion:  appVersion.String,
+				LastSeenAt:  *lastSeenAt,
+			}
+		}
+		messages = append(messages, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over outbox messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil, ErrNotFound
+	}
+	return messages, nil
+}
+
+func (s *Storage) UpdateOutboxMessage(ctx context.Context, req UpdateOutboxMessage) error {
+	now := s.nowFunc()
+	if _, err := s.updateOutboxMessage.ExecContext(ctx,
+		req.Status, req.NextAttemptAt, req.LastError, now, req.Id); err != nil {
+		return fmt.Errorf("failed to update outbox message: %w", err)
+	}
+	return nil
+}
+
+// PruneOutbox deletes delivered and failed messages last updated before the given time
+// along with their delivery attempts, it returns the number of deleted messages
+func (s *Storage) PruneOutbox(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.deleteOutbox.ExecContext(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete outbox messages: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return deleted, nil
+}
+
+func (s *Storage) InsertDeliveryAttempt(ctx context.Context, a DeliveryAttempt) error {
+	if _, err := s.insertDeliveryAttempt.ExecContext(ctx, a.OutboxId, a.UserId, a.Token, a.Platform, a.Environment,
+		a.PayloadHash, a.MessageId, a.Status, a.Reason, a.Error, a.Latency.Milliseconds(), s.nowFunc()); err != nil {
+		return fmt.Errorf("failed to insert delivery attempt: %w", err)
+	}
+	return nil
+}
+
+// PruneDeliveryAttempts deletes attempts made before the given time, it returns the number of deleted attempts
+func (s *Storage) PruneDeliveryAttempts(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.deleteDeliveryAttempts.ExecContext(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete delivery attempts: %w", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return deleted, nil
+}
+
+// GetDeliveryAttempts returns the latest delivery attempts of the user, newest first
+func (s *Storage) GetDeliveryAttempts(ctx context.Context, userId string, limit int) ([]*DeliveryAttempt, error) {
+	rows, err := s.getDeliveryAttempts.QueryContext(ctx, userId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query getDeliveryAttempts: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	attempts := make([]*DeliveryAttempt, 0, limit)
+	for rows.Next() {
+		a := DeliveryAttempt{}
+		var latencyMs int64
+		if err := rows.Scan(&a.Id, &a.OutboxId, &a.UserId, &a.Token, &a.Platform, &a.Environment, &a.PayloadHash,
+			&a.MessageId, &a.Status, &a.Reason, &a.Error, &latencyMs, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan getDeliveryAttempts: %w", err)
+		}
+		a.Latency = time.Duration(latencyMs) * time.Millisecond
+		attempts = append(attempts, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over getDeliveryAttempts: %w", err)
+	}
+	if len(attempts) == 0 {
+		return nil, ErrNotFound
+	}
+	return attempts, nil
+}
+
+func (s *Storage) DeleteDevice(ctx context.Context, userId string, token []byte) error {
+	return s.deleteDeviceWith(ctx, s.deleteDevice, token, userId)
+}
+
+// DeleteUnregisteredDevice deletes the device unless it was seen after the token had been unregistered
+func (s *Storage) DeleteUnregisteredDevice(ctx context.Context, userId string, token []byte, unregisteredAt time.Time) error {
+	return s.deleteDeviceWith(ctx, s.deleteUnregisteredDevice, token, userId, unregisteredAt)
+}
+
+// deleteDeviceWith clears the legacy device of the user too, unless the device is kept
+func (s *Storage) deleteDeviceWith(ctx context.Context, deleteStmt *sql.Stmt, token []byte, userId string,
+	args ...any) error {
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.StmtContext(ctx, deleteStmt).ExecContext(ctx,
+			append([]any{token, userId}, args...)...); err != nil {
+			return fmt.Errorf("failed to delete: %w", err)
+		}
+		if _, err := tx.StmtContext(ctx, s.clearLegacyDevice).ExecContext(ctx, userId, token); err != nil {
+			return fmt.Errorf("failed to clear legacy device: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) UpdateDeviceEnvironment(ctx context.Context, userId string, token []byte,
+	environment DeviceTokenType) error {
+	if _, err := s.updateDeviceEnvironment.ExecContext(ctx, environment, token, userId); err != nil {
+		return fmt.Errorf("failed to update device environment: %w", err)
+	}
+	return nil
+}
+
+// GetActiveGames returns unfinished games with all their players whose state
+// was not updated during the last staleAge
+func (s *Storage) GetActiveGames(ctx context.Context, staleAge time.Duration) ([]*Game, error) {
 	now := s.nowFunc()
-	rows, err := s.getActiveGames.QueryContext(ctx, &now)
+	staleBefore := now.Add(-staleAge)
+	rows, err := s.getActiveGames.QueryContext(ctx, &now, &staleBefore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query getActiveGames: %w", err)
 	}
@@ -495,9 +1617,21 @@ func (s *Storage) GetActiveGames(ctx context.Context) ([]*Game, error) {
 	games := make([]*Game, 0)
 	for rows.Next() {
 		game := Game{}
-		if err := rows.Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt); err != nil {
+		player := Player{}
+		var stateUpdatedAt *time.Time
+		if err := rows.Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt,
+			&game.State, &stateUpdatedAt,
+			&player.UserId, &player.PlayerId, &player.Color); err != nil {
 			return nil, fmt.Errorf("failed to scan a row getActiveGames: %w", err)
 		}
+
+		if len(games) > 0 && games[len(games)-1].GameId == game.GameId {
+			last := games[len(games)-1]
+			last.Players = append(last.Players, player)
+			continue
+		}
+		setStateUpdatedAt(&game, stateUpdatedAt)
+		game.Players = []Player{player}
 		games = append(games, &game)
 	}
 	if err := rows.Err(); err != nil {
@@ -509,6 +1643,121 @@ func (s *Storage) GetActiveGames(ctx context.Context) ([]*Game, error) {
 	return games, nil
 }
 
+func (s *Storage) UpdateGameState(ctx context.Context, gameId string, state GameState) error {
+	now := s.nowFunc()
+	if _, err := s.updateGameState.ExecContext(ctx, &state, now, gameId); err != nil {
+		return fmt.Errorf("failed to update game state: %w", err)
+	}
+	return nil
+}
+
+// GetWaitingPlayers returns players of active games waiting since waitingBefore or earlier
+// that have received less than maxReminders reminders
+func (s *Storage) GetWaitingPlayers(ctx context.Context, waitingBefore time.Time, maxReminders int) ([]*WaitingPlayer, error) {
+	now := s.nowFunc()
+	rows, err := s.getWaitingPlayers.QueryContext(ctx, waitingBefore, maxReminders, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waiting players: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var players []*WaitingPlayer
+	for rows.Next() {
+		p := WaitingPlayer{}
+		if err := rows.Scan(&p.GameId, &p.UserId, &p.PlayerId, &p.WaitingSince, &p.RemindersSent); err != nil {
+			return nil, fmt.Errorf("failed to scan waiting players: %w", err)
+		}
+		players = append(players, &p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over waiting players: %w", err)
+	}
+	if len(players) == 0 {
+		return nil, ErrNotFound
+	}
+	return players, nil
+}
+
+// UpdateRemindersSent stores the number of reminders sent to the player,
+// nothing is updated if the player stopped waiting since then
+func (s *Storage) UpdateRemindersSent(ctx context.Context, playerId string, waitingSince time.Time, sent int) error {
+	if _, err := s.updateRemindersSent.ExecContext(ctx, sent, playerId, waitingSince); err != nil {
+		return fmt.Errorf("failed to update reminders sent: %w", err)
+	}
+	return nil
+}
+
+// UpdateGameNudged records a nudge in the game, ErrRateLimited is returned
+// if the previous nudge was less than interval ago
+func (s *Storage) UpdateGameNudged(ctx context.Context, gameId string, interval time.Duration) error {
+	now := s.nowFunc()
+	res, err := s.updateGameNudged.ExecContext(ctx, now, gameId, now.Add(-interval))
+	if err != nil {
+		return fmt.Errorf("failed to update game nudged: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// UpdateGameRematchOf links the game to the previous one of the series,
+// returns ErrAlreadyExists if the previous game has another rematch
+func (s *Storage) UpdateGameRematchOf(ctx context.Context, gameId string, rematchOf string) error {
+	if _, err := s.updateGameRematchOf.ExecContext(ctx, gameId, rematchOf); err != nil {
+		if errIsUniqueViolation(err) {
+			return ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to update game rematch of: %w", err)
+	}
+	return nil
+}
+
+// ClaimRematch reserves the rematch of the game, so that only one caller creates it.
+// A claim older than staleAfter is taken over. It returns the claim time to release the claim with,
+// or ErrNotFound if the game is rematched or claimed already.
+func (s *Storage) ClaimRematch(ctx context.Context, gameId string, staleAfter time.Duration) (time.Time, error) {
+	now := s.nowFunc()
+	var claimedAt time.Time
+	if err := s.claimRematch.QueryRowContext(ctx, gameId, now, now.Add(-staleAfter)).Scan(&claimedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, ErrNotFound
+		}
+		return time.Time{}, fmt.Errorf("failed to claim rematch: %w", err)
+	}
+	return claimedAt, nil
+}
+
+// ReleaseRematch lets others create the rematch after the claimed creation failed
+func (s *Storage) ReleaseRematch(ctx context.Context, gameId string, claimedAt time.Time) error {
+	if _, err := s.releaseRematch.ExecContext(ctx, gameId, claimedAt); err != nil {
+		return fmt.Errorf("failed to release rematch: %w", err)
+	}
+	return nil
+}
+
+// GetRematchId returns id of the rematch of the game, ErrNotFound if there is none
+func (s *Storage) GetRematchId(ctx context.Context, gameId string) (string, error) {
+	var rematchId string
+	if err := s.getRematchId.QueryRowContext(ctx, gameId).Scan(&rematchId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("failed to query getRematchId: %w", err)
+	}
+	return rematchId, nil
+}
+
+func setStateUpdatedAt(game *Game, updatedAt *time.Time) {
+	if game.State != nil && updatedAt != nil {
+		game.State.UpdatedAt = *updatedAt
+	}
+}
+
 func (s *Storage) UpdateGameResults(ctx context.Context, gameId string, results *GameResults) error {
 	now := s.nowFunc()
 	if _, err := s.updateGameResults.ExecContext(ctx, results, now, gameId); err != nil {
@@ -519,14 +1768,12 @@ func (s *Storage) UpdateGameResults(ctx context.Context, gameId string, results
 
 func (s *Storage) UpdateElo(ctx context.Context, updater EloUpdater) error {
 	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
-		getGamePlayersAndElo := tx.StmtContext(ctx, s.getGamePlayersAndElo)
 		getOldestFinishedGame := tx.StmtContext(ctx, s.getOldestFinishedGame)
-		updateGameEloResults := tx.StmtContext(ctx, s.updateGameEloResults)
-		updateUserElo := tx.StmtContext(ctx, s.updateUserElo)
 
 		var game Game
 		err := getOldestFinishedGame.QueryRowContext(ctx).
-			Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt, &game.GameResults)
+			Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt, &game.FinishedAt,
+				&game.GameResults)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return fmt.Errorf("no finished game found: %w", ErrNotFound)
@@ -534,83 +1781,465 @@ func (s *Storage) UpdateElo(ctx context.Context, updater EloUpdater) error {
 			return fmt.Errorf("failed to query getOldestFinishedGame: %w", err)
 		}
 
-		rows, err := getGamePlayersAndElo.QueryContext(ctx, game.GameId)
-		if err != nil {
-			return fmt.Errorf("failed to query getGamePlayersAndElo: %w", err)
-		}
-		defer rows.Close() //nolint:errcheck
+		return s.applyRatings(ctx, tx, game, updater)
+	}); err != nil {
+		return fmt.Errorf("failed to update elo: %w", err)
+	}
+	return nil
+}
 
-		var players []Player
-		var stateUsers []EloStateUser
-		for rows.Next() {
-			p := Player{}
-			u := EloStateUser{}
-			if err := rows.Scan(&p.UserId, &p.PlayerId, &p.Color, &u.Elo); err != nil {
-				return fmt.Errorf("failed to scan a row getGamePlayersAndElo: %w", err)
-			}
-			u.UserId = p.UserId
-			players = append(players, p)
-			stateUsers = append(stateUsers, u)
-		}
-		if err := rows.Err(); err != nil {
-			return fmt.Errorf("failed to iterate over rows getActiveGames: %w", err)
-		}
-		if len(players) == 0 || len(stateUsers) == 0 {
-			return fmt.Errorf("no players found for the game: %s", game.GameId)
-		}
+// GetUnannouncedGames returns ids of the rated games which results were not announced to the players yet
+func (s *Storage) GetUnannouncedGames(ctx context.Context) ([]string, error) {
+	rows, err := s.getUnannouncedGames.QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unannounced games: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
 
-		game.Players = players
-		eloResults, err := updater(ctx, EloUpdateState{
-			Game:  game,
-			Users: stateUsers,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to update results: %w", err)
+	var games []string
+	for rows.Next() {
+		var gameId string
+		if err := rows.Scan(&gameId); err != nil {
+			return nil, fmt.Errorf("failed to scan unannounced games: %w", err)
 		}
-
-		eloResultsUpdate, err := updateGameEloResults.ExecContext(ctx, eloResults, game.GameId)
-		if err != nil {
-			return fmt.Errorf("failed to exec updateGameEloResults: %w", err)
+		games = append(games, gameId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over unannounced games: %w", err)
+	}
+	if len(games) == 0 {
+		return nil, ErrNotFound
+	}
+	return games, nil
+}
+
+func (s *Storage) UpdateGameAnnounced(ctx context.Context, gameId string) error {
+	now := s.nowFunc()
+	if _, err := s.updateGameAnnounced.ExecContext(ctx, now, gameId); err != nil {
+		return fmt.Errorf("failed to update game announced: %w", err)
+	}
+	return nil
+}
+
+type RatingReplay struct {
+	UserId    string
+	Nickname  string
+	OldElo    int64
+	NewElo    int64
+	OldGlicko Glicko
+	NewGlicko Glicko
+	// SkippedGameId is set instead of the user for a game the updater has rejected
+	SkippedGameId string
+	SkipReason    string
+}
+
+// ReplayRatings resets every user to the base rating and applies the updater to all
+// finished games in the order they have finished. Games the updater rejects are skipped
+// and listed after the users. If dryRun is set the transaction is rolled back,
+// but the resulting ratings are still returned.
+func (s *Storage) ReplayRatings(ctx context.Context, updater EloUpdater, dryRun bool) ([]*RatingReplay, error) {
+	var replays []*RatingReplay
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		getFinishedGames := tx.StmtContext(ctx, s.getFinishedGames)
+		getUserRatings := tx.StmtContext(ctx, s.getUserRatings)
+		resetBucketRatings := tx.StmtContext(ctx, s.resetBucketRatings)
+		resetGameRatings := tx.StmtContext(ctx, s.resetGameRatings)
+		resetRatingHistory := tx.StmtContext(ctx, s.resetRatingHistory)
+		resetUserRatings := tx.StmtContext(ctx, s.resetUserRatings)
+
+		if _, err := tx.ExecContext(ctx, `LOCK TABLE manager_users, manager_games IN EXCLUSIVE MODE`); err != nil {
+			return fmt.Errorf("failed to lock tables: %w", err)
 		}
-		eloResultsAffected, err := eloResultsUpdate.RowsAffected()
+
+		before, err := queryUserRatings(ctx, getUserRatings)
 		if err != nil {
-			return fmt.Errorf("failed to get rows affected updateGameEloResults: %w", err)
+			return fmt.Errorf("failed to query ratings before replay: %w", err)
 		}
-		if eloResultsAffected != 1 {
-			return fmt.Errorf("updateGameEloResults unexpected affected rows: %d", eloResultsAffected)
+
+		if _, err := resetUserRatings.ExecContext(ctx); err != nil {
+			return fmt.Errorf("failed to reset user ratings: %w", err)
+		}
+		if _, err := resetGameRatings.ExecContext(ctx); err != nil {
+			return fmt.Errorf("failed to reset game ratings: %w", err)
+		}
+		if _, err := resetRatingHistory.ExecContext(ctx); err != nil {
+			return fmt.Errorf("failed to reset rating history: %w", err)
+		}
+		if _, err := resetBucketRatings.ExecContext(ctx); err != nil {
+			return fmt.Errorf("failed to reset bucket ratings: %w", err)
 		}
 
-		for _, u := range eloResults.Players {
-			r, err := updateUserElo.ExecContext(ctx, u.NewElo, u.UserId, u.OldElo)
-			if err != nil {
-				return fmt.Errorf("failed to updateUserElo: %w", err)
+		games, err := queryGamesWithResults(ctx, getFinishedGames)
+		if err != nil {
+			return fmt.Errorf("failed to query finished games: %w", err)
+		}
+		var skipped []*RatingReplay
+		for _, g := range games {
+			if _, err := tx.ExecContext(ctx, `SAVEPOINT replay_game`); err != nil {
+				return fmt.Errorf("failed to create savepoint: %w", err)
+			}
+			err := s.applyRatings(ctx, tx, *g, updater)
+			if errors.Is(err, errRatingsRejected) {
+				if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT replay_game`); err != nil {
+					return fmt.Errorf("failed to rollback to savepoint: %w", err)
+				}
+				skipped = append(skipped, &RatingReplay{SkippedGameId: g.GameId, SkipReason: err.Error()})
+				continue
 			}
-			affected, err := r.RowsAffected()
 			if err != nil {
-				return fmt.Errorf("failed to get rows affected updateUserEloResults: %w", err)
+				return fmt.Errorf("failed to replay game %s: %w", g.GameId, err)
+			}
+			if _, err := tx.ExecContext(ctx, `RELEASE SAVEPOINT replay_game`); err != nil {
+				return fmt.Errorf("failed to release savepoint: %w", err)
+			}
+		}
+
+		after, err := queryUserRatings(ctx, getUserRatings)
+		if err != nil {
+			return fmt.Errorf("failed to query ratings after replay: %w", err)
+		}
+
+		replays = make([]*RatingReplay, len(after))
+		for i, a := range after {
+			replays[i] = &RatingReplay{
+				UserId:    a.UserId,
+				Nickname:  a.Nickname,
+				OldElo:    a.Elo,
+				NewElo:    a.Elo,
+				OldGlicko: a.Glicko,
+				NewGlicko: a.Glicko,
 			}
-			if affected != 1 {
-				return fmt.Errorf("updateUserElo unexpected affected rows: %d", affected)
+			for _, b := range before {
+				if b.UserId == a.UserId {
+					replays[i].OldElo = b.Elo
+					replays[i].OldGlicko = b.Glicko
+				}
 			}
 		}
+		replays = append(replays, skipped...)
+
+		if dryRun {
+			return errRollback
+		}
 		return nil
-	}); err != nil {
-		return fmt.Errorf("failed to update elo: %w", err)
+	}); err != nil && !errors.Is(err, errRollback) {
+		return nil, fmt.Errorf("failed to replay ratings: %w", err)
+	}
+	return replays, nil
+}
+
+func queryDevices(ctx context.Context, stmt *sql.Stmt, userId string) ([]Device, error) {
+	rows, err := stmt.QueryContext(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query devices: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var devices []Device
+	for rows.Next() {
+		d := Device{}
+		if err := rows.Scan(&d.Token, &d.UserId, &d.Platform, &d.Environment, &d.AppVersion, &d.LastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan device: %w", err)
+		}
+		devices = append(devices, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over devices: %w", err)
+	}
+	return devices, nil
+}
+
+func queryUserRatings(ctx context.Context, stmt *sql.Stmt) ([]*User, error) {
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user ratings: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var users []*User
+	for rows.Next() {
+		user := User{}
+		if err := rows.Scan(&user.UserId, &user.Nickname, &user.Elo,
+			&user.Glicko.Rating, &user.Glicko.Deviation, &user.Glicko.Volatility); err != nil {
+			return nil, fmt.Errorf("failed to scan user ratings: %w", err)
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over user ratings: %w", err)
+	}
+	return users, nil
+}
+
+func queryGamesWithResults(ctx context.Context, stmt *sql.Stmt) ([]*Game, error) {
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query games: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var games []*Game
+	for rows.Next() {
+		game := Game{}
+		if err := rows.Scan(&game.GameId, &game.SpectatorId, &game.CreatedAt, &game.ExpiresAt,
+			&game.FinishedAt, &game.GameResults); err != nil {
+			return nil, fmt.Errorf("failed to scan games: %w", err)
+		}
+		games = append(games, &game)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over games: %w", err)
+	}
+	return games, nil
+}
+
+// applyRatings calls the updater for a single finished game and stores the results
+func (s *Storage) applyRatings(ctx context.Context, tx *sql.Tx, game Game, updater EloUpdater) error {
+	getGamePlayersAndElo := tx.StmtContext(ctx, s.getGamePlayersAndElo)
+	insertRatingHistory := tx.StmtContext(ctx, s.insertRatingHistory)
+	updateGameEloResults := tx.StmtContext(ctx, s.updateGameEloResults)
+	updateUserElo := tx.StmtContext(ctx, s.updateUserElo)
+	updateUserGlicko := tx.StmtContext(ctx, s.updateUserGlicko)
+
+	rows, err := getGamePlayersAndElo.QueryContext(ctx, game.GameId)
+	if err != nil {
+		return fmt.Errorf("failed to query getGamePlayersAndElo: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var players []Player
+	var stateUsers []EloStateUser
+	for rows.Next() {
+		p := Player{}
+		u := EloStateUser{}
+		if err := rows.Scan(&p.UserId, &p.PlayerId, &p.Color, &u.Elo,
+			&u.Glicko.Rating, &u.Glicko.Deviation, &u.Glicko.Volatility); err != nil {
+			return fmt.Errorf("failed to scan a row getGamePlayersAndElo: %w", err)
+		}
+		u.UserId = p.UserId
+		players = append(players, p)
+		stateUsers = append(stateUsers, u)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate over rows getActiveGames: %w", err)
+	}
+	if len(players) == 0 || len(stateUsers) == 0 {
+		return fmt.Errorf("no players found for the game: %s", game.GameId)
+	}
+
+	game.Players = players
+	results, err := updater(ctx, EloUpdateState{
+		Game:  game,
+		Users: stateUsers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update results: %w: %w", errRatingsRejected, err)
+	}
+
+	eloResultsUpdate, err := updateGameEloResults.ExecContext(ctx, &results.Elo, &results.Glicko, game.GameId)
+	if err != nil {
+		return fmt.Errorf("failed to exec updateGameEloResults: %w", err)
+	}
+	eloResultsAffected, err := eloResultsUpdate.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected updateGameEloResults: %w", err)
+	}
+	if eloResultsAffected != 1 {
+		return fmt.Errorf("updateGameEloResults unexpected affected rows: %d", eloResultsAffected)
+	}
+
+	for _, u := range results.Elo.Players {
+		r, err := updateUserElo.ExecContext(ctx, u.NewElo, u.UserId, u.OldElo)
+		if err != nil {
+			return fmt.Errorf("failed to updateUserElo: %w", err)
+		}
+		affected, err := r.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected updateUserEloResults: %w", err)
+		}
+		if affected != 1 {
+			return fmt.Errorf("updateUserElo unexpected affected rows: %d", affected)
+		}
+	}
+	for _, u := range results.Glicko.Players {
+		r, err := updateUserGlicko.ExecContext(ctx,
+			u.NewGlicko.Rating, u.NewGlicko.Deviation, u.NewGlicko.Volatility, u.UserId, u.OldGlicko.Rating)
+		if err != nil {
+			return fmt.Errorf("failed to updateUserGlicko: %w", err)
+		}
+		affected, err := r.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected updateUserGlicko: %w", err)
+		}
+		if affected != 1 {
+			return fmt.Errorf("updateUserGlicko unexpected affected rows: %d", affected)
+		}
+	}
+
+	changedAt := s.nowFunc()
+	if game.FinishedAt != nil {
+		changedAt = *game.FinishedAt
+	}
+	for _, h := range ratingHistory(game.GameId, changedAt, stateUsers, results) {
+		if _, err := insertRatingHistory.ExecContext(ctx, h.UserId, h.GameId, h.OldElo, h.NewElo,
+			h.OldGlicko.Rating, h.OldGlicko.Deviation, h.OldGlicko.Volatility,
+			h.NewGlicko.Rating, h.NewGlicko.Deviation, h.NewGlicko.Volatility,
+			h.CreatedAt); err != nil {
+			return fmt.Errorf("failed to insertRatingHistory: %w", err)
+		}
+	}
+
+	for _, bucket := range results.Buckets {
+		if err := s.applyBucketRatings(ctx, tx, game, bucket, updater); err != nil {
+			return fmt.Errorf("failed to apply ratings for bucket %s: %w", bucket, err)
+		}
 	}
 	return nil
 }
 
-func (s *Storage) GetLeaderboard(ctx context.Context, ut UserType, limit int64) ([]*User, error) {
-	rows, err := s.getLeaderboard.QueryContext(ctx, ut, limit)
+// applyBucketRatings calls the updater with the bucket ratings of the game players
+// and stores the results. Buckets returned by the updater are ignored.
+func (s *Storage) applyBucketRatings(ctx context.Context, tx *sql.Tx, game Game, bucket string, updater EloUpdater) error {
+	insertBucketRating := tx.StmtContext(ctx, s.insertBucketRating)
+	lockBucketRating := tx.StmtContext(ctx, s.lockBucketRating)
+	updateBucketRating := tx.StmtContext(ctx, s.updateBucketRating)
+
+	stateUsers := make([]EloStateUser, len(game.Players))
+	for i, p := range game.Players {
+		if _, err := insertBucketRating.ExecContext(ctx, p.UserId, bucket); err != nil {
+			return fmt.Errorf("failed to insertBucketRating: %w", err)
+		}
+
+		u := EloStateUser{UserId: p.UserId}
+		if err := lockBucketRating.QueryRowContext(ctx, p.UserId, bucket).
+			Scan(&u.Elo, &u.Glicko.Rating, &u.Glicko.Deviation, &u.Glicko.Volatility); err != nil {
+			return fmt.Errorf("failed to query lockBucketRating: %w", err)
+		}
+		stateUsers[i] = u
+	}
+
+	results, err := updater(ctx, EloUpdateState{
+		Game:  game,
+		Users: stateUsers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update results: %w: %w", errRatingsRejected, err)
+	}
+
+	for _, u := range ratingHistory(game.GameId, time.Time{}, stateUsers, results) {
+		if _, err := updateBucketRating.ExecContext(ctx, u.NewElo,
+			u.NewGlicko.Rating, u.NewGlicko.Deviation, u.NewGlicko.Volatility, u.UserId, bucket); err != nil {
+			return fmt.Errorf("failed to updateBucketRating: %w", err)
+		}
+	}
+	return nil
+}
+
+func ratingHistory(gameId string, changedAt time.Time, users []EloStateUser, results RatingResults) []RatingHistory {
+	history := make([]RatingHistory, len(users))
+	for i, u := range users {
+		h := RatingHistory{
+			UserId:    u.UserId,
+			GameId:    gameId,
+			OldElo:    u.Elo,
+			NewElo:    u.Elo,
+			OldGlicko: u.Glicko,
+			NewGlicko: u.Glicko,
+			CreatedAt: changedAt,
+		}
+		for _, p := range results.Elo.Players {
+			if p.UserId == u.UserId {
+				h.NewElo = p.NewElo
+			}
+		}
+		for _, p := range results.Glicko.Players {
+			if p.UserId == u.UserId {
+				h.NewGlicko = p.NewGlicko
+			}
+		}
+		history[i] = h
+	}
+	return history
+}
+
+type GetRatingHistory struct {
+	UserId string
+	Before *RatingHistoryCursor // Returns records strictly older than the cursor when set
+	Limit  int
+}
+
+type RatingHistoryCursor struct {
+	CreatedAt time.Time
+	GameId    string
+}
+
+func (s *Storage) GetRatingHistory(ctx context.Context, req GetRatingHistory) ([]*RatingHistory, error) {
+	var beforeTime *time.Time
+	var beforeGame string
+	if req.Before != nil {
+		beforeTime = &req.Before.CreatedAt
+		beforeGame = req.Before.GameId
+	}
+
+	rows, err := s.getRatingHistory.QueryContext(ctx, req.UserId, beforeTime, beforeGame, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query getRatingHistory: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	history := make([]*RatingHistory, 0, req.Limit)
+	for rows.Next() {
+		h := RatingHistory{}
+		if err := rows.Scan(&h.UserId, &h.GameId, &h.OldElo, &h.NewElo,
+			&h.OldGlicko.Rating, &h.OldGlicko.Deviation, &h.OldGlicko.Volatility,
+			&h.NewGlicko.Rating, &h.NewGlicko.Deviation, &h.NewGlicko.Volatility,
+			&h.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan getRatingHistory: %w", err)
+		}
+		history = append(history, &h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over getRatingHistory: %w", err)
+	}
+	if len(history) == 0 {
+		return nil, ErrNotFound
+	}
+	return history, nil
+}
+
+type GetLeaderboard struct {
+	Type   UserType
+	System RatingSystem
+	Bucket string // Global leaderboard if empty
+	Limit  int64
+}
+
+func (s *Storage) GetLeaderboard(ctx context.Context, req GetLeaderboard) ([]*User, error) {
+	var rows *sql.Rows
+	var err error
+	if req.Bucket == "" {
+		stmt := s.getLeaderboard
+		if req.System == RatingSystemGlicko2 {
+			stmt = s.getGlickoLeaderboard
+		}
+		rows, err = stmt.QueryContext(ctx, req.Type, req.Limit)
+	} else {
+		stmt := s.getBucketLeaderboard
+		if req.System == RatingSystemGlicko2 {
+			stmt = s.getBucketGlickoLeaderboard
+		}
+		rows, err = stmt.QueryContext(ctx, req.Type, req.Bucket, req.Limit)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query searchUsers: %w", err)
 	}
 	defer rows.Close() //nolint:errcheck
 
-	users := make([]*User, 0, limit)
+	users := make([]*User, 0, req.Limit)
 	for rows.Next() {
 		user := User{}
-		if err := rows.Scan(&user.UserId, &user.Nickname, &user.Color, &user.CreatedAt, &user.Elo); err != nil {
+		if err := rows.Scan(&user.UserId, &user.Nickname, &user.Color, &user.CreatedAt, &user.Elo,
+			&user.Glicko.Rating, &user.Glicko.Deviation, &user.Glicko.Volatility); err != nil {
 			return nil, fmt.Errorf("failed to query searchUsers: %w", err)
 		}
 		users = append(users, &user)
@@ -623,3 +2252,451 @@ func (s *Storage) GetLeaderboard(ctx context.Context, ut UserType, limit int64)
 	}
 	return users, nil
 }
+
+type CreateLobby struct {
+	LobbyId  string
+	HostId   string
+	Settings GameSettings
+	UserIds  []string // Invited users, the host is added as accepted
+	TTL      time.Duration
+}
+
+func (s *Storage) CreateLobby(ctx context.Context, req CreateLobby) error {
+	now := s.nowFunc()
+
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		insertLobby := tx.StmtContext(ctx, s.insertLobby)
+		insertLobbyMember := tx.StmtContext(ctx, s.insertLobbyMember)
+
+		_, err := insertLobby.ExecContext(ctx, req.LobbyId, req.HostId, &req.Settings, LobbyStatusOpen,
+			now, now.Add(req.TTL))
+		if err != nil {
+			return fmt.Errorf("failed to insert lobby: %w", err)
+		}
+		_, err = insertLobbyMember.ExecContext(ctx, req.LobbyId, req.HostId, InvitationStatusAccepted, now, now)
+		if err != nil {
+			return fmt.Errorf("failed to insert host(%s): %w", req.HostId, err)
+		}
+		for _, userId := range req.UserIds {
+			_, err := insertLobbyMember.ExecContext(ctx, req.LobbyId, userId, InvitationStatusPending, now, nil)
+			if err != nil {
+				return fmt.Errorf("failed to insert member(%s): %w", userId, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to create lobby: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) GetLobby(ctx context.Context, lobbyId string) (*Lobby, error) {
+	lobbies, err := s.queryLobbies(ctx, s.getLobbyById, lobbyId)
+	if err != nil {
+		return nil, err
+	}
+	return lobbies[0], nil
+}
+
+// GetLobbiesByUserId returns open and starting lobbies the user is a member of or is invited to,
+// a starting lobby is listed so that its host can start it again if the game creation was interrupted
+func (s *Storage) GetLobbiesByUserId(ctx context.Context, userId string) ([]*Lobby, error) {
+	return s.queryLobbies(ctx, s.getLobbiesByUserId, userId, s.nowFunc())
+}
+
+// InviteToLobby adds users to an open lobby, returns ErrNotFound if the lobby is not open
+// and ErrLobbyFull if the lobby would have more than maxMembers members who haven't declined
+func (s *Storage) InviteToLobby(ctx context.Context, lobbyId string, userIds []string, maxMembers int) error {
+	now := s.nowFunc()
+
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var lobbyStatus LobbyStatus
+		var expiresAt time.Time
+		row := tx.StmtContext(ctx, s.lockLobby).QueryRowContext(ctx, lobbyId)
+		if err := row.Scan(&lobbyStatus, &expiresAt); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNotFound
+			}
+			return fmt.Errorf("failed to lock lobby: %w", err)
+		}
+		if lobbyStatus != LobbyStatusOpen || !expiresAt.After(now) {
+			return ErrNotFound
+		}
+
+		// Members are counted under the lock, so concurrent invitations can't overfill the lobby
+		rows, err := tx.StmtContext(ctx, s.getLobbyMemberIds).QueryContext(ctx, lobbyId)
+		if err != nil {
+			return fmt.Errorf("failed to query lobby members: %w", err)
+		}
+		defer rows.Close() //nolint:errcheck
+		members := make(map[string]bool)
+		for rows.Next() {
+			var userId string
+			if err := rows.Scan(&userId); err != nil {
+				return fmt.Errorf("failed to scan lobby member: %w", err)
+			}
+			members[userId] = true
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to iterate over lobby members: %w", err)
+		}
+		for _, userId := range userIds {
+			members[userId] = true
+		}
+		if len(members) > maxMembers {
+			return ErrLobbyFull
+		}
+
+		insertLobbyMember := tx.StmtContext(ctx, s.insertLobbyMember)
+		for _, userId := range userIds {
+			_, err := insertLobbyMember.ExecContext(ctx, lobbyId, userId, InvitationStatusPending, now, nil)
+			if err != nil {
+				return fmt.Errorf("failed to insert member(%s): %w", userId, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrLobbyFull) {
+			return err
+		}
+		return fmt.Errorf("failed to invite to lobby: %w", err)
+	}
+	return nil
+}
+
+// UpdateLobbyMember responds to a pending invitation of an open lobby, returns ErrNotFound if there is none
+func (s *Storage) UpdateLobbyMember(ctx context.Context, lobbyId string, userId string, status InvitationStatus) error {
+	res, err := s.updateLobbyMember.ExecContext(ctx, lobbyId, userId, status, s.nowFunc())
+	if err != nil {
+		return fmt.Errorf("failed to update lobby member: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ClaimLobby moves an open lobby to starting, so that only one caller creates its game.
+// A lobby which has been starting for staleAfter is claimed again, its previous claim can't be released then.
+// It returns the claim time to release the lobby with, or ErrNotFound if the lobby can't be claimed.
+func (s *Storage) ClaimLobby(ctx context.Context, lobbyId string, staleAfter time.Duration) (time.Time, error) {
+	now := s.nowFunc()
+	var claimedAt time.Time
+	if err := s.claimLobby.QueryRowContext(ctx, lobbyId, now, now.Add(-staleAfter)).Scan(&claimedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, ErrNotFound
+		}
+		return time.Time{}, fmt.Errorf("failed to claim lobby: %w", err)
+	}
+	return claimedAt, nil
+}
+
+// ReleaseLobby moves a claimed lobby to the given status, returns ErrNotFound if the claim is no longer held.
+// Empty gameId keeps the current one.
+func (s *Storage) ReleaseLobby(ctx context.Context, lobbyId string, claimedAt time.Time, to LobbyStatus, gameId string) error {
+	res, err := s.releaseLobby.ExecContext(ctx, lobbyId, claimedAt, to, toStrPtr(gameId), s.nowFunc())
+	if err != nil {
+		return fmt.Errorf("failed to release lobby: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// queryLobbies reads lobbies with a row per member, rows of a lobby must go together
+func (s *Storage) queryLobbies(ctx context.Context, stmt *sql.Stmt, args ...any) ([]*Lobby, error) {
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query lobbies: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	now := s.nowFunc()
+	lobbies := make([]*Lobby, 0)
+	for rows.Next() {
+		lobby := Lobby{}
+		member := LobbyMember{}
+
+		var gameId sql.NullString
+		if err := rows.Scan(&lobby.LobbyId, &lobby.HostId, &lobby.Settings, &lobby.Status, &gameId,
+			&lobby.CreatedAt, &lobby.ExpiresAt,
+			&member.UserId, &member.Nickname, &member.Color, &member.Status, &member.RespondedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan lobby: %w", err)
+		}
+		if len(lobbies) == 0 || lobbies[len(lobbies)-1].LobbyId != lobby.LobbyId {
+			lobby.GameId = fromStrPtr(gameId)
+			if lobby.Status == LobbyStatusOpen && !lobby.ExpiresAt.After(now) {
+				lobby.Status = LobbyStatusExpired
+			}
+			lobbies = append(lobbies, &lobby)
+		}
+		last := lobbies[len(lobbies)-1]
+		last.Members = append(last.Members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over lobbies: %w", err)
+	}
+	if len(lobbies) == 0 {
+		return nil, ErrNotFound
+	}
+	return lobbies, nil
+}
+
+type CreatePreset struct {
+	PresetId string
+	OwnerId  string
+	Name     string
+	Settings GameSettings
+	UserIds  []string // Users the preset is shared with
+}
+
+// CreatePreset returns ErrAlreadyExists if the owner has a preset with the same name
+func (s *Storage) CreatePreset(ctx context.Context, req CreatePreset) error {
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.StmtContext(ctx, s.insertPreset).ExecContext(ctx,
+			req.PresetId, req.OwnerId, req.Name, &req.Settings, s.nowFunc())
+		if err != nil {
+			if errIsUniqueViolation(err) {
+				return ErrAlreadyExists
+			}
+			return fmt.Errorf("failed to insert preset: %w", err)
+		}
+		return insertPresetUsers(ctx, tx.StmtContext(ctx, s.insertPresetUser), req.PresetId,
+			append([]string{req.OwnerId}, req.UserIds...))
+	}); err != nil {
+		if errors.Is(err, ErrAlreadyExists) {
+			return ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to create preset: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) GetPreset(ctx context.Context, presetId string) (*Preset, error) {
+	presets, err := s.queryPresets(ctx, s.getPresetById, presetId)
+	if err != nil {
+		return nil, err
+	}
+	return presets[0], nil
+}
+
+// GetPresetsByUserId returns presets the user owns or which are shared with them
+func (s *Storage) GetPresetsByUserId(ctx context.Context, userId string) ([]*Preset, error) {
+	return s.queryPresets(ctx, s.getPresetsByUserId, userId)
+}
+
+type UpdatePreset struct {
+	PresetId string
+	Name     string
+	Settings GameSettings
+	UserIds  []string // Replace the users the preset is shared with
+}
+
+// UpdatePreset returns ErrNotFound if there is no such preset
+// and ErrAlreadyExists if the owner has another preset with the same name
+func (s *Storage) UpdatePreset(ctx context.Context, req UpdatePreset) error {
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var ownerId string
+		row := tx.StmtContext(ctx, s.updatePreset).QueryRowContext(ctx,
+			req.PresetId, req.Name, &req.Settings, s.nowFunc())
+		if err := row.Scan(&ownerId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNotFound
+			}
+			if errIsUniqueViolation(err) {
+				return ErrAlreadyExists
+			}
+			return fmt.Errorf("failed to update preset: %w", err)
+		}
+
+		if _, err := tx.StmtContext(ctx, s.deletePresetUsers).ExecContext(ctx, req.PresetId); err != nil {
+			return fmt.Errorf("failed to delete preset users: %w", err)
+		}
+		return insertPresetUsers(ctx, tx.StmtContext(ctx, s.insertPresetUser), req.PresetId,
+			append([]string{ownerId}, req.UserIds...))
+	}); err != nil {
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrAlreadyExists) {
+			return err
+		}
+		return fmt.Errorf("failed to update preset: %w", err)
+	}
+	return nil
+}
+
+// DeletePreset returns ErrNotFound if there is no such preset
+func (s *Storage) DeletePreset(ctx context.Context, presetId string) error {
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.StmtContext(ctx, s.deletePresetUsers).ExecContext(ctx, presetId); err != nil {
+			return fmt.Errorf("failed to delete preset users: %w", err)
+		}
+		res, err := tx.StmtContext(ctx, s.deletePreset).ExecContext(ctx, presetId)
+		if err != nil {
+			return fmt.Errorf("failed to delete preset: %w", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if affected == 0 {
+			return ErrNotFound
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to delete preset: %w", err)
+	}
+	return nil
+}
+
+func insertPresetUsers(ctx context.Context, stmt *sql.Stmt, presetId string, userIds []string) error {
+	for _, userId := range userIds {
+		if _, err := stmt.ExecContext(ctx, presetId, userId); err != nil {
+			return fmt.Errorf("failed to insert preset user(%s): %w", userId, err)
+		}
+	}
+	return nil
+}
+
+// queryPresets reads presets with a row per user, rows of a preset must go together
+func (s *Storage) queryPresets(ctx context.Context, stmt *sql.Stmt, args ...any) ([]*Preset, error) {
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query presets: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	presets := make([]*Preset, 0)
+	for rows.Next() {
+		preset := Preset{}
+		user := PresetUser{}
+
+		if err := rows.Scan(&preset.PresetId, &preset.OwnerId, &preset.Name, &preset.Settings,
+			&preset.CreatedAt, &preset.UpdatedAt, &user.UserId, &user.Nickname); err != nil {
+			return nil, fmt.Errorf("failed to scan preset: %w", err)
+		}
+		if len(presets) == 0 || presets[len(presets)-1].PresetId != preset.PresetId {
+			presets = append(presets, &preset)
+		}
+		last := presets[len(presets)-1]
+		last.Users = append(last.Users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over presets: %w", err)
+	}
+	if len(presets) == 0 {
+		return nil, ErrNotFound
+	}
+	return presets, nil
+}
+
+// Enqueue puts the user in the matchmaking queue or replaces their preferences, the wait starts over
+func (s *Storage) Enqueue(ctx context.Context, userId string, preferences MatchmakingPreferences) error {
+	if _, err := s.upsertQueueEntry.ExecContext(ctx, userId, &preferences, s.nowFunc()); err != nil {
+		return fmt.Errorf("failed to upsert queue entry: %w", err)
+	}
+	return nil
+}
+
+// Dequeue removes the user from the matchmaking queue, returns ErrNotFound if they are not queued
+func (s *Storage) Dequeue(ctx context.Context, userId string) error {
+	res, err := s.deleteQueueEntry.ExecContext(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("failed to delete queue entry: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetQueue returns players enqueued less than maxAge ago, the longest waiting first
+func (s *Storage) GetQueue(ctx context.Context, maxAge time.Duration) ([]*QueueEntry, error) {
+	rows, err := s.getQueue.QueryContext(ctx, s.nowFunc().Add(-maxAge))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query getQueue: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	entries := make([]*QueueEntry, 0)
+	for rows.Next() {
+		entry := QueueEntry{}
+		if err := rows.Scan(&entry.UserId, &entry.Nickname, &entry.Color, &entry.Elo,
+			&entry.Preferences, &entry.EnqueuedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan getQueue: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over getQueue: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, ErrNotFound
+	}
+	return entries, nil
+}
+
+func (s *Storage) GetQueueEntry(ctx context.Context, userId string, maxAge time.Duration) (*QueueEntry, error) {
+	entry := QueueEntry{}
+	row := s.getQueueEntry.QueryRowContext(ctx, userId, s.nowFunc().Add(-maxAge))
+	if err := row.Scan(&entry.UserId, &entry.Nickname, &entry.Color, &entry.Elo,
+		&entry.Preferences, &entry.EnqueuedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to query getQueueEntry: %w", err)
+	}
+	return &entry, nil
+}
+
+// ClaimQueueEntries removes all the entries from the queue or none of them.
+// ErrNotFound is returned if any player has left or enqueued again since the entries were read.
+func (s *Storage) ClaimQueueEntries(ctx context.Context, entries []*QueueEntry) error {
+	if err := s.withTX(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		claimQueueEntry := tx.StmtContext(ctx, s.claimQueueEntry)
+		for _, e := range entries {
+			res, err := claimQueueEntry.ExecContext(ctx, e.UserId, e.EnqueuedAt)
+			if err != nil {
+				return fmt.Errorf("failed to claim queue entry(%s): %w", e.UserId, err)
+			}
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("failed to get affected rows: %w", err)
+			}
+			if affected == 0 {
+				return ErrNotFound
+			}
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to claim queue entries: %w", err)
+	}
+	return nil
+}
+
+// RestoreQueueEntries puts claimed entries back keeping their wait time
+func (s *Storage) RestoreQueueEntries(ctx context.Context, entries []*QueueEntry) error {
+	for _, e := range entries {
+		if _, err := s.insertQueueEntry.ExecContext(ctx, e.UserId, &e.Preferences, e.EnqueuedAt); err != nil {
+			return fmt.Errorf("failed to insert queue entry(%s): %w", e.UserId, err)
+		}
+	}
+	return nil
+}
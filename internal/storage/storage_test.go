@@ -3,7 +3,9 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +20,8 @@ const (
 	defaultDatabase = "postgres"
 )
 
+var initialGlicko = Glicko{Rating: 1500, Deviation: 350, Volatility: 0.06}
+
 func TestMigrations(t *testing.T) {
 	t.Parallel()
 
@@ -50,6 +54,20 @@ func TestStorage_GetGamesByUserId(t *testing.T) {
 		assert.NilError(t, err)
 	}
 
+	gbu4Settings := GameSettings{
+		Board:        "hellas",
+		CorporateEra: true,
+		Colonies:     true,
+		Seed:         0.42,
+		FirstUserId:  "game_by_user3",
+		Advanced: &AdvancedSettings{
+			Turmoil:       true,
+			Draft:         true,
+			RandomMA:      "Limited synergy",
+			BannedCards:   []string{"Mohole Lake"},
+			IncludedCards: []string{"Pets"},
+		},
+	}
 	for _, g := range []*Game{
 		{
 			GameId:      "gbu1",
@@ -89,6 +107,7 @@ func TestStorage_GetGamesByUserId(t *testing.T) {
 				{UserId: "game_by_user3", PlayerId: "p4_3", Color: ColorYellow},
 				{UserId: "game_by_user2", PlayerId: "p4_2", Color: ColorBronze},
 			},
+			Settings: &gbu4Settings,
 		},
 		{ // Finished game
 			GameId:      "gbu5",
@@ -136,6 +155,7 @@ func TestStorage_GetGamesByUserId(t *testing.T) {
 				Players: []Player{
 					{UserId: "game_by_user2", PlayerId: "p4_2", Color: ColorBronze},
 				},
+				Settings: &gbu4Settings,
 			},
 		}); diff != "" {
 			t.Errorf("GetGamesByUserId (-want +got):\n%s", diff)
@@ -165,6 +185,7 @@ func TestStorage_GetGamesByUserId(t *testing.T) {
 				Players: []Player{
 					{UserId: "game_by_user2", PlayerId: "p4_2", Color: ColorBronze},
 				},
+				Settings: &gbu4Settings,
 			},
 			{
 				GameId:      "gbu5",
@@ -181,6 +202,52 @@ func TestStorage_GetGamesByUserId(t *testing.T) {
 		}
 	})
 
+	t.Run("GetFinishedGames", func(t *testing.T) {
+		got, err := storage.GetFinishedGames(ctx, GetFinishedGames{UserId: "game_by_user2", Limit: 10})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, []*Game{
+			{
+				GameId:      "gbu5",
+				SpectatorId: "sbu5",
+				CreatedAt:   gameNow,
+				ExpiresAt:   gameNow.Add(time.Hour),
+				FinishedAt:  &finishTime,
+				Players: []Player{
+					{UserId: "game_by_user1", PlayerId: "p5_1", Color: ColorBlue},
+					{UserId: "game_by_user3", PlayerId: "p5_3", Color: ColorYellow},
+					{UserId: "game_by_user2", PlayerId: "p5_2", Color: ColorBronze},
+				},
+			},
+		})
+
+		_, err = storage.GetFinishedGames(ctx, GetFinishedGames{
+			UserId: "game_by_user2",
+			Before: &GameHistoryCursor{FinishedAt: finishTime, GameId: "gbu5"},
+			Limit:  10,
+		})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("GetGameById", func(t *testing.T) {
+		got, err := storage.GetGameById(ctx, "gbu4")
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, &Game{
+			GameId:      "gbu4",
+			SpectatorId: "sbu4",
+			CreatedAt:   gameNow,
+			ExpiresAt:   gameNow.Add(time.Hour),
+			Players: []Player{
+				{UserId: "game_by_user1", PlayerId: "p4_1", Color: ColorBlue},
+				{UserId: "game_by_user3", PlayerId: "p4_3", Color: ColorYellow},
+				{UserId: "game_by_user2", PlayerId: "p4_2", Color: ColorBronze},
+			},
+			Settings: &gbu4Settings,
+		})
+
+		_, err = storage.GetGameById(ctx, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
 	t.Run("GetGameByPlayerId", func(t *testing.T) {
 		got, err := storage.GetGameByPlayerId(ctx, "p1_3")
 		assert.NilError(t, err)
@@ -196,6 +263,542 @@ func TestStorage_GetGamesByUserId(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("rematch", func(t *testing.T) {
+		_, err := storage.GetRematchId(ctx, "gbu5")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		claimedAt, err := storage.ClaimRematch(ctx, "gbu5", time.Minute)
+		assert.NilError(t, err)
+		_, err = storage.ClaimRematch(ctx, "gbu5", time.Minute)
+		assert.ErrorIs(t, err, ErrNotFound)
+		err = storage.ReleaseRematch(ctx, "gbu5", claimedAt)
+		assert.NilError(t, err)
+		_, err = storage.ClaimRematch(ctx, "gbu5", time.Minute)
+		assert.NilError(t, err)
+
+		err = storage.UpdateGameRematchOf(ctx, "gbu2", "gbu5")
+		assert.NilError(t, err)
+		err = storage.UpdateGameRematchOf(ctx, "gbu1", "gbu2")
+		assert.NilError(t, err)
+		// Only one rematch per game
+		err = storage.UpdateGameRematchOf(ctx, "gbu4", "gbu2")
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+		_, err = storage.ClaimRematch(ctx, "gbu2", 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		rematchId, err := storage.GetRematchId(ctx, "gbu5")
+		assert.NilError(t, err)
+		assert.Equal(t, rematchId, "gbu2")
+
+		for _, tt := range []struct {
+			gameId    string
+			rematchOf string
+		}{
+			{gameId: "gbu5"},
+			{gameId: "gbu2", rematchOf: "gbu5"},
+			{gameId: "gbu1", rematchOf: "gbu2"},
+		} {
+			got, err := storage.GetGameById(ctx, tt.gameId)
+			assert.NilError(t, err)
+			assert.Equal(t, got.SeriesId, "gbu5")
+			assert.Equal(t, got.RematchOf, tt.rematchOf)
+		}
+	})
+}
+
+func TestStorage_ReplayRatings(t *testing.T) {
+	t.Parallel()
+
+	storage := prepareStorage(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+	storage.nowFunc = func() time.Time { return now }
+	for _, u := range []UpsertUser{
+		{UserId: "replay_user1", Nickname: "replay user 1"},
+		{UserId: "replay_user2", Nickname: "replay user 2"},
+	} {
+		err := storage.UpsertUser(ctx, u)
+		assert.NilError(t, err)
+	}
+
+	for i, g := range []*Game{
+		{
+			GameId:      "replay1",
+			SpectatorId: "sreplay1",
+			ExpiresAt:   now.Add(time.Hour),
+			Players: []Player{
+				{UserId: "replay_user1", PlayerId: "rp1_1", Color: ColorBlue},
+				{UserId: "replay_user2", PlayerId: "rp1_2", Color: ColorRed},
+			},
+		},
+		{
+			GameId:      "replay2",
+			SpectatorId: "sreplay2",
+			ExpiresAt:   now.Add(time.Hour),
+			Players: []Player{
+				{UserId: "replay_user1", PlayerId: "rp2_1", Color: ColorBlue},
+				{UserId: "replay_user2", PlayerId: "rp2_2", Color: ColorRed},
+			},
+		},
+	} {
+		err := storage.CreateGame(ctx, g)
+		assert.NilError(t, err)
+
+		finishedAt := now.Add(time.Duration(i) * time.Minute)
+		storage.nowFunc = func() time.Time { return finishedAt }
+		err = storage.UpdateGameResults(ctx, g.GameId, &GameResults{Raw: map[string]any{}})
+		assert.NilError(t, err)
+		storage.nowFunc = func() time.Time { return now }
+	}
+
+	// The first player always wins 10 points, the second one loses 5
+	var replayed []string
+	updater := func(ctx context.Context, state EloUpdateState) (RatingResults, error) {
+		replayed = append(replayed, state.Game.GameId)
+		results := RatingResults{}
+		for i, u := range state.Users {
+			delta := int64(10)
+			if i > 0 {
+				delta = -5
+			}
+			results.Elo.Players = append(results.Elo.Players, EloResultsPlayer{
+				UserId:   u.UserId,
+				PlayerId: state.Game.Players[i].PlayerId,
+				OldElo:   u.Elo,
+				NewElo:   u.Elo + delta,
+			})
+		}
+		return results, nil
+	}
+
+	// Original processing with a different rating function
+	for i := 0; i < 2; i++ {
+		err := storage.UpdateElo(ctx, func(ctx context.Context, state EloUpdateState) (RatingResults, error) {
+			results := RatingResults{}
+			for i, u := range state.Users {
+				results.Elo.Players = append(results.Elo.Players, EloResultsPlayer{
+					UserId:   u.UserId,
+					PlayerId: state.Game.Players[i].PlayerId,
+					OldElo:   u.Elo,
+					NewElo:   u.Elo + 1,
+				})
+			}
+			return results, nil
+		})
+		assert.NilError(t, err)
+	}
+
+	wantReplays := []*RatingReplay{
+		{UserId: "replay_user1", Nickname: "replay user 1", OldElo: 1002, NewElo: 1020,
+			OldGlicko: initialGlicko, NewGlicko: initialGlicko},
+		{UserId: "replay_user2", Nickname: "replay user 2", OldElo: 1002, NewElo: 990,
+			OldGlicko: initialGlicko, NewGlicko: initialGlicko},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		replayed = nil
+		got, err := storage.ReplayRatings(ctx, updater, true)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, wantReplays)
+		assert.DeepEqual(t, replayed, []string{"replay1", "replay2"})
+
+		u, err := storage.GetUserById(ctx, "replay_user1")
+		assert.NilError(t, err)
+		assert.Equal(t, u.Elo, int64(1002))
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		replayed = nil
+		got, err := storage.ReplayRatings(ctx, updater, false)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, wantReplays)
+		assert.DeepEqual(t, replayed, []string{"replay1", "replay2"})
+
+		u, err := storage.GetUserById(ctx, "replay_user1")
+		assert.NilError(t, err)
+		assert.Equal(t, u.Elo, int64(1020))
+		u, err = storage.GetUserById(ctx, "replay_user2")
+		assert.NilError(t, err)
+		assert.Equal(t, u.Elo, int64(990))
+
+		// All games have elo results again
+		err = storage.UpdateElo(ctx, updater)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("rejected game", func(t *testing.T) {
+		rejecting := func(ctx context.Context, state EloUpdateState) (RatingResults, error) {
+			if state.Game.GameId == "replay1" {
+				return RatingResults{}, errors.New("not enough players")
+			}
+			return updater(ctx, state)
+		}
+		got, err := storage.ReplayRatings(ctx, rejecting, true)
+		assert.NilError(t, err)
+		assert.Equal(t, len(got), 3)
+		assert.DeepEqual(t, got[:2], []*RatingReplay{
+			{UserId: "replay_user1", Nickname: "replay user 1", OldElo: 1020, NewElo: 1010,
+				OldGlicko: initialGlicko, NewGlicko: initialGlicko},
+			{UserId: "replay_user2", Nickname: "replay user 2", OldElo: 990, NewElo: 995,
+				OldGlicko: initialGlicko, NewGlicko: initialGlicko},
+		})
+		assert.Equal(t, got[2].SkippedGameId, "replay1")
+		assert.Assert(t, strings.Contains(got[2].SkipReason, "not enough players"))
+	})
+}
+
+func TestStorage_Lobbies(t *testing.T) {
+	t.Parallel()
+
+	storage := prepareStorage(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+	storage.nowFunc = func() time.Time { return now }
+	for _, u := range []UpsertUser{
+		{UserId: "lobby_user1", Nickname: "lobby user 1"},
+		{UserId: "lobby_user2", Nickname: "lobby user 2"},
+		{UserId: "lobby_user3", Nickname: "lobby user 3"},
+	} {
+		err := storage.UpsertUser(ctx, u)
+		assert.NilError(t, err)
+	}
+	memberStatuses := func(lobby *Lobby) map[string]InvitationStatus {
+		statuses := make(map[string]InvitationStatus)
+		for _, m := range lobby.Members {
+			statuses[m.UserId] = m.Status
+		}
+		return statuses
+	}
+
+	err := storage.CreateLobby(ctx, CreateLobby{
+		LobbyId:  "lobby1",
+		HostId:   "lobby_user1",
+		Settings: GameSettings{Board: "hellas", Prelude: true},
+		UserIds:  []string{"lobby_user2"},
+		TTL:      time.Hour,
+	})
+	assert.NilError(t, err)
+
+	t.Run("GetLobby", func(t *testing.T) {
+		lobby, err := storage.GetLobby(ctx, "lobby1")
+		assert.NilError(t, err)
+		assert.Equal(t, lobby.HostId, "lobby_user1")
+		assert.Equal(t, lobby.Status, LobbyStatusOpen)
+		assert.DeepEqual(t, lobby.Settings, GameSettings{Board: "hellas", Prelude: true})
+		assert.Equal(t, lobby.ExpiresAt.Unix(), now.Add(time.Hour).Unix())
+		assert.Equal(t, len(lobby.Members), 2)
+		assert.Equal(t, lobby.Members[0].UserId, "lobby_user1")
+		assert.Equal(t, lobby.Members[0].Nickname, "lobby user 1")
+		assert.DeepEqual(t, memberStatuses(lobby), map[string]InvitationStatus{
+			"lobby_user1": InvitationStatusAccepted,
+			"lobby_user2": InvitationStatusPending,
+		})
+
+		_, err = storage.GetLobby(ctx, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invitations", func(t *testing.T) {
+		err := storage.InviteToLobby(ctx, "lobby1", []string{"lobby_user3"}, 2)
+		assert.ErrorIs(t, err, ErrLobbyFull)
+		err = storage.InviteToLobby(ctx, "lobby1", []string{"lobby_user3"}, 3)
+		assert.NilError(t, err)
+		err = storage.UpdateLobbyMember(ctx, "lobby1", "lobby_user3", InvitationStatusDeclined)
+		assert.NilError(t, err)
+		err = storage.UpdateLobbyMember(ctx, "lobby1", "lobby_user3", InvitationStatusAccepted)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		lobbies, err := storage.GetLobbiesByUserId(ctx, "lobby_user3")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, len(lobbies), 0)
+
+		// Declined users can be invited again, they are not counted as members
+		err = storage.InviteToLobby(ctx, "lobby1", []string{"lobby_user3"}, 3)
+		assert.NilError(t, err)
+		err = storage.UpdateLobbyMember(ctx, "lobby1", "lobby_user3", InvitationStatusAccepted)
+		assert.NilError(t, err)
+
+		lobbies, err = storage.GetLobbiesByUserId(ctx, "lobby_user3")
+		assert.NilError(t, err)
+		assert.Equal(t, len(lobbies), 1)
+		assert.DeepEqual(t, memberStatuses(lobbies[0]), map[string]InvitationStatus{
+			"lobby_user1": InvitationStatusAccepted,
+			"lobby_user2": InvitationStatusPending,
+			"lobby_user3": InvitationStatusAccepted,
+		})
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		err := storage.CreateLobby(ctx, CreateLobby{
+			LobbyId: "lobby2",
+			HostId:  "lobby_user2",
+			UserIds: []string{"lobby_user3"},
+			TTL:     time.Minute,
+		})
+		assert.NilError(t, err)
+
+		expiredNow := now.Add(time.Minute)
+		storage.nowFunc = func() time.Time { return expiredNow }
+		defer func() { storage.nowFunc = func() time.Time { return now } }()
+
+		lobby, err := storage.GetLobby(ctx, "lobby2")
+		assert.NilError(t, err)
+		assert.Equal(t, lobby.Status, LobbyStatusExpired)
+		err = storage.UpdateLobbyMember(ctx, "lobby2", "lobby_user3", InvitationStatusAccepted)
+		assert.ErrorIs(t, err, ErrNotFound)
+		err = storage.InviteToLobby(ctx, "lobby2", []string{"lobby_user1"}, 5)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = storage.ClaimLobby(ctx, "lobby2", time.Minute)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("start", func(t *testing.T) {
+		claimedAt, err := storage.ClaimLobby(ctx, "lobby1", time.Minute)
+		assert.NilError(t, err)
+		_, err = storage.ClaimLobby(ctx, "lobby1", time.Minute)
+		assert.ErrorIs(t, err, ErrNotFound)
+		err = storage.UpdateLobbyMember(ctx, "lobby1", "lobby_user2", InvitationStatusAccepted)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// A stale claim is taken over and can't be released anymore
+		storage.nowFunc = func() time.Time { return now.Add(2 * time.Minute) }
+		reclaimedAt, err := storage.ClaimLobby(ctx, "lobby1", time.Minute)
+		assert.NilError(t, err)
+		storage.nowFunc = func() time.Time { return now }
+		err = storage.ReleaseLobby(ctx, "lobby1", claimedAt, LobbyStatusOpen, "")
+		assert.ErrorIs(t, err, ErrNotFound)
+		lobby, err := storage.GetLobby(ctx, "lobby1")
+		assert.NilError(t, err)
+		assert.Equal(t, lobby.Status, LobbyStatusStarting)
+		lobbies, err := storage.GetLobbiesByUserId(ctx, "lobby_user1")
+		assert.NilError(t, err)
+		assert.Equal(t, len(lobbies), 1)
+
+		err = storage.CreateGame(ctx, &Game{
+			GameId:      "lobby_game1",
+			SpectatorId: "lobby_spectator1",
+			ExpiresAt:   now.Add(time.Hour),
+			Players: []Player{
+				{UserId: "lobby_user1", PlayerId: "lgp1_1", Color: ColorBlue},
+				{UserId: "lobby_user3", PlayerId: "lgp1_3", Color: ColorRed},
+			},
+		})
+		assert.NilError(t, err)
+		err = storage.ReleaseLobby(ctx, "lobby1", reclaimedAt, LobbyStatusStarted, "lobby_game1")
+		assert.NilError(t, err)
+
+		lobby, err = storage.GetLobby(ctx, "lobby1")
+		assert.NilError(t, err)
+		assert.Equal(t, lobby.Status, LobbyStatusStarted)
+		assert.Equal(t, lobby.GameId, "lobby_game1")
+
+		_, err = storage.GetLobbiesByUserId(ctx, "lobby_user1")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestStorage_Matchmaking(t *testing.T) {
+	t.Parallel()
+
+	storage := prepareStorage(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+	storage.nowFunc = func() time.Time { return now }
+	for _, u := range []UpsertUser{
+		{UserId: "queue_user1", Nickname: "queue user 1"},
+		{UserId: "queue_user2", Nickname: "queue user 2"},
+	} {
+		err := storage.UpsertUser(ctx, u)
+		assert.NilError(t, err)
+	}
+
+	_, err := storage.GetQueue(ctx, time.Hour)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	preferences := MatchmakingPreferences{
+		PlayersCounts: []int{2, 3},
+		Settings:      GameSettings{Prelude: true},
+		RatingRange:   100,
+	}
+	err = storage.Enqueue(ctx, "queue_user1", preferences)
+	assert.NilError(t, err)
+	now = now.Add(time.Minute)
+	err = storage.Enqueue(ctx, "queue_user2", MatchmakingPreferences{PlayersCounts: []int{2}})
+	assert.NilError(t, err)
+
+	t.Run("GetQueue", func(t *testing.T) {
+		entries, err := storage.GetQueue(ctx, time.Hour)
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 2)
+		assert.Equal(t, entries[0].UserId, "queue_user1")
+		assert.Equal(t, entries[0].Nickname, "queue user 1")
+		assert.DeepEqual(t, entries[0].Preferences, preferences)
+		assert.Equal(t, entries[1].UserId, "queue_user2")
+
+		// The first player has waited too long
+		entries, err = storage.GetQueue(ctx, 30*time.Second)
+		assert.NilError(t, err)
+		assert.Equal(t, len(entries), 1)
+		assert.Equal(t, entries[0].UserId, "queue_user2")
+
+		entry, err := storage.GetQueueEntry(ctx, "queue_user1", time.Hour)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, entry.Preferences, preferences)
+		_, err = storage.GetQueueEntry(ctx, "queue_user1", 30*time.Second)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		entries, err := storage.GetQueue(ctx, time.Hour)
+		assert.NilError(t, err)
+
+		// The second player enqueues again, the read entries are outdated
+		now = now.Add(time.Minute)
+		err = storage.Enqueue(ctx, "queue_user2", MatchmakingPreferences{PlayersCounts: []int{2}})
+		assert.NilError(t, err)
+		err = storage.ClaimQueueEntries(ctx, entries)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = storage.GetQueueEntry(ctx, "queue_user1", time.Hour)
+		assert.NilError(t, err)
+
+		entries, err = storage.GetQueue(ctx, time.Hour)
+		assert.NilError(t, err)
+		err = storage.ClaimQueueEntries(ctx, entries)
+		assert.NilError(t, err)
+		_, err = storage.GetQueue(ctx, time.Hour)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		err = storage.RestoreQueueEntries(ctx, entries)
+		assert.NilError(t, err)
+		restored, err := storage.GetQueue(ctx, time.Hour)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, restored, entries)
+	})
+
+	t.Run("Dequeue", func(t *testing.T) {
+		err := storage.Dequeue(ctx, "queue_user1")
+		assert.NilError(t, err)
+		err = storage.Dequeue(ctx, "queue_user1")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestStorage_Presets(t *testing.T) {
+	t.Parallel()
+
+	storage := prepareStorage(t)
+	ctx := context.Background()
+
+	for _, u := range []UpsertUser{
+		{UserId: "preset_user1", Nickname: "preset user 1"},
+		{UserId: "preset_user2", Nickname: "preset user 2"},
+		{UserId: "preset_user3", Nickname: "preset user 3"},
+	} {
+		err := storage.UpsertUser(ctx, u)
+		assert.NilError(t, err)
+	}
+	userIds := func(preset *Preset) []string {
+		ids := make([]string, len(preset.Users))
+		for i, u := range preset.Users {
+			ids[i] = u.UserId
+		}
+		return ids
+	}
+
+	hellas := GameSettings{
+		Board:    "hellas",
+		Advanced: &AdvancedSettings{Draft: true, RandomMA: "Full random"},
+	}
+	err := storage.CreatePreset(ctx, CreatePreset{
+		PresetId: "preset1",
+		OwnerId:  "preset_user1",
+		Name:     "hellas",
+		Settings: hellas,
+		UserIds:  []string{"preset_user2"},
+	})
+	assert.NilError(t, err)
+
+	t.Run("GetPreset", func(t *testing.T) {
+		preset, err := storage.GetPreset(ctx, "preset1")
+		assert.NilError(t, err)
+		assert.Equal(t, preset.OwnerId, "preset_user1")
+		assert.Equal(t, preset.Name, "hellas")
+		assert.DeepEqual(t, preset.Settings, hellas)
+		assert.DeepEqual(t, userIds(preset), []string{"preset_user1", "preset_user2"})
+		assert.Equal(t, preset.Users[0].Nickname, "preset user 1")
+
+		_, err = storage.GetPreset(ctx, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		err := storage.CreatePreset(ctx, CreatePreset{
+			PresetId: "preset2",
+			OwnerId:  "preset_user1",
+			Name:     "hellas",
+			Settings: GameSettings{},
+		})
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+
+		err = storage.CreatePreset(ctx, CreatePreset{
+			PresetId: "preset3",
+			OwnerId:  "preset_user3",
+			Name:     "hellas",
+			Settings: GameSettings{},
+		})
+		assert.NilError(t, err)
+	})
+
+	t.Run("GetPresetsByUserId", func(t *testing.T) {
+		presets, err := storage.GetPresetsByUserId(ctx, "preset_user2")
+		assert.NilError(t, err)
+		assert.Equal(t, len(presets), 1)
+		assert.Equal(t, presets[0].PresetId, "preset1")
+
+		_, err = storage.GetPresetsByUserId(ctx, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("UpdatePreset", func(t *testing.T) {
+		hellasColonies := GameSettings{
+			Board:    "hellas",
+			Colonies: true,
+			Advanced: &AdvancedSettings{BannedCards: []string{"Pets"}},
+		}
+		err := storage.UpdatePreset(ctx, UpdatePreset{
+			PresetId: "preset1",
+			Name:     "hellas colonies",
+			Settings: hellasColonies,
+			UserIds:  []string{"preset_user3"},
+		})
+		assert.NilError(t, err)
+
+		preset, err := storage.GetPreset(ctx, "preset1")
+		assert.NilError(t, err)
+		assert.Equal(t, preset.Name, "hellas colonies")
+		assert.DeepEqual(t, preset.Settings, hellasColonies)
+		assert.DeepEqual(t, userIds(preset), []string{"preset_user1", "preset_user3"})
+
+		_, err = storage.GetPresetsByUserId(ctx, "preset_user2")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		err = storage.UpdatePreset(ctx, UpdatePreset{PresetId: "unknown"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("DeletePreset", func(t *testing.T) {
+		err := storage.DeletePreset(ctx, "preset3")
+		assert.NilError(t, err)
+		_, err = storage.GetPreset(ctx, "preset3")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		err = storage.DeletePreset(ctx, "preset3")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
 }
 
 func TestStorage(t *testing.T) {
@@ -221,14 +824,14 @@ func TestStorage(t *testing.T) {
 			user, err := storage.GetUserById(ctx, "test user id")
 			assert.NilError(t, err)
 			assert.DeepEqual(t, user, &User{
-				UserId:          "test user id",
-				Nickname:        "test user nickname",
-				Color:           ColorBronze,
-				CreatedAt:       now,
-				DeviceTokenType: DeviceTokenTypeProduction,
-				LastIp:          "last ip 1",
-				Type:            UserTypeBlank, // Upsert creates blank user
-				Elo:             1000,
+				UserId:    "test user id",
+				Nickname:  "test user nickname",
+				Color:     ColorBronze,
+				CreatedAt: now,
+				LastIp:    "last ip 1",
+				Type:      UserTypeBlank, // Upsert creates blank user
+				Elo:       1000,
+				Glicko:    initialGlicko,
 			})
 		})
 
@@ -246,14 +849,14 @@ func TestStorage(t *testing.T) {
 			user, err := storage.GetUserById(ctx, "test user id")
 			assert.NilError(t, err)
 			assert.DeepEqual(t, user, &User{
-				UserId:          "test user id",
-				Nickname:        "test user nickname",
-				Color:           ColorBronze,
-				CreatedAt:       now,
-				DeviceTokenType: DeviceTokenTypeProduction,
-				LastIp:          "last ip 2",
-				Type:            UserTypeBlank,
-				Elo:             1000,
+				UserId:    "test user id",
+				Nickname:  "test user nickname",
+				Color:     ColorBronze,
+				CreatedAt: now,
+				LastIp:    "last ip 2",
+				Type:      UserTypeBlank,
+				Elo:       1000,
+				Glicko:    initialGlicko,
 			})
 		})
 
@@ -266,13 +869,13 @@ func TestStorage(t *testing.T) {
 			assert.NilError(t, err)
 
 			expected := &User{
-				UserId:          "test get user no ip id",
-				Nickname:        "test get user no ip nickname",
-				Color:           ColorBronze,
-				CreatedAt:       now2,
-				DeviceTokenType: DeviceTokenTypeProduction,
-				Type:            UserTypeBlank,
-				Elo:             1000,
+				UserId:    "test get user no ip id",
+				Nickname:  "test get user no ip nickname",
+				Color:     ColorBronze,
+				CreatedAt: now2,
+				Type:      UserTypeBlank,
+				Elo:       1000,
+				Glicko:    initialGlicko,
 			}
 
 			user, err := storage.GetUserById(ctx, "test get user no ip id")
@@ -306,14 +909,14 @@ func TestStorage(t *testing.T) {
 			assert.DeepEqual(t, expectedAfterUpdate, updated)
 
 			expectedAfterGet := &User{
-				UserId:          "test user id",
-				Nickname:        "new test nickname",
-				Color:           ColorGreen,
-				CreatedAt:       now,
-				DeviceTokenType: DeviceTokenTypeProduction,
-				LastIp:          "last ip 2",
-				Type:            UserTypeActive,
-				Elo:             1000,
+				UserId:    "test user id",
+				Nickname:  "new test nickname",
+				Color:     ColorGreen,
+				CreatedAt: now,
+				LastIp:    "last ip 2",
+				Type:      UserTypeActive,
+				Elo:       1000,
+				Glicko:    initialGlicko,
 			}
 			got, err := storage.GetUserById(ctx, "test user id")
 			assert.NilError(t, err)
@@ -332,14 +935,14 @@ func TestStorage(t *testing.T) {
 			user, err := storage.GetUserById(ctx, "test user id")
 			assert.NilError(t, err)
 			assert.DeepEqual(t, user, &User{
-				UserId:          "test user id",
-				Nickname:        "new test nickname",
-				Color:           ColorGreen,
-				CreatedAt:       now,
-				DeviceTokenType: DeviceTokenTypeProduction,
-				LastIp:          "last ip 3",
-				Type:            UserTypeActive,
-				Elo:             1000,
+				UserId:    "test user id",
+				Nickname:  "new test nickname",
+				Color:     ColorGreen,
+				CreatedAt: now,
+				LastIp:    "last ip 3",
+				Type:      UserTypeActive,
+				Elo:       1000,
+				Glicko:    initialGlicko,
 			})
 		})
 
@@ -375,12 +978,12 @@ func TestStorage(t *testing.T) {
 			got, err := storage.GetUserByNickname(ctx, "second test user nickname")
 			assert.NilError(t, err)
 			assert.DeepEqual(t, got, &User{
-				UserId:          "second test user id",
-				Nickname:        "second test user nickname",
-				CreatedAt:       now2,
-				DeviceTokenType: DeviceTokenTypeProduction,
-				Type:            UserTypeBlank,
-				Elo:             1000,
+				UserId:    "second test user id",
+				Nickname:  "second test user nickname",
+				CreatedAt: now2,
+				Type:      UserTypeBlank,
+				Elo:       1000,
+				Glicko:    initialGlicko,
 			})
 		})
 
@@ -396,13 +999,90 @@ func TestStorage(t *testing.T) {
 			})
 			assert.NilError(t, err)
 
-			err = storage.UpdateDeviceToken(ctx, "device token user", []byte("device token"), DeviceTokenTypeSandbox)
+			err = storage.UpsertUser(ctx, UpsertUser{
+				UserId:   "device token user 2",
+				Nickname: "device token user 2 nickname",
+			})
+			assert.NilError(t, err)
+
+			_, err = storage.GetDevices(ctx, "device token user")
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			storage.nowFunc = func() time.Time { return now }
+			err = storage.UpdateDeviceToken(ctx, UpdateDeviceToken{
+				UserId:      "device token user",
+				Token:       []byte("phone token"),
+				Platform:    DevicePlatformIOS,
+				Environment: DeviceTokenTypeSandbox,
+				AppVersion:  "1.0",
+			})
+			assert.NilError(t, err)
+			storage.nowFunc = func() time.Time { return now.Add(time.Minute) }
+			err = storage.UpdateDeviceToken(ctx, UpdateDeviceToken{
+				UserId:      "device token user",
+				Token:       []byte("tablet token"),
+				Platform:    DevicePlatformAndroid,
+				Environment: DeviceTokenTypeProduction,
+				AppVersion:  "1.1",
+			})
+			assert.NilError(t, err)
+
+			got, err := storage.GetDevices(ctx, "device token user")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, []Device{
+				{
+					Token:       []byte("tablet token"),
+					UserId:      "device token user",
+					Platform:    DevicePlatformAndroid,
+					Environment: DeviceTokenTypeProduction,
+					AppVersion:  "1.1",
+					LastSeenAt:  now.Add(time.Minute),
+				},
+				{
+					Token:       []byte("phone token"),
+					UserId:      "device token user",
+					Platform:    DevicePlatformIOS,
+					Environment: DeviceTokenTypeSandbox,
+					AppVersion:  "1.0",
+					LastSeenAt:  now,
+				},
+			})
+
+			// The same device logs in as another user
+			err = storage.UpdateDeviceToken(ctx, UpdateDeviceToken{
+				UserId:      "device token user 2",
+				Token:       []byte("tablet token"),
+				Platform:    DevicePlatformAndroid,
+				Environment: DeviceTokenTypeProduction,
+				AppVersion:  "1.2",
+			})
+			assert.NilError(t, err)
+
+			got, err = storage.GetDevices(ctx, "device token user")
 			assert.NilError(t, err)
+			assert.Equal(t, len(got), 1)
+			assert.DeepEqual(t, got[0].Token, []byte("phone token"))
 
-			got, err := storage.GetUserById(ctx, "device token user")
+			got, err = storage.GetDevices(ctx, "device token user 2")
 			assert.NilError(t, err)
-			assert.DeepEqual(t, got.DeviceToken, []byte("device token"))
-			assert.Equal(t, got.DeviceTokenType, DeviceTokenTypeSandbox)
+			assert.Equal(t, len(got), 1)
+			assert.DeepEqual(t, got[0].Token, []byte("tablet token"))
+			assert.Equal(t, got[0].AppVersion, "1.2")
+
+			// The previous release reads the last device from manager_users
+			legacyToken := func(userId string) []byte {
+				var token []byte
+				err := storage.db.QueryRowContext(ctx,
+					"SELECT device_token FROM manager_users WHERE id = $1", userId).Scan(&token)
+				assert.NilError(t, err)
+				return token
+			}
+			assert.Assert(t, legacyToken("device token user") == nil)
+			assert.DeepEqual(t, legacyToken("device token user 2"), []byte("tablet token"))
+
+			err = storage.DeleteDevice(ctx, "device token user 2", []byte("tablet token"))
+			assert.NilError(t, err)
+			assert.Assert(t, legacyToken("device token user 2") == nil)
 		})
 	})
 
@@ -437,55 +1117,55 @@ func TestStorage(t *testing.T) {
 				name:   "success - exact",
 				search: SearchUsers{Search: "prefix middle nickname suffix", Limit: 5, Type: UserTypeBlank},
 				want: []*User{
-					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
 				name:   "success - prefix",
 				search: SearchUsers{Search: "prefix", Limit: 5, Type: UserTypeBlank},
 				want: []*User{
-					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000},
-					{UserId: "search 2", Nickname: "prefix middlenick surname", CreatedAt: searchNow, Elo: 1000},
-					{UserId: "search 3", Nickname: "prefix nsuffix", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
+					{UserId: "search 2", Nickname: "prefix middlenick surname", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
+					{UserId: "search 3", Nickname: "prefix nsuffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
 				name:   "success - suffix",
 				search: SearchUsers{Search: "suffix", Limit: 5, Type: UserTypeBlank},
 				want: []*User{
-					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000},
-					{UserId: "search 3", Nickname: "prefix nsuffix", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
+					{UserId: "search 3", Nickname: "prefix nsuffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
 				name:   "success - middle",
 				search: SearchUsers{Search: "middle", Limit: 5, Type: UserTypeBlank},
 				want: []*User{
-					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000},
-					{UserId: "search 2", Nickname: "prefix middlenick surname", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
+					{UserId: "search 2", Nickname: "prefix middlenick surname", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
 				name:   "success - middle and active type",
 				search: SearchUsers{Search: "middle", Limit: 5, Type: UserTypeActive},
 				want: []*User{
-					{UserId: "search 4", Nickname: "free middle nickname", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 4", Nickname: "free middle nickname", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
 				name:   "success - limit",
 				search: SearchUsers{Search: "prefix", Limit: 2, Type: UserTypeBlank},
 				want: []*User{
-					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000},
-					{UserId: "search 2", Nickname: "prefix middlenick surname", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
+					{UserId: "search 2", Nickname: "prefix middlenick surname", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
 				name:   "success - exclude",
 				search: SearchUsers{Search: "prefix", Limit: 5, ExcludedUserId: "search 2", Type: UserTypeBlank},
 				want: []*User{
-					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000},
-					{UserId: "search 3", Nickname: "prefix nsuffix", CreatedAt: searchNow, Elo: 1000},
+					{UserId: "search 1", Nickname: "prefix middle nickname suffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
+					{UserId: "search 3", Nickname: "prefix nsuffix", CreatedAt: searchNow, Elo: 1000, Glicko: initialGlicko},
 				},
 			},
 			{
@@ -614,7 +1294,7 @@ func TestStorage(t *testing.T) {
 		storage.nowFunc = func() time.Time { return gameNow }
 		buffer := 2 * time.Minute
 
-		_, err := storage.GetActiveUsers(ctx, buffer)
+		_, err := storage.GetActiveUsers(ctx, buffer, 0)
 		assert.ErrorIs(t, err, ErrNotFound)
 
 		for _, u := range []UpsertUser{
@@ -660,7 +1340,7 @@ func TestStorage(t *testing.T) {
 			assert.NilError(t, err)
 		}
 
-		got, err := storage.GetActiveUsers(ctx, buffer)
+		got, err := storage.GetActiveUsers(ctx, buffer, 0)
 		assert.NilError(t, err)
 		assert.DeepEqual(t, got, []string{
 			"active_user1", "active_user2", "active_user3",
@@ -676,6 +1356,26 @@ func TestStorage(t *testing.T) {
 			err := storage.UpsertUser(ctx, u)
 			assert.NilError(t, err)
 		}
+		for _, token := range []string{"phone token", "tablet token"} {
+			err := storage.UpdateDeviceToken(ctx, UpdateDeviceToken{
+				UserId:      "notification_user1",
+				Token:       []byte("notification " + token),
+				Platform:    DevicePlatformIOS,
+				Environment: DeviceTokenTypeProduction,
+			})
+			assert.NilError(t, err)
+		}
+		phone := Device{
+			Token:       []byte("notification phone token"),
+			UserId:      "notification_user1",
+			Platform:    DevicePlatformIOS,
+			Environment: DeviceTokenTypeProduction,
+			LastSeenAt:  now,
+		}
+		tablet := phone
+		tablet.Token = []byte("notification tablet token")
+		sandboxPhone := phone
+		sandboxPhone.Environment = DeviceTokenTypeSandbox
 
 		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 		defer cancel()
@@ -692,13 +1392,12 @@ func TestStorage(t *testing.T) {
 			err := storage.UpdateSentNotification(ctx, "notification_user1",
 				func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
 					assert.DeepEqual(t, UserNotificationState{
-						DeviceToken:      []byte("some token"),
-						DeviceTokenType:  DeviceTokenTypeProduction,
+						Devices:          []Device{phone, tablet},
 						SentNotification: SentNotification{ActiveGames: 2},
 					}, state)
+					// Phone moves to sandbox, tablet is removed
 					return UserNotificationState{
-						DeviceToken:      []byte("some token"),
-						DeviceTokenType:  DeviceTokenTypeSandbox,
+						Devices:          []Device{sandboxPhone},
 						SentNotification: SentNotification{ActiveGames: 3},
 					}, nil
 				})
@@ -708,11 +1407,8 @@ func TestStorage(t *testing.T) {
 		err := storage.UpdateSentNotification(ctx, "notification_user1",
 			func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
 				wait <- struct{}{}
-				return UserNotificationState{
-					DeviceToken:      []byte("some token"),
-					DeviceTokenType:  DeviceTokenTypeProduction,
-					SentNotification: SentNotification{ActiveGames: 2},
-				}, nil
+				state.SentNotification = SentNotification{ActiveGames: 2}
+				return state, nil
 			})
 		assert.NilError(t, err)
 		err = <-backgroundErr
@@ -721,20 +1417,220 @@ func TestStorage(t *testing.T) {
 		err = storage.UpdateSentNotification(ctx, "notification_user1",
 			func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
 				assert.DeepEqual(t, UserNotificationState{
-					DeviceToken:      []byte("some token"),
-					DeviceTokenType:  DeviceTokenTypeSandbox,
+					Devices:          []Device{sandboxPhone},
 					SentNotification: SentNotification{ActiveGames: 3},
 				}, state)
 				return state, nil
 			})
 		assert.NilError(t, err)
+
+		t.Run("notification settings", func(t *testing.T) {
+			got, err := storage.GetNotificationSettings(ctx, "notification_user1")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, NotificationSettings{})
+
+			settings := NotificationSettings{
+				DisabledPlatforms: []DevicePlatform{DevicePlatformWeb},
+				QuietHours:        &QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60},
+				TimeZone:          "Europe/Berlin",
+				BadgeOnly:         true,
+				MinDelay:          15 * time.Minute,
+			}
+			err = storage.UpdateNotificationSettings(ctx, "notification_user1", settings)
+			assert.NilError(t, err)
+
+			got, err = storage.GetNotificationSettings(ctx, "notification_user1")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, settings)
+
+			err = storage.UpdateNotificationSettings(ctx, "unknown user", settings)
+			assert.ErrorIs(t, err, ErrNotFound)
+			_, err = storage.GetNotificationSettings(ctx, "unknown user")
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+
+		t.Run("deferred notification", func(t *testing.T) {
+			deferredUntil := now.Add(time.Hour)
+			err := storage.UpdateSentNotification(ctx, "notification_user1",
+				func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
+					assert.Assert(t, state.DeferredUntil == nil)
+					assert.Equal(t, state.Settings.TimeZone, "Europe/Berlin")
+					state.DeferredUntil = &deferredUntil
+					return state, nil
+				})
+			assert.NilError(t, err)
+
+			// Not due yet
+			users, _ := storage.GetActiveUsers(ctx, 0, 0)
+			assert.Assert(t, !slices.Contains(users, "notification_user1"))
+
+			storage.nowFunc = func() time.Time { return deferredUntil }
+			users, err = storage.GetActiveUsers(ctx, 0, 0)
+			assert.NilError(t, err)
+			assert.Assert(t, slices.Contains(users, "notification_user1"))
+
+			err = storage.UpdateSentNotification(ctx, "notification_user1",
+				func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
+					assert.Assert(t, state.DeferredUntil != nil)
+					assert.Assert(t, state.DeferredUntil.Equal(deferredUntil))
+					state.DeferredUntil = nil
+					return state, nil
+				})
+			assert.NilError(t, err)
+			storage.nowFunc = func() time.Time { return now }
+		})
+
+		t.Run("outbox", func(t *testing.T) {
+			payload := PushPayload{Title: "Mars awaits you!", Badge: 3, Sound: "default", URL: "https://mars/player"}
+			err := storage.UpdateSentNotification(ctx, "notification_user1",
+				func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
+					state.Outbox = []OutboxMessage{{Token: sandboxPhone.Token, Payload: payload}}
+					return state, nil
+				})
+			assert.NilError(t, err)
+
+			got, err := storage.ClaimOutbox(ctx, 10, time.Minute)
+			assert.NilError(t, err)
+			assert.Equal(t, len(got), 1)
+			assert.DeepEqual(t, got[0], &OutboxMessage{
+				Id:        got[0].Id,
+				UserId:    "notification_user1",
+				Token:     sandboxPhone.Token,
+				Payload:   payload,
+				Status:    OutboxStatusPending,
+				CreatedAt: now,
+				Device:    &sandboxPhone,
+			})
+
+			_, err = storage.GetDeliveryAttempts(ctx, "notification_user1", 10)
+			assert.ErrorIs(t, err, ErrNotFound)
+			attempt := DeliveryAttempt{
+				OutboxId:    got[0].Id,
+				UserId:      "notification_user1",
+				Token:       sandboxPhone.Token,
+				Platform:    DevicePlatformIOS,
+				Environment: DeviceTokenTypeSandbox,
+				PayloadHash: "0123456789abcdef",
+				MessageId:   "apns id",
+				Status:      503,
+				Error:       "status 503",
+				Latency:     150 * time.Millisecond,
+			}
+			err = storage.InsertDeliveryAttempt(ctx, attempt)
+			assert.NilError(t, err)
+			attempts, err := storage.GetDeliveryAttempts(ctx, "notification_user1", 10)
+			assert.NilError(t, err)
+			assert.Equal(t, len(attempts), 1)
+			attempt.Id = attempts[0].Id
+			attempt.CreatedAt = now
+			assert.DeepEqual(t, attempts[0], &attempt)
+
+			// Attempts are kept until the retention window passes
+			_, err = storage.PruneDeliveryAttempts(ctx, now)
+			assert.NilError(t, err)
+			attempts, err = storage.GetDeliveryAttempts(ctx, "notification_user1", 10)
+			assert.NilError(t, err)
+			assert.Equal(t, len(attempts), 1)
+
+			// Leased by the previous claim
+			_, err = storage.ClaimOutbox(ctx, 10, time.Minute)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			err = storage.UpdateOutboxMessage(ctx, UpdateOutboxMessage{
+				Id:            got[0].Id,
+				Status:        OutboxStatusPending,
+				NextAttemptAt: now,
+				LastError:     "status 503",
+			})
+			assert.NilError(t, err)
+			retried, err := storage.ClaimOutbox(ctx, 10, time.Minute)
+			assert.NilError(t, err)
+			assert.Equal(t, len(retried), 1)
+			assert.Equal(t, retried[0].Attempts, 1)
+			assert.Equal(t, retried[0].LastError, "status 503")
+
+			err = storage.UpdateOutboxMessage(ctx, UpdateOutboxMessage{
+				Id:            got[0].Id,
+				Status:        OutboxStatusDelivered,
+				NextAttemptAt: now,
+			})
+			assert.NilError(t, err)
+			_, err = storage.ClaimOutbox(ctx, 10, 0)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			// Deferred messages are not claimed before they are due
+			err = storage.UpdateSentNotification(ctx, "notification_user1",
+				func(ctx context.Context, state UserNotificationState) (UserNotificationState, error) {
+					state.Outbox = []OutboxMessage{{Token: sandboxPhone.Token, Payload: payload, NotBefore: now.Add(time.Hour)}}
+					return state, nil
+				})
+			assert.NilError(t, err)
+			_, err = storage.ClaimOutbox(ctx, 10, 0)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			storage.nowFunc = func() time.Time { return now.Add(time.Hour) }
+			deferred, err := storage.ClaimOutbox(ctx, 10, 0)
+			assert.NilError(t, err)
+			assert.Equal(t, len(deferred), 1)
+			err = storage.InsertDeliveryAttempt(ctx, DeliveryAttempt{
+				OutboxId:    deferred[0].Id,
+				UserId:      "notification_user1",
+				Token:       sandboxPhone.Token,
+				Platform:    DevicePlatformIOS,
+				Environment: DeviceTokenTypeSandbox,
+				Status:      200,
+			})
+			assert.NilError(t, err)
+			err = storage.UpdateOutboxMessage(ctx, UpdateOutboxMessage{
+				Id:            deferred[0].Id,
+				Status:        OutboxStatusDelivered,
+				NextAttemptAt: now,
+			})
+			assert.NilError(t, err)
+			storage.nowFunc = func() time.Time { return now }
+
+			// Only the message delivered before the retention window is pruned, along with its attempts
+			pruned, err := storage.PruneOutbox(ctx, now.Add(time.Minute))
+			assert.NilError(t, err)
+			assert.Assert(t, pruned >= 1)
+			attempts, err = storage.GetDeliveryAttempts(ctx, "notification_user1", 10)
+			assert.NilError(t, err)
+			assert.Equal(t, len(attempts), 1)
+			assert.Equal(t, attempts[0].OutboxId, deferred[0].Id)
+			pruned, err = storage.PruneOutbox(ctx, now.Add(time.Minute))
+			assert.NilError(t, err)
+			assert.Equal(t, pruned, int64(0))
+
+			pruned, err = storage.PruneDeliveryAttempts(ctx, now.Add(2*time.Hour))
+			assert.NilError(t, err)
+			assert.Assert(t, pruned >= 1)
+			_, err = storage.GetDeliveryAttempts(ctx, "notification_user1", 10)
+			assert.ErrorIs(t, err, ErrNotFound)
+			pruned, err = storage.PruneOutbox(ctx, now.Add(2*time.Hour))
+			assert.NilError(t, err)
+			assert.Assert(t, pruned >= 1)
+		})
+
+		t.Run("unregistered device", func(t *testing.T) {
+			// Registered again after the token was reported unregistered
+			err := storage.DeleteUnregisteredDevice(ctx, "notification_user1", sandboxPhone.Token, now.Add(-time.Minute))
+			assert.NilError(t, err)
+			devices, err := storage.GetDevices(ctx, "notification_user1")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, devices, []Device{sandboxPhone})
+
+			err = storage.DeleteUnregisteredDevice(ctx, "notification_user1", sandboxPhone.Token, now)
+			assert.NilError(t, err)
+			_, err = storage.GetDevices(ctx, "notification_user1")
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
 	})
 
 	t.Run("GetActiveGames", func(t *testing.T) {
 		now := time.Now().Truncate(time.Second).Add(4 * time.Hour) // 4 Hours added to avoid querying other games
 		storage.nowFunc = func() time.Time { return now }
 
-		_, err := storage.GetActiveGames(ctx)
+		_, err := storage.GetActiveGames(ctx, 0)
 		assert.ErrorIs(t, err, ErrNotFound)
 
 		for _, u := range []UpsertUser{
@@ -780,7 +1676,7 @@ func TestStorage(t *testing.T) {
 			assert.NilError(t, err)
 		}
 
-		got, err := storage.GetActiveGames(ctx)
+		got, err := storage.GetActiveGames(ctx, 0)
 		assert.NilError(t, err)
 		assert.DeepEqual(t, got, []*Game{
 			{
@@ -788,12 +1684,20 @@ func TestStorage(t *testing.T) {
 				SpectatorId: "sgag1",
 				CreatedAt:   now,
 				ExpiresAt:   now.Add(time.Hour),
+				Players: []Player{
+					{UserId: "active_game_user1", PlayerId: "agagp1_1", Color: ColorBlue},
+					{UserId: "active_game_user2", PlayerId: "agagp1_2", Color: ColorRed},
+				},
 			},
 			{
 				GameId:      "gag2",
 				SpectatorId: "sgag2",
 				CreatedAt:   now,
 				ExpiresAt:   now.Add(time.Hour),
+				Players: []Player{
+					{UserId: "active_game_user3", PlayerId: "agagp2_3", Color: ColorBlue},
+					{UserId: "active_game_user2", PlayerId: "agagp2_2", Color: ColorRed},
+				},
 			},
 		})
 
@@ -802,7 +1706,7 @@ func TestStorage(t *testing.T) {
 			storage.nowFunc = func() time.Time { return updateNow }
 
 			// 3 players still have active games
-			u, err := storage.GetActiveUsers(ctx, 0)
+			u, err := storage.GetActiveUsers(ctx, 0, 0)
 			assert.NilError(t, err)
 			assert.DeepEqual(t, u, []string{
 				"active_game_user1", "active_game_user2", "active_game_user3",
@@ -813,7 +1717,7 @@ func TestStorage(t *testing.T) {
 			}})
 			assert.NilError(t, err)
 
-			got, err := storage.GetActiveGames(ctx)
+			got, err := storage.GetActiveGames(ctx, 0)
 			assert.NilError(t, err)
 			assert.DeepEqual(t, got, []*Game{
 				{
@@ -821,23 +1725,139 @@ func TestStorage(t *testing.T) {
 					SpectatorId: "sgag2",
 					CreatedAt:   now,
 					ExpiresAt:   now.Add(time.Hour),
+					Players: []Player{
+						{UserId: "active_game_user3", PlayerId: "agagp2_3", Color: ColorBlue},
+						{UserId: "active_game_user2", PlayerId: "agagp2_2", Color: ColorRed},
+					},
 				},
 			})
 
 			// After 5 minutes 3 players have active games with the buffer of 10 minutes
 			updateNow = updateNow.Add(5 * time.Minute)
-			u, err = storage.GetActiveUsers(ctx, 10*time.Minute)
+			u, err = storage.GetActiveUsers(ctx, 10*time.Minute, 0)
 			assert.NilError(t, err)
 			assert.DeepEqual(t, u, []string{
 				"active_game_user1", "active_game_user2", "active_game_user3",
 			})
 
 			// Only 2 have games with a small buffer
-			u, err = storage.GetActiveUsers(ctx, time.Minute)
+			u, err = storage.GetActiveUsers(ctx, time.Minute, 0)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, u, []string{
+				"active_game_user2", "active_game_user3",
+			})
+		})
+
+		t.Run("UpdateGameState", func(t *testing.T) {
+			stateNow := now.Add(40 * time.Minute)
+			storage.nowFunc = func() time.Time { return stateNow }
+
+			err := storage.UpdateGameState(ctx, "gag2", GameState{
+				WaitingFor:   []Color{ColorRed},
+				Phase:        "action",
+				Generation:   3,
+				PlayersCount: 2,
+				Players: []GameStatePlayer{
+					{Name: "user3", Color: ColorBlue},
+					{Name: "user2", Color: ColorRed},
+				},
+			})
+			assert.NilError(t, err)
+
+			// The state is fresh, so nothing to scan
+			_, err = storage.GetActiveGames(ctx, 10*time.Minute)
+			assert.ErrorIs(t, err, ErrNotFound)
+			_, err = storage.GetActiveUsers(ctx, time.Minute, 10*time.Minute)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			stateNow = stateNow.Add(15 * time.Minute)
+			got, err := storage.GetActiveGames(ctx, 10*time.Minute)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, []*Game{
+				{
+					GameId:      "gag2",
+					SpectatorId: "sgag2",
+					CreatedAt:   now,
+					ExpiresAt:   now.Add(time.Hour),
+					Players: []Player{
+						{UserId: "active_game_user3", PlayerId: "agagp2_3", Color: ColorBlue},
+						{UserId: "active_game_user2", PlayerId: "agagp2_2", Color: ColorRed},
+					},
+					State: &GameState{
+						WaitingFor:   []Color{ColorRed},
+						Phase:        "action",
+						Generation:   3,
+						PlayersCount: 2,
+						Players: []GameStatePlayer{
+							{Name: "user3", Color: ColorBlue},
+							{Name: "user2", Color: ColorRed},
+						},
+						UpdatedAt: now.Add(40 * time.Minute),
+					},
+				},
+			})
+
+			u, err := storage.GetActiveUsers(ctx, time.Minute, 10*time.Minute)
 			assert.NilError(t, err)
 			assert.DeepEqual(t, u, []string{
 				"active_game_user2", "active_game_user3",
 			})
+
+			t.Run("reminders", func(t *testing.T) {
+				waitingSince := now.Add(40 * time.Minute)
+				_, err := storage.GetWaitingPlayers(ctx, waitingSince.Add(-time.Second), 3)
+				assert.ErrorIs(t, err, ErrNotFound)
+
+				got, err := storage.GetWaitingPlayers(ctx, waitingSince, 3)
+				assert.NilError(t, err)
+				assert.DeepEqual(t, got, []*WaitingPlayer{
+					{GameId: "gag2", UserId: "active_game_user2", PlayerId: "agagp2_2", WaitingSince: waitingSince},
+				})
+
+				// Still waiting, the wait continues
+				err = storage.UpdateGameState(ctx, "gag2", GameState{
+					WaitingFor:   []Color{ColorRed, ColorBlue},
+					Phase:        "research",
+					PlayersCount: 2,
+				})
+				assert.NilError(t, err)
+				err = storage.UpdateRemindersSent(ctx, "agagp2_2", waitingSince, 1)
+				assert.NilError(t, err)
+
+				got, err = storage.GetWaitingPlayers(ctx, stateNow, 3)
+				assert.NilError(t, err)
+				assert.DeepEqual(t, got, []*WaitingPlayer{
+					{GameId: "gag2", UserId: "active_game_user2", PlayerId: "agagp2_2", WaitingSince: waitingSince,
+						RemindersSent: 1},
+					{GameId: "gag2", UserId: "active_game_user3", PlayerId: "agagp2_3", WaitingSince: stateNow},
+				})
+				_, err = storage.GetWaitingPlayers(ctx, waitingSince, 1)
+				assert.ErrorIs(t, err, ErrNotFound)
+
+				// The wait is over, reminders start over
+				err = storage.UpdateGameState(ctx, "gag2", GameState{
+					WaitingFor:   []Color{ColorBlue},
+					Phase:        "action",
+					PlayersCount: 2,
+				})
+				assert.NilError(t, err)
+				got, err = storage.GetWaitingPlayers(ctx, stateNow, 3)
+				assert.NilError(t, err)
+				assert.DeepEqual(t, got, []*WaitingPlayer{
+					{GameId: "gag2", UserId: "active_game_user3", PlayerId: "agagp2_3", WaitingSince: stateNow},
+				})
+			})
+
+			t.Run("nudge", func(t *testing.T) {
+				err := storage.UpdateGameNudged(ctx, "gag2", time.Hour)
+				assert.NilError(t, err)
+				err = storage.UpdateGameNudged(ctx, "gag2", time.Hour)
+				assert.ErrorIs(t, err, ErrRateLimited)
+
+				stateNow = stateNow.Add(time.Hour)
+				err = storage.UpdateGameNudged(ctx, "gag2", time.Hour)
+				assert.NilError(t, err)
+			})
 		})
 	})
 
@@ -873,7 +1893,7 @@ func TestStorage(t *testing.T) {
 		}})
 		assert.NilError(t, err)
 
-		err = storage.UpdateElo(ctx, func(ctx context.Context, state EloUpdateState) (EloResults, error) {
+		err = storage.UpdateElo(ctx, func(ctx context.Context, state EloUpdateState) (RatingResults, error) {
 			assert.DeepEqual(t, EloUpdateState{
 				Game: Game{
 					GameId:      "update elo 1",
@@ -889,45 +1909,135 @@ func TestStorage(t *testing.T) {
 					GameResults: &GameResults{Raw: map[string]any{
 						"some data": float64(42),
 					}},
+					FinishedAt: &now,
 				},
 				Users: []EloStateUser{
-					{UserId: "update elo 1", Elo: 1000},
-					{UserId: "update elo 2", Elo: 1000},
-					{UserId: "update elo 3", Elo: 1000},
-					{UserId: "update elo 4", Elo: 1000},
+					{UserId: "update elo 1", Elo: 1000, Glicko: initialGlicko},
+					{UserId: "update elo 2", Elo: 1000, Glicko: initialGlicko},
+					{UserId: "update elo 3", Elo: 1000, Glicko: initialGlicko},
+					{UserId: "update elo 4", Elo: 1000, Glicko: initialGlicko},
 				},
 			}, state)
-			return EloResults{
-				Players: []EloResultsPlayer{
-					{UserId: "update elo 1", PlayerId: "update elo player 1 1", OldElo: 1000, NewElo: 1010},
-					{UserId: "update elo 2", PlayerId: "update elo player 1 2", OldElo: 1000, NewElo: 1000},
-					{UserId: "update elo 3", PlayerId: "update elo player 1 3", OldElo: 1000, NewElo: 985},
-					{UserId: "update elo 4", PlayerId: "update elo player 1 4", OldElo: 1000, NewElo: 1024},
+			return RatingResults{
+				Elo: EloResults{
+					Players: []EloResultsPlayer{
+						{UserId: "update elo 1", PlayerId: "update elo player 1 1", OldElo: 1000, NewElo: 1010},
+						{UserId: "update elo 2", PlayerId: "update elo player 1 2", OldElo: 1000, NewElo: 1000},
+						{UserId: "update elo 3", PlayerId: "update elo player 1 3", OldElo: 1000, NewElo: 985},
+						{UserId: "update elo 4", PlayerId: "update elo player 1 4", OldElo: 1000, NewElo: 1024},
+					},
+				},
+				Glicko: GlickoResults{
+					Players: []GlickoResultsPlayer{
+						{UserId: "update elo 1", PlayerId: "update elo player 1 1", OldGlicko: initialGlicko,
+							NewGlicko: Glicko{Rating: 1600, Deviation: 200, Volatility: 0.06}},
+						{UserId: "update elo 2", PlayerId: "update elo player 1 2", OldGlicko: initialGlicko,
+							NewGlicko: Glicko{Rating: 1500, Deviation: 200, Volatility: 0.06}},
+						{UserId: "update elo 3", PlayerId: "update elo player 1 3", OldGlicko: initialGlicko,
+							NewGlicko: Glicko{Rating: 1400, Deviation: 200, Volatility: 0.06}},
+						{UserId: "update elo 4", PlayerId: "update elo player 1 4", OldGlicko: initialGlicko,
+							NewGlicko: Glicko{Rating: 1700, Deviation: 200, Volatility: 0.06}},
+					},
 				},
+				Buckets: []string{"players:4"},
 			}, nil
 		})
 		assert.NilError(t, err)
 
 		for _, u := range []struct {
-			userId      string
-			expectedElo int64
+			userId         string
+			expectedElo    int64
+			expectedGlicko Glicko
 		}{
-			{userId: "update elo 1", expectedElo: 1010},
-			{userId: "update elo 2", expectedElo: 1000},
-			{userId: "update elo 3", expectedElo: 985},
-			{userId: "update elo 4", expectedElo: 1024},
+			{userId: "update elo 1", expectedElo: 1010, expectedGlicko: Glicko{Rating: 1600, Deviation: 200, Volatility: 0.06}},
+			{userId: "update elo 2", expectedElo: 1000, expectedGlicko: Glicko{Rating: 1500, Deviation: 200, Volatility: 0.06}},
+			{userId: "update elo 3", expectedElo: 985, expectedGlicko: Glicko{Rating: 1400, Deviation: 200, Volatility: 0.06}},
+			{userId: "update elo 4", expectedElo: 1024, expectedGlicko: Glicko{Rating: 1700, Deviation: 200, Volatility: 0.06}},
 		} {
 			got, err := storage.GetUserById(ctx, u.userId)
 			assert.NilError(t, err)
 			assert.Equal(t, u.expectedElo, got.Elo)
+			assert.Equal(t, u.expectedGlicko, got.Glicko)
 		}
 
 		t.Run("leaderboard", func(t *testing.T) {
-			got, err := storage.GetLeaderboard(ctx, UserTypeBlank, 2)
+			got, err := storage.GetLeaderboard(ctx, GetLeaderboard{Type: UserTypeBlank, System: RatingSystemElo, Limit: 2})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, []*User{
+				{UserId: "update elo 4", Nickname: "update elo player 4", CreatedAt: now, Elo: 1024,
+					Glicko: Glicko{Rating: 1700, Deviation: 200, Volatility: 0.06}},
+				{UserId: "update elo 1", Nickname: "update elo player 1", CreatedAt: now, Elo: 1010,
+					Glicko: Glicko{Rating: 1600, Deviation: 200, Volatility: 0.06}},
+			}, got)
+		})
+
+		t.Run("announce results", func(t *testing.T) {
+			got, err := storage.GetUnannouncedGames(ctx)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, []string{"update elo 1"})
+
+			err = storage.UpdateGameAnnounced(ctx, "update elo 1")
+			assert.NilError(t, err)
+
+			_, err = storage.GetUnannouncedGames(ctx)
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+
+		t.Run("rating history", func(t *testing.T) {
+			got, err := storage.GetRatingHistory(ctx, GetRatingHistory{UserId: "update elo 3", Limit: 10})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, []*RatingHistory{
+				{
+					UserId:    "update elo 3",
+					GameId:    "update elo 1",
+					OldElo:    1000,
+					NewElo:    985,
+					OldGlicko: initialGlicko,
+					NewGlicko: Glicko{Rating: 1400, Deviation: 200, Volatility: 0.06},
+					CreatedAt: now,
+				},
+			}, got)
+
+			_, err = storage.GetRatingHistory(ctx, GetRatingHistory{
+				UserId: "update elo 3",
+				Before: &RatingHistoryCursor{CreatedAt: now, GameId: "update elo 1"},
+				Limit:  10,
+			})
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+
+		t.Run("bucket leaderboard", func(t *testing.T) {
+			got, err := storage.GetLeaderboard(ctx, GetLeaderboard{
+				Type:   UserTypeBlank,
+				System: RatingSystemElo,
+				Bucket: "players:4",
+				Limit:  2,
+			})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, []*User{
+				{UserId: "update elo 4", Nickname: "update elo player 4", CreatedAt: now, Elo: 1024,
+					Glicko: Glicko{Rating: 1700, Deviation: 200, Volatility: 0.06}},
+				{UserId: "update elo 1", Nickname: "update elo player 1", CreatedAt: now, Elo: 1010,
+					Glicko: Glicko{Rating: 1600, Deviation: 200, Volatility: 0.06}},
+			}, got)
+
+			_, err = storage.GetLeaderboard(ctx, GetLeaderboard{
+				Type:   UserTypeBlank,
+				System: RatingSystemElo,
+				Bucket: "players:2",
+				Limit:  2,
+			})
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+
+		t.Run("glicko leaderboard", func(t *testing.T) {
+			got, err := storage.GetLeaderboard(ctx, GetLeaderboard{Type: UserTypeBlank, System: RatingSystemGlicko2, Limit: 2})
 			assert.NilError(t, err)
 			assert.DeepEqual(t, []*User{
-				{UserId: "update elo 4", Nickname: "update elo player 4", CreatedAt: now, Elo: 1024},
-				{UserId: "update elo 1", Nickname: "update elo player 1", CreatedAt: now, Elo: 1010},
+				{UserId: "update elo 4", Nickname: "update elo player 4", CreatedAt: now, Elo: 1024,
+					Glicko: Glicko{Rating: 1700, Deviation: 200, Volatility: 0.06}},
+				{UserId: "update elo 1", Nickname: "update elo player 1", CreatedAt: now, Elo: 1010,
+					Glicko: Glicko{Rating: 1600, Deviation: 200, Volatility: 0.06}},
 			}, got)
 		})
 	})
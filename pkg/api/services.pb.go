@@ -14,6 +14,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -72,7 +73,7 @@ func (x CreateGameV2_Board) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CreateGameV2_Board.Descriptor instead.
 func (CreateGameV2_Board) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{7, 0}
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type Login struct {
@@ -227,14 +228,14 @@ func (*UpdateDeviceToken) Descriptor() ([]byte, []int) {
 	return file_pkg_api_services_proto_rawDescGZIP(), []int{3}
 }
 
-type SearchUser struct {
+type RegisterWebPushSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SearchUser) Reset() {
-	*x = SearchUser{}
+func (x *RegisterWebPushSubscription) Reset() {
+	*x = RegisterWebPushSubscription{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,13 +243,13 @@ func (x *SearchUser) Reset() {
 	}
 }
 
-func (x *SearchUser) String() string {
+func (x *RegisterWebPushSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUser) ProtoMessage() {}
+func (*RegisterWebPushSubscription) ProtoMessage() {}
 
-func (x *SearchUser) ProtoReflect() protoreflect.Message {
+func (x *RegisterWebPushSubscription) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -260,19 +261,19 @@ func (x *SearchUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUser.ProtoReflect.Descriptor instead.
-func (*SearchUser) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterWebPushSubscription.ProtoReflect.Descriptor instead.
+func (*RegisterWebPushSubscription) Descriptor() ([]byte, []int) {
 	return file_pkg_api_services_proto_rawDescGZIP(), []int{4}
 }
 
-type GetEloLeaderboard struct {
+type SearchUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetEloLeaderboard) Reset() {
-	*x = GetEloLeaderboard{}
+func (x *SearchUser) Reset() {
+	*x = SearchUser{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,13 +281,13 @@ func (x *GetEloLeaderboard) Reset() {
 	}
 }
 
-func (x *GetEloLeaderboard) String() string {
+func (x *SearchUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEloLeaderboard) ProtoMessage() {}
+func (*SearchUser) ProtoMessage() {}
 
-func (x *GetEloLeaderboard) ProtoReflect() protoreflect.Message {
+func (x *SearchUser) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,19 +299,19 @@ func (x *GetEloLeaderboard) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEloLeaderboard.ProtoReflect.Descriptor instead.
-func (*GetEloLeaderboard) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUser.ProtoReflect.Descriptor instead.
+func (*SearchUser) Descriptor() ([]byte, []int) {
 	return file_pkg_api_services_proto_rawDescGZIP(), []int{5}
 }
 
-type CreateGame struct {
+type GetEloLeaderboard struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateGame) Reset() {
-	*x = CreateGame{}
+func (x *GetEloLeaderboard) Reset() {
+	*x = GetEloLeaderboard{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,13 +319,13 @@ func (x *CreateGame) Reset() {
 	}
 }
 
-func (x *CreateGame) String() string {
+func (x *GetEloLeaderboard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGame) ProtoMessage() {}
+func (*GetEloLeaderboard) ProtoMessage() {}
 
-func (x *CreateGame) ProtoReflect() protoreflect.Message {
+func (x *GetEloLeaderboard) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,19 +337,19 @@ func (x *CreateGame) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGame.ProtoReflect.Descriptor instead.
-func (*CreateGame) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEloLeaderboard.ProtoReflect.Descriptor instead.
+func (*GetEloLeaderboard) Descriptor() ([]byte, []int) {
 	return file_pkg_api_services_proto_rawDescGZIP(), []int{6}
 }
 
-type CreateGameV2 struct {
+type GetRatingHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateGameV2) Reset() {
-	*x = CreateGameV2{}
+func (x *GetRatingHistory) Reset() {
+	*x = GetRatingHistory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -356,13 +357,13 @@ func (x *CreateGameV2) Reset() {
 	}
 }
 
-func (x *CreateGameV2) String() string {
+func (x *GetRatingHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameV2) ProtoMessage() {}
+func (*GetRatingHistory) ProtoMessage() {}
 
-func (x *CreateGameV2) ProtoReflect() protoreflect.Message {
+func (x *GetRatingHistory) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,19 +375,19 @@ func (x *CreateGameV2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameV2.ProtoReflect.Descriptor instead.
-func (*CreateGameV2) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRatingHistory.ProtoReflect.Descriptor instead.
+func (*GetRatingHistory) Descriptor() ([]byte, []int) {
 	return file_pkg_api_services_proto_rawDescGZIP(), []int{7}
 }
 
-type GetGames struct {
+type GetNotificationSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetGames) Reset() {
-	*x = GetGames{}
+func (x *GetNotificationSettings) Reset() {
+	*x = GetNotificationSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,13 +395,13 @@ func (x *GetGames) Reset() {
 	}
 }
 
-func (x *GetGames) String() string {
+func (x *GetNotificationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGames) ProtoMessage() {}
+func (*GetNotificationSettings) ProtoMessage() {}
 
-func (x *GetGames) ProtoReflect() protoreflect.Message {
+func (x *GetNotificationSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -412,19 +413,19 @@ func (x *GetGames) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGames.ProtoReflect.Descriptor instead.
-func (*GetGames) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNotificationSettings.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettings) Descriptor() ([]byte, []int) {
 	return file_pkg_api_services_proto_rawDescGZIP(), []int{8}
 }
 
-type Login_Request struct {
+type UpdateNotificationSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Login_Request) Reset() {
-	*x = Login_Request{}
+func (x *UpdateNotificationSettings) Reset() {
+	*x = UpdateNotificationSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,13 +433,13 @@ func (x *Login_Request) Reset() {
 	}
 }
 
-func (x *Login_Request) String() string {
+func (x *UpdateNotificationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Login_Request) ProtoMessage() {}
+func (*UpdateNotificationSettings) ProtoMessage() {}
 
-func (x *Login_Request) ProtoReflect() protoreflect.Message {
+func (x *UpdateNotificationSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -450,21 +451,19 @@ func (x *Login_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Login_Request.ProtoReflect.Descriptor instead.
-func (*Login_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use UpdateNotificationSettings.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettings) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{9}
 }
 
-type Login_Response struct {
+type CreateGame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *Login_Response) Reset() {
-	*x = Login_Response{}
+func (x *CreateGame) Reset() {
+	*x = CreateGame{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,13 +471,13 @@ func (x *Login_Response) Reset() {
 	}
 }
 
-func (x *Login_Response) String() string {
+func (x *CreateGame) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Login_Response) ProtoMessage() {}
+func (*CreateGame) ProtoMessage() {}
 
-func (x *Login_Response) ProtoReflect() protoreflect.Message {
+func (x *CreateGame) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,26 +489,19 @@ func (x *Login_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Login_Response.ProtoReflect.Descriptor instead.
-func (*Login_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{0, 1}
-}
-
-func (x *Login_Response) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use CreateGame.ProtoReflect.Descriptor instead.
+func (*CreateGame) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{10}
 }
 
-type GetMe_Request struct {
+type CreateGameV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetMe_Request) Reset() {
-	*x = GetMe_Request{}
+func (x *CreateGameV2) Reset() {
+	*x = CreateGameV2{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -517,13 +509,13 @@ func (x *GetMe_Request) Reset() {
 	}
 }
 
-func (x *GetMe_Request) String() string {
+func (x *CreateGameV2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMe_Request) ProtoMessage() {}
+func (*CreateGameV2) ProtoMessage() {}
 
-func (x *GetMe_Request) ProtoReflect() protoreflect.Message {
+func (x *CreateGameV2) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,21 +527,19 @@ func (x *GetMe_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMe_Request.ProtoReflect.Descriptor instead.
-func (*GetMe_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use CreateGameV2.ProtoReflect.Descriptor instead.
+func (*CreateGameV2) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{11}
 }
 
-type GetMe_Response struct {
+type CreateGameV3 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *GetMe_Response) Reset() {
-	*x = GetMe_Response{}
+func (x *CreateGameV3) Reset() {
+	*x = CreateGameV3{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -557,13 +547,13 @@ func (x *GetMe_Response) Reset() {
 	}
 }
 
-func (x *GetMe_Response) String() string {
+func (x *CreateGameV3) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMe_Response) ProtoMessage() {}
+func (*CreateGameV3) ProtoMessage() {}
 
-func (x *GetMe_Response) ProtoReflect() protoreflect.Message {
+func (x *CreateGameV3) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,29 +565,19 @@ func (x *GetMe_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMe_Response.ProtoReflect.Descriptor instead.
-func (*GetMe_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{1, 1}
-}
-
-func (x *GetMe_Response) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use CreateGameV3.ProtoReflect.Descriptor instead.
+func (*CreateGameV3) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{12}
 }
 
-type UpdateMe_Request struct {
+type GetGames struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Nickname string      `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Color    PlayerColor `protobuf:"varint,2,opt,name=color,proto3,enum=api.PlayerColor" json:"color,omitempty"`
 }
 
-func (x *UpdateMe_Request) Reset() {
-	*x = UpdateMe_Request{}
+func (x *GetGames) Reset() {
+	*x = GetGames{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -605,13 +585,13 @@ func (x *UpdateMe_Request) Reset() {
 	}
 }
 
-func (x *UpdateMe_Request) String() string {
+func (x *GetGames) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMe_Request) ProtoMessage() {}
+func (*GetGames) ProtoMessage() {}
 
-func (x *UpdateMe_Request) ProtoReflect() protoreflect.Message {
+func (x *GetGames) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -623,35 +603,19 @@ func (x *UpdateMe_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMe_Request.ProtoReflect.Descriptor instead.
-func (*UpdateMe_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *UpdateMe_Request) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
-}
-
-func (x *UpdateMe_Request) GetColor() PlayerColor {
-	if x != nil {
-		return x.Color
-	}
-	return PlayerColor_BLUE
+// Deprecated: Use GetGames.ProtoReflect.Descriptor instead.
+func (*GetGames) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{13}
 }
 
-type UpdateMe_Response struct {
+type GetGame struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *UpdateMe_Response) Reset() {
-	*x = UpdateMe_Response{}
+func (x *GetGame) Reset() {
+	*x = GetGame{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -659,13 +623,13 @@ func (x *UpdateMe_Response) Reset() {
 	}
 }
 
-func (x *UpdateMe_Response) String() string {
+func (x *GetGame) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMe_Response) ProtoMessage() {}
+func (*GetGame) ProtoMessage() {}
 
-func (x *UpdateMe_Response) ProtoReflect() protoreflect.Message {
+func (x *GetGame) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -677,28 +641,19 @@ func (x *UpdateMe_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMe_Response.ProtoReflect.Descriptor instead.
-func (*UpdateMe_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{2, 1}
-}
-
-func (x *UpdateMe_Response) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use GetGame.ProtoReflect.Descriptor instead.
+func (*GetGame) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{14}
 }
 
-type UpdateDeviceToken_Request struct {
+type GetGameHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DeviceToken []byte `protobuf:"bytes,1,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
 }
 
-func (x *UpdateDeviceToken_Request) Reset() {
-	*x = UpdateDeviceToken_Request{}
+func (x *GetGameHistory) Reset() {
+	*x = GetGameHistory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,13 +661,13 @@ func (x *UpdateDeviceToken_Request) Reset() {
 	}
 }
 
-func (x *UpdateDeviceToken_Request) String() string {
+func (x *GetGameHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeviceToken_Request) ProtoMessage() {}
+func (*GetGameHistory) ProtoMessage() {}
 
-func (x *UpdateDeviceToken_Request) ProtoReflect() protoreflect.Message {
+func (x *GetGameHistory) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,26 +679,19 @@ func (x *UpdateDeviceToken_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeviceToken_Request.ProtoReflect.Descriptor instead.
-func (*UpdateDeviceToken_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{3, 0}
-}
-
-func (x *UpdateDeviceToken_Request) GetDeviceToken() []byte {
-	if x != nil {
-		return x.DeviceToken
-	}
-	return nil
+// Deprecated: Use GetGameHistory.ProtoReflect.Descriptor instead.
+func (*GetGameHistory) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{15}
 }
 
-type UpdateDeviceToken_Response struct {
+type Rematch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateDeviceToken_Response) Reset() {
-	*x = UpdateDeviceToken_Response{}
+func (x *Rematch) Reset() {
+	*x = Rematch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -751,13 +699,13 @@ func (x *UpdateDeviceToken_Response) Reset() {
 	}
 }
 
-func (x *UpdateDeviceToken_Response) String() string {
+func (x *Rematch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDeviceToken_Response) ProtoMessage() {}
+func (*Rematch) ProtoMessage() {}
 
-func (x *UpdateDeviceToken_Response) ProtoReflect() protoreflect.Message {
+func (x *Rematch) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -769,21 +717,19 @@ func (x *UpdateDeviceToken_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDeviceToken_Response.ProtoReflect.Descriptor instead.
-func (*UpdateDeviceToken_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use Rematch.ProtoReflect.Descriptor instead.
+func (*Rematch) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{16}
 }
 
-type SearchUser_Request struct {
+type Nudge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Search string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
 }
 
-func (x *SearchUser_Request) Reset() {
-	*x = SearchUser_Request{}
+func (x *Nudge) Reset() {
+	*x = Nudge{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,13 +737,13 @@ func (x *SearchUser_Request) Reset() {
 	}
 }
 
-func (x *SearchUser_Request) String() string {
+func (x *Nudge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUser_Request) ProtoMessage() {}
+func (*Nudge) ProtoMessage() {}
 
-func (x *SearchUser_Request) ProtoReflect() protoreflect.Message {
+func (x *Nudge) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,28 +755,19 @@ func (x *SearchUser_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUser_Request.ProtoReflect.Descriptor instead.
-func (*SearchUser_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *SearchUser_Request) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
+// Deprecated: Use Nudge.ProtoReflect.Descriptor instead.
+func (*Nudge) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{17}
 }
 
-type SearchUser_Response struct {
+type CreateLobby struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *SearchUser_Response) Reset() {
-	*x = SearchUser_Response{}
+func (x *CreateLobby) Reset() {
+	*x = CreateLobby{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,13 +775,13 @@ func (x *SearchUser_Response) Reset() {
 	}
 }
 
-func (x *SearchUser_Response) String() string {
+func (x *CreateLobby) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUser_Response) ProtoMessage() {}
+func (*CreateLobby) ProtoMessage() {}
 
-func (x *SearchUser_Response) ProtoReflect() protoreflect.Message {
+func (x *CreateLobby) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,26 +793,19 @@ func (x *SearchUser_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUser_Response.ProtoReflect.Descriptor instead.
-func (*SearchUser_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{4, 1}
-}
-
-func (x *SearchUser_Response) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use CreateLobby.ProtoReflect.Descriptor instead.
+func (*CreateLobby) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{18}
 }
 
-type GetEloLeaderboard_Request struct {
+type GetLobbies struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetEloLeaderboard_Request) Reset() {
-	*x = GetEloLeaderboard_Request{}
+func (x *GetLobbies) Reset() {
+	*x = GetLobbies{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -883,13 +813,13 @@ func (x *GetEloLeaderboard_Request) Reset() {
 	}
 }
 
-func (x *GetEloLeaderboard_Request) String() string {
+func (x *GetLobbies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEloLeaderboard_Request) ProtoMessage() {}
+func (*GetLobbies) ProtoMessage() {}
 
-func (x *GetEloLeaderboard_Request) ProtoReflect() protoreflect.Message {
+func (x *GetLobbies) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -901,21 +831,19 @@ func (x *GetEloLeaderboard_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEloLeaderboard_Request.ProtoReflect.Descriptor instead.
-func (*GetEloLeaderboard_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use GetLobbies.ProtoReflect.Descriptor instead.
+func (*GetLobbies) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{19}
 }
 
-type GetEloLeaderboard_Response struct {
+type GetLobby struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *GetEloLeaderboard_Response) Reset() {
-	*x = GetEloLeaderboard_Response{}
+func (x *GetLobby) Reset() {
+	*x = GetLobby{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,13 +851,13 @@ func (x *GetEloLeaderboard_Response) Reset() {
 	}
 }
 
-func (x *GetEloLeaderboard_Response) String() string {
+func (x *GetLobby) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEloLeaderboard_Response) ProtoMessage() {}
+func (*GetLobby) ProtoMessage() {}
 
-func (x *GetEloLeaderboard_Response) ProtoReflect() protoreflect.Message {
+func (x *GetLobby) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -941,28 +869,19 @@ func (x *GetEloLeaderboard_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEloLeaderboard_Response.ProtoReflect.Descriptor instead.
-func (*GetEloLeaderboard_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{5, 1}
-}
-
-func (x *GetEloLeaderboard_Response) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use GetLobby.ProtoReflect.Descriptor instead.
+func (*GetLobby) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{20}
 }
 
-type CreateGame_Request struct {
+type InviteToLobby struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
 }
 
-func (x *CreateGame_Request) Reset() {
-	*x = CreateGame_Request{}
+func (x *InviteToLobby) Reset() {
+	*x = InviteToLobby{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,13 +889,13 @@ func (x *CreateGame_Request) Reset() {
 	}
 }
 
-func (x *CreateGame_Request) String() string {
+func (x *InviteToLobby) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGame_Request) ProtoMessage() {}
+func (*InviteToLobby) ProtoMessage() {}
 
-func (x *CreateGame_Request) ProtoReflect() protoreflect.Message {
+func (x *InviteToLobby) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -988,26 +907,19 @@ func (x *CreateGame_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGame_Request.ProtoReflect.Descriptor instead.
-func (*CreateGame_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{6, 0}
-}
-
-func (x *CreateGame_Request) GetPlayers() []string {
-	if x != nil {
-		return x.Players
-	}
-	return nil
+// Deprecated: Use InviteToLobby.ProtoReflect.Descriptor instead.
+func (*InviteToLobby) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{21}
 }
 
-type CreateGame_Response struct {
+type AcceptInvitation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateGame_Response) Reset() {
-	*x = CreateGame_Response{}
+func (x *AcceptInvitation) Reset() {
+	*x = AcceptInvitation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1015,13 +927,13 @@ func (x *CreateGame_Response) Reset() {
 	}
 }
 
-func (x *CreateGame_Response) String() string {
+func (x *AcceptInvitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGame_Response) ProtoMessage() {}
+func (*AcceptInvitation) ProtoMessage() {}
 
-func (x *CreateGame_Response) ProtoReflect() protoreflect.Message {
+func (x *AcceptInvitation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1033,27 +945,19 @@ func (x *CreateGame_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGame_Response.ProtoReflect.Descriptor instead.
-func (*CreateGame_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use AcceptInvitation.ProtoReflect.Descriptor instead.
+func (*AcceptInvitation) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{22}
 }
 
-type CreateGameV2_Request struct {
+type DeclineInvitation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Players      []string           `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	Board        CreateGameV2_Board `protobuf:"varint,2,opt,name=board,proto3,enum=api.CreateGameV2_Board" json:"board,omitempty"`
-	CorporateEra bool               `protobuf:"varint,3,opt,name=corporate_era,json=corporateEra,proto3" json:"corporate_era,omitempty"`
-	Prelude      bool               `protobuf:"varint,4,opt,name=prelude,proto3" json:"prelude,omitempty"`
-	VenusNext    bool               `protobuf:"varint,5,opt,name=venus_next,json=venusNext,proto3" json:"venus_next,omitempty"`
-	SolarPhase   bool               `protobuf:"varint,6,opt,name=solar_phase,json=solarPhase,proto3" json:"solar_phase,omitempty"`
-	Colonies     bool               `protobuf:"varint,7,opt,name=colonies,proto3" json:"colonies,omitempty"`
 }
 
-func (x *CreateGameV2_Request) Reset() {
-	*x = CreateGameV2_Request{}
+func (x *DeclineInvitation) Reset() {
+	*x = DeclineInvitation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_services_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1061,13 +965,13 @@ func (x *CreateGameV2_Request) Reset() {
 	}
 }
 
-func (x *CreateGameV2_Request) String() string {
+func (x *DeclineInvitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameV2_Request) ProtoMessage() {}
+func (*DeclineInvitation) ProtoMessage() {}
 
-func (x *CreateGameV2_Request) ProtoReflect() protoreflect.Message {
+func (x *DeclineInvitation) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_services_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1079,430 +983,5306 @@ func (x *CreateGameV2_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameV2_Request.ProtoReflect.Descriptor instead.
-func (*CreateGameV2_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use DeclineInvitation.ProtoReflect.Descriptor instead.
+func (*DeclineInvitation) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateGameV2_Request) GetPlayers() []string {
-	if x != nil {
-		return x.Players
+type StartLobby struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartLobby) Reset() {
+	*x = StartLobby{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateGameV2_Request) GetBoard() CreateGameV2_Board {
-	if x != nil {
-		return x.Board
+func (x *StartLobby) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLobby) ProtoMessage() {}
+
+func (x *StartLobby) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return CreateGameV2_RANDOM
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGameV2_Request) GetCorporateEra() bool {
-	if x != nil {
-		return x.CorporateEra
+// Deprecated: Use StartLobby.ProtoReflect.Descriptor instead.
+func (*StartLobby) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{24}
+}
+
+type JoinQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinQueue) Reset() {
+	*x = JoinQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *CreateGameV2_Request) GetPrelude() bool {
-	if x != nil {
-		return x.Prelude
+func (x *JoinQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueue) ProtoMessage() {}
+
+func (x *JoinQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGameV2_Request) GetVenusNext() bool {
-	if x != nil {
-		return x.VenusNext
+// Deprecated: Use JoinQueue.ProtoReflect.Descriptor instead.
+func (*JoinQueue) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{25}
+}
+
+type LeaveQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveQueue) Reset() {
+	*x = LeaveQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *CreateGameV2_Request) GetSolarPhase() bool {
-	if x != nil {
-		return x.SolarPhase
+func (x *LeaveQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueue) ProtoMessage() {}
+
+func (x *LeaveQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGameV2_Request) GetColonies() bool {
-	if x != nil {
-		return x.Colonies
+// Deprecated: Use LeaveQueue.ProtoReflect.Descriptor instead.
+func (*LeaveQueue) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{26}
+}
+
+type GetQueueStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQueueStatus) Reset() {
+	*x = GetQueueStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-type CreateGameV2_Response struct {
+func (x *GetQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatus) ProtoMessage() {}
+
+func (x *GetQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatus.ProtoReflect.Descriptor instead.
+func (*GetQueueStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{27}
+}
+
+type GetDeliveryAttempts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateGameV2_Response) Reset() {
-	*x = CreateGameV2_Response{}
+func (x *GetDeliveryAttempts) Reset() {
+	*x = GetDeliveryAttempts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_services_proto_msgTypes[24]
+		mi := &file_pkg_api_services_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateGameV2_Response) String() string {
+func (x *GetDeliveryAttempts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameV2_Response) ProtoMessage() {}
+func (*GetDeliveryAttempts) ProtoMessage() {}
 
-func (x *CreateGameV2_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_services_proto_msgTypes[24]
+func (x *GetDeliveryAttempts) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryAttempts.ProtoReflect.Descriptor instead.
+func (*GetDeliveryAttempts) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{28}
+}
+
+type CreatePreset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreatePreset) Reset() {
+	*x = CreatePreset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePreset) ProtoMessage() {}
+
+func (x *CreatePreset) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePreset.ProtoReflect.Descriptor instead.
+func (*CreatePreset) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{29}
+}
+
+type GetPresets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPresets) Reset() {
+	*x = GetPresets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPresets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresets) ProtoMessage() {}
+
+func (x *GetPresets) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateGameV2_Response.ProtoReflect.Descriptor instead.
-func (*CreateGameV2_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{7, 1}
-}
-
-type GetGames_Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetGames_Request) Reset() {
-	*x = GetGames_Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_services_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetGames_Request) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGames_Request) ProtoMessage() {}
-
-func (x *GetGames_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_services_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresets.ProtoReflect.Descriptor instead.
+func (*GetPresets) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{30}
+}
+
+type UpdatePreset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdatePreset) Reset() {
+	*x = UpdatePreset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreset) ProtoMessage() {}
+
+func (x *UpdatePreset) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreset.ProtoReflect.Descriptor instead.
+func (*UpdatePreset) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{31}
+}
+
+type DeletePreset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePreset) Reset() {
+	*x = DeletePreset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePreset) ProtoMessage() {}
+
+func (x *DeletePreset) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePreset.ProtoReflect.Descriptor instead.
+func (*DeletePreset) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{32}
+}
+
+type Login_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Login_Request) Reset() {
+	*x = Login_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Login_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Login_Request) ProtoMessage() {}
+
+func (x *Login_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Login_Request.ProtoReflect.Descriptor instead.
+func (*Login_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Login_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *Login_Response) Reset() {
+	*x = Login_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Login_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Login_Response) ProtoMessage() {}
+
+func (x *Login_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Login_Response.ProtoReflect.Descriptor instead.
+func (*Login_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Login_Response) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetMe_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMe_Request) Reset() {
+	*x = GetMe_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMe_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMe_Request) ProtoMessage() {}
+
+func (x *GetMe_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMe_Request.ProtoReflect.Descriptor instead.
+func (*GetMe_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type GetMe_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetMe_Response) Reset() {
+	*x = GetMe_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMe_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMe_Response) ProtoMessage() {}
+
+func (x *GetMe_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMe_Response.ProtoReflect.Descriptor instead.
+func (*GetMe_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetMe_Response) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateMe_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string      `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Color    PlayerColor `protobuf:"varint,2,opt,name=color,proto3,enum=api.PlayerColor" json:"color,omitempty"`
+}
+
+func (x *UpdateMe_Request) Reset() {
+	*x = UpdateMe_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMe_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMe_Request) ProtoMessage() {}
+
+func (x *UpdateMe_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMe_Request.ProtoReflect.Descriptor instead.
+func (*UpdateMe_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *UpdateMe_Request) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateMe_Request) GetColor() PlayerColor {
+	if x != nil {
+		return x.Color
+	}
+	return PlayerColor_BLUE
+}
+
+type UpdateMe_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UpdateMe_Response) Reset() {
+	*x = UpdateMe_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMe_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMe_Response) ProtoMessage() {}
+
+func (x *UpdateMe_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMe_Response.ProtoReflect.Descriptor instead.
+func (*UpdateMe_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *UpdateMe_Response) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateDeviceToken_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceToken []byte `protobuf:"bytes,1,opt,name=device_token,json=deviceToken,proto3" json:"device_token,omitempty"`
+	// FCM registration tokens are sent as UTF-8 bytes
+	Platform   DevicePlatform `protobuf:"varint,2,opt,name=platform,proto3,enum=api.DevicePlatform" json:"platform,omitempty"`
+	AppVersion string         `protobuf:"bytes,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+}
+
+func (x *UpdateDeviceToken_Request) Reset() {
+	*x = UpdateDeviceToken_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDeviceToken_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceToken_Request) ProtoMessage() {}
+
+func (x *UpdateDeviceToken_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceToken_Request.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceToken_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *UpdateDeviceToken_Request) GetDeviceToken() []byte {
+	if x != nil {
+		return x.DeviceToken
+	}
+	return nil
+}
+
+func (x *UpdateDeviceToken_Request) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_DEVICE_PLATFORM_IOS
+}
+
+func (x *UpdateDeviceToken_Request) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type UpdateDeviceToken_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDeviceToken_Response) Reset() {
+	*x = UpdateDeviceToken_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDeviceToken_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeviceToken_Response) ProtoMessage() {}
+
+func (x *UpdateDeviceToken_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeviceToken_Response.ProtoReflect.Descriptor instead.
+func (*UpdateDeviceToken_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{3, 1}
+}
+
+type RegisterWebPushSubscription_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Subscription keys as returned by PushSubscription.toJSON()
+	P256Dh   string `protobuf:"bytes,2,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	Auth     string `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	PlayerId string `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *RegisterWebPushSubscription_Request) Reset() {
+	*x = RegisterWebPushSubscription_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWebPushSubscription_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebPushSubscription_Request) ProtoMessage() {}
+
+func (x *RegisterWebPushSubscription_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebPushSubscription_Request.ProtoReflect.Descriptor instead.
+func (*RegisterWebPushSubscription_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RegisterWebPushSubscription_Request) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *RegisterWebPushSubscription_Request) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *RegisterWebPushSubscription_Request) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *RegisterWebPushSubscription_Request) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RegisterWebPushSubscription_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterWebPushSubscription_Response) Reset() {
+	*x = RegisterWebPushSubscription_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWebPushSubscription_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebPushSubscription_Response) ProtoMessage() {}
+
+func (x *RegisterWebPushSubscription_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebPushSubscription_Response.ProtoReflect.Descriptor instead.
+func (*RegisterWebPushSubscription_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{4, 1}
+}
+
+type SearchUser_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *SearchUser_Request) Reset() {
+	*x = SearchUser_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUser_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUser_Request) ProtoMessage() {}
+
+func (x *SearchUser_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUser_Request.ProtoReflect.Descriptor instead.
+func (*SearchUser_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *SearchUser_Request) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type SearchUser_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *SearchUser_Response) Reset() {
+	*x = SearchUser_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUser_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUser_Response) ProtoMessage() {}
+
+func (x *SearchUser_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUser_Response.ProtoReflect.Descriptor instead.
+func (*SearchUser_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *SearchUser_Response) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetEloLeaderboard_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most one filter can be set. The global leaderboard is returned when none is set.
+	// Only games with exactly this number of players
+	PlayersCount int32 `protobuf:"varint,1,opt,name=players_count,json=playersCount,proto3" json:"players_count,omitempty"`
+	// Only games on this board, BOARD_UNKNOWN means any board
+	Board Board `protobuf:"varint,2,opt,name=board,proto3,enum=api.Board" json:"board,omitempty"`
+	// Only games with this expansion enabled
+	Expansion Expansion `protobuf:"varint,3,opt,name=expansion,proto3,enum=api.Expansion" json:"expansion,omitempty"`
+}
+
+func (x *GetEloLeaderboard_Request) Reset() {
+	*x = GetEloLeaderboard_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEloLeaderboard_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEloLeaderboard_Request) ProtoMessage() {}
+
+func (x *GetEloLeaderboard_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEloLeaderboard_Request.ProtoReflect.Descriptor instead.
+func (*GetEloLeaderboard_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *GetEloLeaderboard_Request) GetPlayersCount() int32 {
+	if x != nil {
+		return x.PlayersCount
+	}
+	return 0
+}
+
+func (x *GetEloLeaderboard_Request) GetBoard() Board {
+	if x != nil {
+		return x.Board
+	}
+	return Board_BOARD_UNKNOWN
+}
+
+func (x *GetEloLeaderboard_Request) GetExpansion() Expansion {
+	if x != nil {
+		return x.Expansion
+	}
+	return Expansion_EXPANSION_UNSPECIFIED
+}
+
+type GetEloLeaderboard_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users        []*User      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	RatingSystem RatingSystem `protobuf:"varint,2,opt,name=rating_system,json=ratingSystem,proto3,enum=api.RatingSystem" json:"rating_system,omitempty"`
+}
+
+func (x *GetEloLeaderboard_Response) Reset() {
+	*x = GetEloLeaderboard_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEloLeaderboard_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEloLeaderboard_Response) ProtoMessage() {}
+
+func (x *GetEloLeaderboard_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEloLeaderboard_Response.ProtoReflect.Descriptor instead.
+func (*GetEloLeaderboard_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *GetEloLeaderboard_Response) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetEloLeaderboard_Response) GetRatingSystem() RatingSystem {
+	if x != nil {
+		return x.RatingSystem
+	}
+	return RatingSystem_RATING_SYSTEM_ELO
+}
+
+type GetRatingHistory_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of changes to return, newest first. Defaults to 50.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from the previous response to get the next (older) page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetRatingHistory_Request) Reset() {
+	*x = GetRatingHistory_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRatingHistory_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingHistory_Request) ProtoMessage() {}
+
+func (x *GetRatingHistory_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingHistory_Request.ProtoReflect.Descriptor instead.
+func (*GetRatingHistory_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GetRatingHistory_Request) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRatingHistory_Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetRatingHistory_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*RatingChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Empty when there are no more changes
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetRatingHistory_Response) Reset() {
+	*x = GetRatingHistory_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRatingHistory_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingHistory_Response) ProtoMessage() {}
+
+func (x *GetRatingHistory_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingHistory_Response.ProtoReflect.Descriptor instead.
+func (*GetRatingHistory_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *GetRatingHistory_Response) GetChanges() []*RatingChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetRatingHistory_Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetNotificationSettings_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNotificationSettings_Request) Reset() {
+	*x = GetNotificationSettings_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationSettings_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettings_Request) ProtoMessage() {}
+
+func (x *GetNotificationSettings_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettings_Request.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettings_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type GetNotificationSettings_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *NotificationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetNotificationSettings_Response) Reset() {
+	*x = GetNotificationSettings_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationSettings_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettings_Response) ProtoMessage() {}
+
+func (x *GetNotificationSettings_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettings_Response.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettings_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *GetNotificationSettings_Response) GetSettings() *NotificationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateNotificationSettings_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *NotificationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateNotificationSettings_Request) Reset() {
+	*x = UpdateNotificationSettings_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNotificationSettings_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSettings_Request) ProtoMessage() {}
+
+func (x *UpdateNotificationSettings_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSettings_Request.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettings_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *UpdateNotificationSettings_Request) GetSettings() *NotificationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateNotificationSettings_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *NotificationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateNotificationSettings_Response) Reset() {
+	*x = UpdateNotificationSettings_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNotificationSettings_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSettings_Response) ProtoMessage() {}
+
+func (x *UpdateNotificationSettings_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSettings_Response.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettings_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *UpdateNotificationSettings_Response) GetSettings() *NotificationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type CreateGame_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Settings of the preset are used instead of the default ones if set
+	PresetId string `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+}
+
+func (x *CreateGame_Request) Reset() {
+	*x = CreateGame_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGame_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGame_Request) ProtoMessage() {}
+
+func (x *CreateGame_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGame_Request.ProtoReflect.Descriptor instead.
+func (*CreateGame_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *CreateGame_Request) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *CreateGame_Request) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+type CreateGame_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateGame_Response) Reset() {
+	*x = CreateGame_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGame_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGame_Response) ProtoMessage() {}
+
+func (x *CreateGame_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGame_Response.ProtoReflect.Descriptor instead.
+func (*CreateGame_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{10, 1}
+}
+
+type CreateGameV2_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players      []string           `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Board        CreateGameV2_Board `protobuf:"varint,2,opt,name=board,proto3,enum=api.CreateGameV2_Board" json:"board,omitempty"`
+	CorporateEra bool               `protobuf:"varint,3,opt,name=corporate_era,json=corporateEra,proto3" json:"corporate_era,omitempty"`
+	Prelude      bool               `protobuf:"varint,4,opt,name=prelude,proto3" json:"prelude,omitempty"`
+	VenusNext    bool               `protobuf:"varint,5,opt,name=venus_next,json=venusNext,proto3" json:"venus_next,omitempty"`
+	SolarPhase   bool               `protobuf:"varint,6,opt,name=solar_phase,json=solarPhase,proto3" json:"solar_phase,omitempty"`
+	Colonies     bool               `protobuf:"varint,7,opt,name=colonies,proto3" json:"colonies,omitempty"`
+}
+
+func (x *CreateGameV2_Request) Reset() {
+	*x = CreateGameV2_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameV2_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameV2_Request) ProtoMessage() {}
+
+func (x *CreateGameV2_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameV2_Request.ProtoReflect.Descriptor instead.
+func (*CreateGameV2_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *CreateGameV2_Request) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *CreateGameV2_Request) GetBoard() CreateGameV2_Board {
+	if x != nil {
+		return x.Board
+	}
+	return CreateGameV2_RANDOM
+}
+
+func (x *CreateGameV2_Request) GetCorporateEra() bool {
+	if x != nil {
+		return x.CorporateEra
+	}
+	return false
+}
+
+func (x *CreateGameV2_Request) GetPrelude() bool {
+	if x != nil {
+		return x.Prelude
+	}
+	return false
+}
+
+func (x *CreateGameV2_Request) GetVenusNext() bool {
+	if x != nil {
+		return x.VenusNext
+	}
+	return false
+}
+
+func (x *CreateGameV2_Request) GetSolarPhase() bool {
+	if x != nil {
+		return x.SolarPhase
+	}
+	return false
+}
+
+func (x *CreateGameV2_Request) GetColonies() bool {
+	if x != nil {
+		return x.Colonies
+	}
+	return false
+}
+
+type CreateGameV2_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateGameV2_Response) Reset() {
+	*x = CreateGameV2_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameV2_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameV2_Response) ProtoMessage() {}
+
+func (x *CreateGameV2_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameV2_Response.ProtoReflect.Descriptor instead.
+func (*CreateGameV2_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{11, 1}
+}
+
+type CreateGameV3_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []string     `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Options *GameOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// Options of the preset are used, options must not be set then
+	PresetId string `protobuf:"bytes,3,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+}
+
+func (x *CreateGameV3_Request) Reset() {
+	*x = CreateGameV3_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameV3_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameV3_Request) ProtoMessage() {}
+
+func (x *CreateGameV3_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameV3_Request.ProtoReflect.Descriptor instead.
+func (*CreateGameV3_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *CreateGameV3_Request) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *CreateGameV3_Request) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateGameV3_Request) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+type CreateGameV3_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *GameDetails `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *CreateGameV3_Response) Reset() {
+	*x = CreateGameV3_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameV3_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameV3_Response) ProtoMessage() {}
+
+func (x *CreateGameV3_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameV3_Response.ProtoReflect.Descriptor instead.
+func (*CreateGameV3_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *CreateGameV3_Response) GetGame() *GameDetails {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type GetGames_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGames_Request) Reset() {
+	*x = GetGames_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGames_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGames_Request) ProtoMessage() {}
+
+func (x *GetGames_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGames_Request.ProtoReflect.Descriptor instead.
+func (*GetGames_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type GetGames_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*Game `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *GetGames_Response) Reset() {
+	*x = GetGames_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGames_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGames_Response) ProtoMessage() {}
+
+func (x *GetGames_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGames_Response.ProtoReflect.Descriptor instead.
+func (*GetGames_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *GetGames_Response) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GetGame_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGame_Request) Reset() {
+	*x = GetGame_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGame_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGame_Request) ProtoMessage() {}
+
+func (x *GetGame_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGame_Request.ProtoReflect.Descriptor instead.
+func (*GetGame_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *GetGame_Request) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGame_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *GameDetails `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *GetGame_Response) Reset() {
+	*x = GetGame_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGame_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGame_Response) ProtoMessage() {}
+
+func (x *GetGame_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGame_Response.ProtoReflect.Descriptor instead.
+func (*GetGame_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *GetGame_Response) GetGame() *GameDetails {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type GetGameHistory_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of games to return, most recently finished first. Defaults to 20.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from the previous response to get the next (older) page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetGameHistory_Request) Reset() {
+	*x = GetGameHistory_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameHistory_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameHistory_Request) ProtoMessage() {}
+
+func (x *GetGameHistory_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameHistory_Request.ProtoReflect.Descriptor instead.
+func (*GetGameHistory_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetGameHistory_Request) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGameHistory_Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetGameHistory_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*GameDetails `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// Empty when there are no more games
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetGameHistory_Response) Reset() {
+	*x = GetGameHistory_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameHistory_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameHistory_Response) ProtoMessage() {}
+
+func (x *GetGameHistory_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameHistory_Response.ProtoReflect.Descriptor instead.
+func (*GetGameHistory_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *GetGameHistory_Response) GetGames() []*GameDetails {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetGameHistory_Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Rematch_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Choose a random board instead of the one of the finished game
+	ReshuffleBoard bool `protobuf:"varint,2,opt,name=reshuffle_board,json=reshuffleBoard,proto3" json:"reshuffle_board,omitempty"`
+	// Choose a random first player instead of the one of the finished game
+	ReshuffleFirstPlayer bool `protobuf:"varint,3,opt,name=reshuffle_first_player,json=reshuffleFirstPlayer,proto3" json:"reshuffle_first_player,omitempty"`
+}
+
+func (x *Rematch_Request) Reset() {
+	*x = Rematch_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rematch_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rematch_Request) ProtoMessage() {}
+
+func (x *Rematch_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rematch_Request.ProtoReflect.Descriptor instead.
+func (*Rematch_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *Rematch_Request) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Rematch_Request) GetReshuffleBoard() bool {
+	if x != nil {
+		return x.ReshuffleBoard
+	}
+	return false
+}
+
+func (x *Rematch_Request) GetReshuffleFirstPlayer() bool {
+	if x != nil {
+		return x.ReshuffleFirstPlayer
+	}
+	return false
+}
+
+type Rematch_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *GameDetails `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *Rematch_Response) Reset() {
+	*x = Rematch_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rematch_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rematch_Response) ProtoMessage() {}
+
+func (x *Rematch_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rematch_Response.ProtoReflect.Descriptor instead.
+func (*Rematch_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *Rematch_Response) GetGame() *GameDetails {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type Nudge_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *Nudge_Request) Reset() {
+	*x = Nudge_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nudge_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nudge_Request) ProtoMessage() {}
+
+func (x *Nudge_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nudge_Request.ProtoReflect.Descriptor instead.
+func (*Nudge_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *Nudge_Request) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type Nudge_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nicknames of the nudged players
+	Nicknames []string `protobuf:"bytes,1,rep,name=nicknames,proto3" json:"nicknames,omitempty"`
+}
+
+func (x *Nudge_Response) Reset() {
+	*x = Nudge_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nudge_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nudge_Response) ProtoMessage() {}
+
+func (x *Nudge_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nudge_Response.ProtoReflect.Descriptor instead.
+func (*Nudge_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *Nudge_Response) GetNicknames() []string {
+	if x != nil {
+		return x.Nicknames
+	}
+	return nil
+}
+
+type CreateLobby_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nicknames of the invited players, the host is added automatically
+	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Seed and first player are ignored
+	Settings *GameSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *CreateLobby_Request) Reset() {
+	*x = CreateLobby_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLobby_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLobby_Request) ProtoMessage() {}
+
+func (x *CreateLobby_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLobby_Request.ProtoReflect.Descriptor instead.
+func (*CreateLobby_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *CreateLobby_Request) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *CreateLobby_Request) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type CreateLobby_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobby *Lobby `protobuf:"bytes,1,opt,name=lobby,proto3" json:"lobby,omitempty"`
+}
+
+func (x *CreateLobby_Response) Reset() {
+	*x = CreateLobby_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLobby_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLobby_Response) ProtoMessage() {}
+
+func (x *CreateLobby_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLobby_Response.ProtoReflect.Descriptor instead.
+func (*CreateLobby_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *CreateLobby_Response) GetLobby() *Lobby {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+type GetLobbies_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLobbies_Request) Reset() {
+	*x = GetLobbies_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLobbies_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLobbies_Request) ProtoMessage() {}
+
+func (x *GetLobbies_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLobbies_Request.ProtoReflect.Descriptor instead.
+func (*GetLobbies_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type GetLobbies_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobbies []*Lobby `protobuf:"bytes,1,rep,name=lobbies,proto3" json:"lobbies,omitempty"`
+}
+
+func (x *GetLobbies_Response) Reset() {
+	*x = GetLobbies_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLobbies_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLobbies_Response) ProtoMessage() {}
+
+func (x *GetLobbies_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLobbies_Response.ProtoReflect.Descriptor instead.
+func (*GetLobbies_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *GetLobbies_Response) GetLobbies() []*Lobby {
+	if x != nil {
+		return x.Lobbies
+	}
+	return nil
+}
+
+type GetLobby_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+}
+
+func (x *GetLobby_Request) Reset() {
+	*x = GetLobby_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLobby_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLobby_Request) ProtoMessage() {}
+
+func (x *GetLobby_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLobby_Request.ProtoReflect.Descriptor instead.
+func (*GetLobby_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *GetLobby_Request) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+type GetLobby_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobby *Lobby `protobuf:"bytes,1,opt,name=lobby,proto3" json:"lobby,omitempty"`
+}
+
+func (x *GetLobby_Response) Reset() {
+	*x = GetLobby_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLobby_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLobby_Response) ProtoMessage() {}
+
+func (x *GetLobby_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLobby_Response.ProtoReflect.Descriptor instead.
+func (*GetLobby_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *GetLobby_Response) GetLobby() *Lobby {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+type InviteToLobby_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string   `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+	Players []string `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *InviteToLobby_Request) Reset() {
+	*x = InviteToLobby_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToLobby_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToLobby_Request) ProtoMessage() {}
+
+func (x *InviteToLobby_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToLobby_Request.ProtoReflect.Descriptor instead.
+func (*InviteToLobby_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *InviteToLobby_Request) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+func (x *InviteToLobby_Request) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type InviteToLobby_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobby *Lobby `protobuf:"bytes,1,opt,name=lobby,proto3" json:"lobby,omitempty"`
+}
+
+func (x *InviteToLobby_Response) Reset() {
+	*x = InviteToLobby_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteToLobby_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToLobby_Response) ProtoMessage() {}
+
+func (x *InviteToLobby_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToLobby_Response.ProtoReflect.Descriptor instead.
+func (*InviteToLobby_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *InviteToLobby_Response) GetLobby() *Lobby {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+type AcceptInvitation_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+}
+
+func (x *AcceptInvitation_Request) Reset() {
+	*x = AcceptInvitation_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptInvitation_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitation_Request) ProtoMessage() {}
+
+func (x *AcceptInvitation_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitation_Request.ProtoReflect.Descriptor instead.
+func (*AcceptInvitation_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *AcceptInvitation_Request) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+type AcceptInvitation_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The game is started when this was the last pending invitation
+	Lobby *Lobby `protobuf:"bytes,1,opt,name=lobby,proto3" json:"lobby,omitempty"`
+}
+
+func (x *AcceptInvitation_Response) Reset() {
+	*x = AcceptInvitation_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptInvitation_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitation_Response) ProtoMessage() {}
+
+func (x *AcceptInvitation_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitation_Response.ProtoReflect.Descriptor instead.
+func (*AcceptInvitation_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *AcceptInvitation_Response) GetLobby() *Lobby {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+type DeclineInvitation_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+}
+
+func (x *DeclineInvitation_Request) Reset() {
+	*x = DeclineInvitation_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineInvitation_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitation_Request) ProtoMessage() {}
+
+func (x *DeclineInvitation_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitation_Request.ProtoReflect.Descriptor instead.
+func (*DeclineInvitation_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *DeclineInvitation_Request) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+type DeclineInvitation_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclineInvitation_Response) Reset() {
+	*x = DeclineInvitation_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineInvitation_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitation_Response) ProtoMessage() {}
+
+func (x *DeclineInvitation_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitation_Response.ProtoReflect.Descriptor instead.
+func (*DeclineInvitation_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{23, 1}
+}
+
+type StartLobby_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LobbyId string `protobuf:"bytes,1,opt,name=lobby_id,json=lobbyId,proto3" json:"lobby_id,omitempty"`
+}
+
+func (x *StartLobby_Request) Reset() {
+	*x = StartLobby_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartLobby_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLobby_Request) ProtoMessage() {}
+
+func (x *StartLobby_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLobby_Request.ProtoReflect.Descriptor instead.
+func (*StartLobby_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *StartLobby_Request) GetLobbyId() string {
+	if x != nil {
+		return x.LobbyId
+	}
+	return ""
+}
+
+type StartLobby_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lobby *Lobby `protobuf:"bytes,1,opt,name=lobby,proto3" json:"lobby,omitempty"`
+}
+
+func (x *StartLobby_Response) Reset() {
+	*x = StartLobby_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartLobby_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLobby_Response) ProtoMessage() {}
+
+func (x *StartLobby_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLobby_Response.ProtoReflect.Descriptor instead.
+func (*StartLobby_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{24, 1}
+}
+
+func (x *StartLobby_Response) GetLobby() *Lobby {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+type JoinQueue_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *MatchmakingPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *JoinQueue_Request) Reset() {
+	*x = JoinQueue_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinQueue_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueue_Request) ProtoMessage() {}
+
+func (x *JoinQueue_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueue_Request.ProtoReflect.Descriptor instead.
+func (*JoinQueue_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *JoinQueue_Request) GetPreferences() *MatchmakingPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type JoinQueue_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnqueuedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=enqueued_at,json=enqueuedAt,proto3" json:"enqueued_at,omitempty"`
+}
+
+func (x *JoinQueue_Response) Reset() {
+	*x = JoinQueue_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinQueue_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueue_Response) ProtoMessage() {}
+
+func (x *JoinQueue_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueue_Response.ProtoReflect.Descriptor instead.
+func (*JoinQueue_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *JoinQueue_Response) GetEnqueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnqueuedAt
+	}
+	return nil
+}
+
+type LeaveQueue_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveQueue_Request) Reset() {
+	*x = LeaveQueue_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveQueue_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueue_Request) ProtoMessage() {}
+
+func (x *LeaveQueue_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueue_Request.ProtoReflect.Descriptor instead.
+func (*LeaveQueue_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{26, 0}
+}
+
+type LeaveQueue_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveQueue_Response) Reset() {
+	*x = LeaveQueue_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveQueue_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueue_Response) ProtoMessage() {}
+
+func (x *LeaveQueue_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueue_Response.ProtoReflect.Descriptor instead.
+func (*LeaveQueue_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{26, 1}
+}
+
+type GetQueueStatus_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQueueStatus_Request) Reset() {
+	*x = GetQueueStatus_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueStatus_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatus_Request) ProtoMessage() {}
+
+func (x *GetQueueStatus_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatus_Request.ProtoReflect.Descriptor instead.
+func (*GetQueueStatus_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type GetQueueStatus_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// False once the player is matched, the game is returned by GetGames then
+	Queued      bool                    `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	Preferences *MatchmakingPreferences `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	EnqueuedAt  *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=enqueued_at,json=enqueuedAt,proto3" json:"enqueued_at,omitempty"`
+}
+
+func (x *GetQueueStatus_Response) Reset() {
+	*x = GetQueueStatus_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueStatus_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatus_Response) ProtoMessage() {}
+
+func (x *GetQueueStatus_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueStatus_Response.ProtoReflect.Descriptor instead.
+func (*GetQueueStatus_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *GetQueueStatus_Response) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+func (x *GetQueueStatus_Response) GetPreferences() *MatchmakingPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetQueueStatus_Response) GetEnqueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnqueuedAt
+	}
+	return nil
+}
+
+type GetDeliveryAttempts_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// Maximum number of attempts to return, newest first. Defaults to 50.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetDeliveryAttempts_Request) Reset() {
+	*x = GetDeliveryAttempts_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeliveryAttempts_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryAttempts_Request) ProtoMessage() {}
+
+func (x *GetDeliveryAttempts_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryAttempts_Request.ProtoReflect.Descriptor instead.
+func (*GetDeliveryAttempts_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *GetDeliveryAttempts_Request) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GetDeliveryAttempts_Request) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDeliveryAttempts_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempts []*DeliveryAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *GetDeliveryAttempts_Response) Reset() {
+	*x = GetDeliveryAttempts_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeliveryAttempts_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryAttempts_Response) ProtoMessage() {}
+
+func (x *GetDeliveryAttempts_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryAttempts_Response.ProtoReflect.Descriptor instead.
+func (*GetDeliveryAttempts_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *GetDeliveryAttempts_Response) GetAttempts() []*DeliveryAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+type CreatePreset_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The first player can't be set in a preset
+	Options *GameOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// Nicknames of the users the preset is shared with
+	SharedWith []string `protobuf:"bytes,3,rep,name=shared_with,json=sharedWith,proto3" json:"shared_with,omitempty"`
+}
+
+func (x *CreatePreset_Request) Reset() {
+	*x = CreatePreset_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePreset_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePreset_Request) ProtoMessage() {}
+
+func (x *CreatePreset_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePreset_Request.ProtoReflect.Descriptor instead.
+func (*CreatePreset_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *CreatePreset_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePreset_Request) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreatePreset_Request) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
+type CreatePreset_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset *Preset `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+}
+
+func (x *CreatePreset_Response) Reset() {
+	*x = CreatePreset_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePreset_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePreset_Response) ProtoMessage() {}
+
+func (x *CreatePreset_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePreset_Response.ProtoReflect.Descriptor instead.
+func (*CreatePreset_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *CreatePreset_Response) GetPreset() *Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+type GetPresets_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPresets_Request) Reset() {
+	*x = GetPresets_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPresets_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresets_Request) ProtoMessage() {}
+
+func (x *GetPresets_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresets_Request.ProtoReflect.Descriptor instead.
+func (*GetPresets_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type GetPresets_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Presets []*Preset `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+}
+
+func (x *GetPresets_Response) Reset() {
+	*x = GetPresets_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPresets_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresets_Response) ProtoMessage() {}
+
+func (x *GetPresets_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresets_Response.ProtoReflect.Descriptor instead.
+func (*GetPresets_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *GetPresets_Response) GetPresets() []*Preset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type UpdatePreset_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PresetId string       `protobuf:"bytes,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Name     string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Options  *GameOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// Replaces the users the preset is shared with
+	SharedWith []string `protobuf:"bytes,4,rep,name=shared_with,json=sharedWith,proto3" json:"shared_with,omitempty"`
+}
+
+func (x *UpdatePreset_Request) Reset() {
+	*x = UpdatePreset_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePreset_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreset_Request) ProtoMessage() {}
+
+func (x *UpdatePreset_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreset_Request.ProtoReflect.Descriptor instead.
+func (*UpdatePreset_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *UpdatePreset_Request) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+func (x *UpdatePreset_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePreset_Request) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdatePreset_Request) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
+type UpdatePreset_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset *Preset `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+}
+
+func (x *UpdatePreset_Response) Reset() {
+	*x = UpdatePreset_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePreset_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreset_Response) ProtoMessage() {}
+
+func (x *UpdatePreset_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreset_Response.ProtoReflect.Descriptor instead.
+func (*UpdatePreset_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{31, 1}
+}
+
+func (x *UpdatePreset_Response) GetPreset() *Preset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+type DeletePreset_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PresetId string `protobuf:"bytes,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+}
+
+func (x *DeletePreset_Request) Reset() {
+	*x = DeletePreset_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePreset_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePreset_Request) ProtoMessage() {}
+
+func (x *DeletePreset_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePreset_Request.ProtoReflect.Descriptor instead.
+func (*DeletePreset_Request) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *DeletePreset_Request) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+type DeletePreset_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePreset_Response) Reset() {
+	*x = DeletePreset_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_services_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePreset_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePreset_Response) ProtoMessage() {}
+
+func (x *DeletePreset_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_services_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePreset_Response.ProtoReflect.Descriptor instead.
+func (*DeletePreset_Response) Descriptor() ([]byte, []int) {
+	return file_pkg_api_services_proto_rawDescGZIP(), []int{32, 1}
+}
+
+var File_pkg_api_services_proto protoreflect.FileDescriptor
+
+var file_pkg_api_services_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x3d, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x3d, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x84,
+	0x01, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x1a, 0x4d, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0x29, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x7e, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70,
+	0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0a, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x6e, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x32, 0x35, 0x36, 0x64, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x32, 0x35, 0x36, 0x64, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x1a, 0x21, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x1a, 0x2b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x22, 0xf8, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x7e, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x63, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x0c,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0xba, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x1a, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x5f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x67, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x41, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x1a, 0x40, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x41, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x5a, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x1a, 0x40, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x56, 0x32, 0x1a, 0xed, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x2e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x70,
+	0x6f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x65, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x72, 0x65, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x6e, 0x75, 0x73,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x65, 0x6e,
+	0x75, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x6c,
+	0x61, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x6e,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x6e,
+	0x69, 0x65, 0x73, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x39, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44,
+	0x4f, 0x4d, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x48, 0x41, 0x52, 0x53, 0x49, 0x53, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x4c, 0x4c, 0x41, 0x53, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x45, 0x4c, 0x59, 0x53, 0x49, 0x55, 0x4d, 0x10, 0x03, 0x22, 0xae, 0x01, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x33, 0x1a, 0x6c, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x1a, 0x30, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0x5f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x22, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x1a, 0x30,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x1a, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x5a, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x1a, 0x81, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x68, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x72, 0x65, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x30, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x05, 0x4e, 0x75, 0x64, 0x67,
+	0x65, 0x1a, 0x22, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x1a, 0x28, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0x8f, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x1a,
+	0x52, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x1a, 0x2c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x22, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x1a,
+	0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x1a, 0x24, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x1a, 0x2c,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x22, 0x7d, 0x0a, 0x0d,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x1a, 0x3e, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62,
+	0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x2c, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x22, 0x66, 0x0a, 0x10, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x24, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x49, 0x64, 0x1a, 0x2c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x24, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x1a, 0x0a,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x1a, 0x24, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x49, 0x64, 0x1a, 0x2c,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x05, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x22, 0x9e, 0x01, 0x0a,
+	0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x48, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x1a, 0x47, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x74, 0x22, 0x23, 0x0a,
+	0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x9e, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x1a, 0x6a, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x1a, 0x2f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x22, 0x4a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73,
+	0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x22, 0xc9,
+	0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x1a,
+	0x87, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x1a, 0x2f, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x42, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x1a, 0x26, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe7,
+	0x0a, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x63, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x92, 0x41, 0x0e, 0x62,
+	0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x5d, 0x0a,
+	0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x08,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b,
+	0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xa4, 0x01, 0x0a, 0x1b,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73,
+	0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x62, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62,
+	0x2d, 0x70, 0x75, 0x73, 0x68, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x32, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22,
+	0x16, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x8a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45,
+	0x6c, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
+	0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x8d, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a,
+	0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x12, 0x21, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2d, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0xa9, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x92,
+	0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0xb5, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x44, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xc6, 0x07, 0x0a, 0x05, 0x47, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x71, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22,
+	0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x56, 0x32, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x56, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x92, 0x41,
+	0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x77,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x33, 0x12, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56,
+	0x33, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x33, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
+	0x2a, 0x22, 0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x33, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x6c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x92,
+	0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x7b, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
+	0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x7b, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x73, 0x0a, 0x05,
+	0x4e, 0x75, 0x64, 0x67, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x75, 0x64, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4e, 0x75, 0x64, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41,
+	0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x2f, 0x7b, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x75, 0x64, 0x67,
+	0x65, 0x32, 0xc9, 0x07, 0x0a, 0x07, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x77, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x33, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x74, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x62,
+	0x62, 0x69, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x62, 0x62, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x12, 0x1a, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x12, 0x76, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x12, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x62, 0x62, 0x79,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x54, 0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x6f, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4a, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x62,
+	0x62, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x10,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x45, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x62,
+	0x62, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x9c, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92,
+	0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x62, 0x62, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
+	0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6c, 0x6f,
+	0x62, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x32, 0x8b, 0x03,
+	0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x75, 0x0a,
+	0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x92, 0x41, 0x0e,
+	0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x7e, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01,
+	0x2a, 0x22, 0x21, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x37, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x32, 0xb7, 0x01, 0x0a, 0x05,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0xad, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x51, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2d, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x32, 0x94, 0x04, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x7a, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a,
+	0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0x74, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x92, 0x41, 0x0e,
+	0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73,
+	0x2f, 0x7b, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8d, 0x01, 0x0a,
+	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a,
+	0x22, 0x2a, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0xf8, 0x01, 0x92,
+	0x41, 0xbb, 0x01, 0x52, 0x53, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x4c, 0x0a, 0x4a, 0x59, 0x6f,
+	0x75, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20,
+	0x6e, 0x6f, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x69, 0x73, 0x20,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x5a, 0x64, 0x0a, 0x62, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x58, 0x08, 0x02, 0x12, 0x43, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x60, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x3a, 0x20, 0x60, 0x20, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x20, 0x61, 0x62, 0x63, 0x64, 0x65, 0x31, 0x32, 0x33, 0x34, 0x35, 0x2e, 0x1a, 0x0d, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x5a, 0x37,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x6e, 0x75, 0x74, 0x34, 0x32, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x6e, 0x67, 0x2d, 0x6d, 0x61, 0x72, 0x73, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_api_services_proto_rawDescOnce sync.Once
+	file_pkg_api_services_proto_rawDescData = file_pkg_api_services_proto_rawDesc
+)
+
+func file_pkg_api_services_proto_rawDescGZIP() []byte {
+	file_pkg_api_services_proto_rawDescOnce.Do(func() {
+		file_pkg_api_services_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_services_proto_rawDescData)
+	})
+	return file_pkg_api_services_proto_rawDescData
+}
+
+var file_pkg_api_services_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_api_services_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_pkg_api_services_proto_goTypes = []any{
+	(CreateGameV2_Board)(0),                      // 0: api.CreateGameV2.Board
+	(*Login)(nil),                                // 1: api.Login
+	(*GetMe)(nil),                                // 2: api.GetMe
+	(*UpdateMe)(nil),                             // 3: api.UpdateMe
+	(*UpdateDeviceToken)(nil),                    // 4: api.UpdateDeviceToken
+	(*RegisterWebPushSubscription)(nil),          // 5: api.RegisterWebPushSubscription
+	(*SearchUser)(nil),                           // 6: api.SearchUser
+	(*GetEloLeaderboard)(nil),                    // 7: api.GetEloLeaderboard
+	(*GetRatingHistory)(nil),                     // 8: api.GetRatingHistory
+	(*GetNotificationSettings)(nil),              // 9: api.GetNotificationSettings
+	(*UpdateNotificationSettings)(nil),           // 10: api.UpdateNotificationSettings
+	(*CreateGame)(nil),                           // 11: api.CreateGame
+	(*CreateGameV2)(nil),                         // 12: api.CreateGameV2
+	(*CreateGameV3)(nil),                         // 13: api.CreateGameV3
+	(*GetGames)(nil),                             // 14: api.GetGames
+	(*GetGame)(nil),                              // 15: api.GetGame
+	(*GetGameHistory)(nil),                       // 16: api.GetGameHistory
+	(*Rematch)(nil),                              // 17: api.Rematch
+	(*Nudge)(nil),                                // 18: api.Nudge
+	(*CreateLobby)(nil),                          // 19: api.CreateLobby
+	(*GetLobbies)(nil),                           // 20: api.GetLobbies
+	(*GetLobby)(nil),                             // 21: api.GetLobby
+	(*InviteToLobby)(nil),                        // 22: api.InviteToLobby
+	(*AcceptInvitation)(nil),                     // 23: api.AcceptInvitation
+	(*DeclineInvitation)(nil),                    // 24: api.DeclineInvitation
+	(*StartLobby)(nil),                           // 25: api.StartLobby
+	(*JoinQueue)(nil),                            // 26: api.JoinQueue
+	(*LeaveQueue)(nil),                           // 27: api.LeaveQueue
+	(*GetQueueStatus)(nil),                       // 28: api.GetQueueStatus
+	(*GetDeliveryAttempts)(nil),                  // 29: api.GetDeliveryAttempts
+	(*CreatePreset)(nil),                         // 30: api.CreatePreset
+	(*GetPresets)(nil),                           // 31: api.GetPresets
+	(*UpdatePreset)(nil),                         // 32: api.UpdatePreset
+	(*DeletePreset)(nil),                         // 33: api.DeletePreset
+	(*Login_Request)(nil),                        // 34: api.Login.Request
+	(*Login_Response)(nil),                       // 35: api.Login.Response
+	(*GetMe_Request)(nil),                        // 36: api.GetMe.Request
+	(*GetMe_Response)(nil),                       // 37: api.GetMe.Response
+	(*UpdateMe_Request)(nil),                     // 38: api.UpdateMe.Request
+	(*UpdateMe_Response)(nil),                    // 39: api.UpdateMe.Response
+	(*UpdateDeviceToken_Request)(nil),            // 40: api.UpdateDeviceToken.Request
+	(*UpdateDeviceToken_Response)(nil),           // 41: api.UpdateDeviceToken.Response
+	(*RegisterWebPushSubscription_Request)(nil),  // 42: api.RegisterWebPushSubscription.Request
+	(*RegisterWebPushSubscription_Response)(nil), // 43: api.RegisterWebPushSubscription.Response
+	(*SearchUser_Request)(nil),                   // 44: api.SearchUser.Request
+	(*SearchUser_Response)(nil),                  // 45: api.SearchUser.Response
+	(*GetEloLeaderboard_Request)(nil),            // 46: api.GetEloLeaderboard.Request
+	(*GetEloLeaderboard_Response)(nil),           // 47: api.GetEloLeaderboard.Response
+	(*GetRatingHistory_Request)(nil),             // 48: api.GetRatingHistory.Request
+	(*GetRatingHistory_Response)(nil),            // 49: api.GetRatingHistory.Response
+	(*GetNotificationSettings_Request)(nil),      // 50: api.GetNotificationSettings.Request
+	(*GetNotificationSettings_Response)(nil),     // 51: api.GetNotificationSettings.Response
+	(*UpdateNotificationSettings_Request)(nil),   // 52: api.UpdateNotificationSettings.Request
+	(*UpdateNotificationSettings_Response)(nil),  // 53: api.UpdateNotificationSettings.Response
+	(*CreateGame_Request)(nil),                   // 54: api.CreateGame.Request
+	(*CreateGame_Response)(nil),                  // 55: api.CreateGame.Response
+	(*CreateGameV2_Request)(nil),                 // 56: api.CreateGameV2.Request
+	(*CreateGameV2_Response)(nil),                // 57: api.CreateGameV2.Response
+	(*CreateGameV3_Request)(nil),                 // 58: api.CreateGameV3.Request
+	(*CreateGameV3_Response)(nil),                // 59: api.CreateGameV3.Response
+	(*GetGames_Request)(nil),                     // 60: api.GetGames.Request
+	(*GetGames_Response)(nil),                    // 61: api.GetGames.Response
+	(*GetGame_Request)(nil),                      // 62: api.GetGame.Request
+	(*GetGame_Response)(nil),                     // 63: api.GetGame.Response
+	(*GetGameHistory_Request)(nil),               // 64: api.GetGameHistory.Request
+	(*GetGameHistory_Response)(nil),              // 65: api.GetGameHistory.Response
+	(*Rematch_Request)(nil),                      // 66: api.Rematch.Request
+	(*Rematch_Response)(nil),                     // 67: api.Rematch.Response
+	(*Nudge_Request)(nil),                        // 68: api.Nudge.Request
+	(*Nudge_Response)(nil),                       // 69: api.Nudge.Response
+	(*CreateLobby_Request)(nil),                  // 70: api.CreateLobby.Request
+	(*CreateLobby_Response)(nil),                 // 71: api.CreateLobby.Response
+	(*GetLobbies_Request)(nil),                   // 72: api.GetLobbies.Request
+	(*GetLobbies_Response)(nil),                  // 73: api.GetLobbies.Response
+	(*GetLobby_Request)(nil),                     // 74: api.GetLobby.Request
+	(*GetLobby_Response)(nil),                    // 75: api.GetLobby.Response
+	(*InviteToLobby_Request)(nil),                // 76: api.InviteToLobby.Request
+	(*InviteToLobby_Response)(nil),               // 77: api.InviteToLobby.Response
+	(*AcceptInvitation_Request)(nil),             // 78: api.AcceptInvitation.Request
+	(*AcceptInvitation_Response)(nil),            // 79: api.AcceptInvitation.Response
+	(*DeclineInvitation_Request)(nil),            // 80: api.DeclineInvitation.Request
+	(*DeclineInvitation_Response)(nil),           // 81: api.DeclineInvitation.Response
+	(*StartLobby_Request)(nil),                   // 82: api.StartLobby.Request
+	(*StartLobby_Response)(nil),                  // 83: api.StartLobby.Response
+	(*JoinQueue_Request)(nil),                    // 84: api.JoinQueue.Request
+	(*JoinQueue_Response)(nil),                   // 85: api.JoinQueue.Response
+	(*LeaveQueue_Request)(nil),                   // 86: api.LeaveQueue.Request
+	(*LeaveQueue_Response)(nil),                  // 87: api.LeaveQueue.Response
+	(*GetQueueStatus_Request)(nil),               // 88: api.GetQueueStatus.Request
+	(*GetQueueStatus_Response)(nil),              // 89: api.GetQueueStatus.Response
+	(*GetDeliveryAttempts_Request)(nil),          // 90: api.GetDeliveryAttempts.Request
+	(*GetDeliveryAttempts_Response)(nil),         // 91: api.GetDeliveryAttempts.Response
+	(*CreatePreset_Request)(nil),                 // 92: api.CreatePreset.Request
+	(*CreatePreset_Response)(nil),                // 93: api.CreatePreset.Response
+	(*GetPresets_Request)(nil),                   // 94: api.GetPresets.Request
+	(*GetPresets_Response)(nil),                  // 95: api.GetPresets.Response
+	(*UpdatePreset_Request)(nil),                 // 96: api.UpdatePreset.Request
+	(*UpdatePreset_Response)(nil),                // 97: api.UpdatePreset.Response
+	(*DeletePreset_Request)(nil),                 // 98: api.DeletePreset.Request
+	(*DeletePreset_Response)(nil),                // 99: api.DeletePreset.Response
+	(*User)(nil),                                 // 100: api.User
+	(PlayerColor)(0),                             // 101: api.PlayerColor
+	(DevicePlatform)(0),                          // 102: api.DevicePlatform
+	(Board)(0),                                   // 103: api.Board
+	(Expansion)(0),                               // 104: api.Expansion
+	(RatingSystem)(0),                            // 105: api.RatingSystem
+	(*RatingChange)(nil),                         // 106: api.RatingChange
+	(*NotificationSettings)(nil),                 // 107: api.NotificationSettings
+	(*GameOptions)(nil),                          // 108: api.GameOptions
+	(*GameDetails)(nil),                          // 109: api.GameDetails
+	(*Game)(nil),                                 // 110: api.Game
+	(*GameSettings)(nil),                         // 111: api.GameSettings
+	(*Lobby)(nil),                                // 112: api.Lobby
+	(*MatchmakingPreferences)(nil),               // 113: api.MatchmakingPreferences
+	(*timestamppb.Timestamp)(nil),                // 114: google.protobuf.Timestamp
+	(*DeliveryAttempt)(nil),                      // 115: api.DeliveryAttempt
+	(*Preset)(nil),                               // 116: api.Preset
+}
+var file_pkg_api_services_proto_depIdxs = []int32{
+	100, // 0: api.Login.Response.user:type_name -> api.User
+	100, // 1: api.GetMe.Response.user:type_name -> api.User
+	101, // 2: api.UpdateMe.Request.color:type_name -> api.PlayerColor
+	100, // 3: api.UpdateMe.Response.user:type_name -> api.User
+	102, // 4: api.UpdateDeviceToken.Request.platform:type_name -> api.DevicePlatform
+	100, // 5: api.SearchUser.Response.users:type_name -> api.User
+	103, // 6: api.GetEloLeaderboard.Request.board:type_name -> api.Board
+	104, // 7: api.GetEloLeaderboard.Request.expansion:type_name -> api.Expansion
+	100, // 8: api.GetEloLeaderboard.Response.users:type_name -> api.User
+	105, // 9: api.GetEloLeaderboard.Response.rating_system:type_name -> api.RatingSystem
+	106, // 10: api.GetRatingHistory.Response.changes:type_name -> api.RatingChange
+	107, // 11: api.GetNotificationSettings.Response.settings:type_name -> api.NotificationSettings
+	107, // 12: api.UpdateNotificationSettings.Request.settings:type_name -> api.NotificationSettings
+	107, // 13: api.UpdateNotificationSettings.Response.settings:type_name -> api.NotificationSettings
+	0,   // 14: api.CreateGameV2.Request.board:type_name -> api.CreateGameV2.Board
+	108, // 15: api.CreateGameV3.Request.options:type_name -> api.GameOptions
+	109, // 16: api.CreateGameV3.Response.game:type_name -> api.GameDetails
+	110, // 17: api.GetGames.Response.games:type_name -> api.Game
+	109, // 18: api.GetGame.Response.game:type_name -> api.GameDetails
+	109, // 19: api.GetGameHistory.Response.games:type_name -> api.GameDetails
+	109, // 20: api.Rematch.Response.game:type_name -> api.GameDetails
+	111, // 21: api.CreateLobby.Request.settings:type_name -> api.GameSettings
+	112, // 22: api.CreateLobby.Response.lobby:type_name -> api.Lobby
+	112, // 23: api.GetLobbies.Response.lobbies:type_name -> api.Lobby
+	112, // 24: api.GetLobby.Response.lobby:type_name -> api.Lobby
+	112, // 25: api.InviteToLobby.Response.lobby:type_name -> api.Lobby
+	112, // 26: api.AcceptInvitation.Response.lobby:type_name -> api.Lobby
+	112, // 27: api.StartLobby.Response.lobby:type_name -> api.Lobby
+	113, // 28: api.JoinQueue.Request.preferences:type_name -> api.MatchmakingPreferences
+	114, // 29: api.JoinQueue.Response.enqueued_at:type_name -> google.protobuf.Timestamp
+	113, // 30: api.GetQueueStatus.Response.preferences:type_name -> api.MatchmakingPreferences
+	114, // 31: api.GetQueueStatus.Response.enqueued_at:type_name -> google.protobuf.Timestamp
+	115, // 32: api.GetDeliveryAttempts.Response.attempts:type_name -> api.DeliveryAttempt
+	108, // 33: api.CreatePreset.Request.options:type_name -> api.GameOptions
+	116, // 34: api.CreatePreset.Response.preset:type_name -> api.Preset
+	116, // 35: api.GetPresets.Response.presets:type_name -> api.Preset
+	108, // 36: api.UpdatePreset.Request.options:type_name -> api.GameOptions
+	116, // 37: api.UpdatePreset.Response.preset:type_name -> api.Preset
+	34,  // 38: api.Users.Login:input_type -> api.Login.Request
+	36,  // 39: api.Users.GetMe:input_type -> api.GetMe.Request
+	38,  // 40: api.Users.UpdateMe:input_type -> api.UpdateMe.Request
+	40,  // 41: api.Users.UpdateDeviceToken:input_type -> api.UpdateDeviceToken.Request
+	42,  // 42: api.Users.RegisterWebPushSubscription:input_type -> api.RegisterWebPushSubscription.Request
+	44,  // 43: api.Users.SearchUser:input_type -> api.SearchUser.Request
+	46,  // 44: api.Users.GetEloLeaderboard:input_type -> api.GetEloLeaderboard.Request
+	48,  // 45: api.Users.GetRatingHistory:input_type -> api.GetRatingHistory.Request
+	50,  // 46: api.Users.GetNotificationSettings:input_type -> api.GetNotificationSettings.Request
+	52,  // 47: api.Users.UpdateNotificationSettings:input_type -> api.UpdateNotificationSettings.Request
+	54,  // 48: api.Games.CreateGame:input_type -> api.CreateGame.Request
+	56,  // 49: api.Games.CreateGameV2:input_type -> api.CreateGameV2.Request
+	58,  // 50: api.Games.CreateGameV3:input_type -> api.CreateGameV3.Request
+	60,  // 51: api.Games.GetGames:input_type -> api.GetGames.Request
+	62,  // 52: api.Games.GetGame:input_type -> api.GetGame.Request
+	64,  // 53: api.Games.GetGameHistory:input_type -> api.GetGameHistory.Request
+	66,  // 54: api.Games.Rematch:input_type -> api.Rematch.Request
+	68,  // 55: api.Games.Nudge:input_type -> api.Nudge.Request
+	70,  // 56: api.Lobbies.CreateLobby:input_type -> api.CreateLobby.Request
+	72,  // 57: api.Lobbies.GetLobbies:input_type -> api.GetLobbies.Request
+	74,  // 58: api.Lobbies.GetLobby:input_type -> api.GetLobby.Request
+	76,  // 59: api.Lobbies.InviteToLobby:input_type -> api.InviteToLobby.Request
+	78,  // 60: api.Lobbies.AcceptInvitation:input_type -> api.AcceptInvitation.Request
+	80,  // 61: api.Lobbies.DeclineInvitation:input_type -> api.DeclineInvitation.Request
+	82,  // 62: api.Lobbies.StartLobby:input_type -> api.StartLobby.Request
+	84,  // 63: api.Matchmaking.JoinQueue:input_type -> api.JoinQueue.Request
+	86,  // 64: api.Matchmaking.LeaveQueue:input_type -> api.LeaveQueue.Request
+	88,  // 65: api.Matchmaking.GetQueueStatus:input_type -> api.GetQueueStatus.Request
+	90,  // 66: api.Admin.GetDeliveryAttempts:input_type -> api.GetDeliveryAttempts.Request
+	92,  // 67: api.Presets.CreatePreset:input_type -> api.CreatePreset.Request
+	94,  // 68: api.Presets.GetPresets:input_type -> api.GetPresets.Request
+	96,  // 69: api.Presets.UpdatePreset:input_type -> api.UpdatePreset.Request
+	98,  // 70: api.Presets.DeletePreset:input_type -> api.DeletePreset.Request
+	35,  // 71: api.Users.Login:output_type -> api.Login.Response
+	37,  // 72: api.Users.GetMe:output_type -> api.GetMe.Response
+	39,  // 73: api.Users.UpdateMe:output_type -> api.UpdateMe.Response
+	41,  // 74: api.Users.UpdateDeviceToken:output_type -> api.UpdateDeviceToken.Response
+	43,  // 75: api.Users.RegisterWebPushSubscription:output_type -> api.RegisterWebPushSubscription.Response
+	45,  // 76: api.Users.SearchUser:output_type -> api.SearchUser.Response
+	47,  // 77: api.Users.GetEloLeaderboard:output_type -> api.GetEloLeaderboard.Response
+	49,  // 78: api.Users.GetRatingHistory:output_type -> api.GetRatingHistory.Response
+	51,  // 79: api.Users.GetNotificationSettings:output_type -> api.GetNotificationSettings.Response
+	53,  // 80: api.Users.UpdateNotificationSettings:output_type -> api.UpdateNotificationSettings.Response
+	55,  // 81: api.Games.CreateGame:output_type -> api.CreateGame.Response
+	57,  // 82: api.Games.CreateGameV2:output_type -> api.CreateGameV2.Response
+	59,  // 83: api.Games.CreateGameV3:output_type -> api.CreateGameV3.Response
+	61,  // 84: api.Games.GetGames:output_type -> api.GetGames.Response
+	63,  // 85: api.Games.GetGame:output_type -> api.GetGame.Response
+	65,  // 86: api.Games.GetGameHistory:output_type -> api.GetGameHistory.Response
+	67,  // 87: api.Games.Rematch:output_type -> api.Rematch.Response
+	69,  // 88: api.Games.Nudge:output_type -> api.Nudge.Response
+	71,  // 89: api.Lobbies.CreateLobby:output_type -> api.CreateLobby.Response
+	73,  // 90: api.Lobbies.GetLobbies:output_type -> api.GetLobbies.Response
+	75,  // 91: api.Lobbies.GetLobby:output_type -> api.GetLobby.Response
+	77,  // 92: api.Lobbies.InviteToLobby:output_type -> api.InviteToLobby.Response
+	79,  // 93: api.Lobbies.AcceptInvitation:output_type -> api.AcceptInvitation.Response
+	81,  // 94: api.Lobbies.DeclineInvitation:output_type -> api.DeclineInvitation.Response
+	83,  // 95: api.Lobbies.StartLobby:output_type -> api.StartLobby.Response
+	85,  // 96: api.Matchmaking.JoinQueue:output_type -> api.JoinQueue.Response
+	87,  // 97: api.Matchmaking.LeaveQueue:output_type -> api.LeaveQueue.Response
+	89,  // 98: api.Matchmaking.GetQueueStatus:output_type -> api.GetQueueStatus.Response
+	91,  // 99: api.Admin.GetDeliveryAttempts:output_type -> api.GetDeliveryAttempts.Response
+	93,  // 100: api.Presets.CreatePreset:output_type -> api.CreatePreset.Response
+	95,  // 101: api.Presets.GetPresets:output_type -> api.GetPresets.Response
+	97,  // 102: api.Presets.UpdatePreset:output_type -> api.UpdatePreset.Response
+	99,  // 103: api.Presets.DeletePreset:output_type -> api.DeletePreset.Response
+	71,  // [71:104] is the sub-list for method output_type
+	38,  // [38:71] is the sub-list for method input_type
+	38,  // [38:38] is the sub-list for extension type_name
+	38,  // [38:38] is the sub-list for extension extendee
+	0,   // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_services_proto_init() }
+func file_pkg_api_services_proto_init() {
+	if File_pkg_api_services_proto != nil {
+		return
+	}
+	file_pkg_api_user_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_api_services_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Login); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDeviceToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterWebPushSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEloLeaderboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRatingHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateNotificationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameV3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGames); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*Rematch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Nudge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLobbies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*InviteToLobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*DeclineInvitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*StartLobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueueStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeliveryAttempts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePreset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPresets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePreset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePreset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*Login_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*Login_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMe_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMe_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMe_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMe_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDeviceToken_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDeviceToken_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterWebPushSubscription_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterWebPushSubscription_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUser_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUser_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEloLeaderboard_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GetEloLeaderboard_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRatingHistory_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRatingHistory_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationSettings_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationSettings_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateNotificationSettings_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateNotificationSettings_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGame_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGame_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameV2_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameV2_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameV3_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameV3_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGames_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGames_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGame_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGame_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameHistory_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameHistory_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*Rematch_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*Rematch_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*Nudge_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*Nudge_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLobby_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGames_Request.ProtoReflect.Descriptor instead.
-func (*GetGames_Request) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{8, 0}
-}
-
-type GetGames_Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Games []*Game `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
-}
-
-func (x *GetGames_Response) Reset() {
-	*x = GetGames_Response{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_services_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetGames_Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGames_Response) ProtoMessage() {}
-
-func (x *GetGames_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_services_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_pkg_api_services_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLobby_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGames_Response.ProtoReflect.Descriptor instead.
-func (*GetGames_Response) Descriptor() ([]byte, []int) {
-	return file_pkg_api_services_proto_rawDescGZIP(), []int{8, 1}
-}
-
-func (x *GetGames_Response) GetGames() []*Game {
-	if x != nil {
-		return x.Games
-	}
-	return nil
-}
-
-var File_pkg_api_services_proto protoreflect.FileDescriptor
-
-var file_pkg_api_services_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
-	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x6b, 0x67,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x3d, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3d,
-	0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x84, 0x01,
-	0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x1a, 0x4d, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0x29, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x2c, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x1a, 0x21, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x1a, 0x2b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x22, 0x4b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3d,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x1a, 0x23, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x02,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x1a, 0xed,
-	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x47, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x65, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x70,
-	0x6f, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c,
-	0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x75,
-	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x4e, 0x65, 0x78,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x61,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x69, 0x65, 0x73, 0x1a, 0x0a,
-	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x05, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x54, 0x48, 0x41, 0x52, 0x53, 0x49, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x48, 0x45, 0x4c, 0x4c, 0x41, 0x53, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4c, 0x59, 0x53,
-	0x49, 0x55, 0x4d, 0x10, 0x03, 0x22, 0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
-	0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x32, 0xcc, 0x05, 0x0a, 0x05, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x63, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
-	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01,
-	0x2a, 0x22, 0x15, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x5d, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x92, 0x41, 0x0e, 0x62,
-	0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2e, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61,
-	0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12,
-	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x6d, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x92, 0x41, 0x0e, 0x62, 0x0c,
-	0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x73, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a,
-	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x8a, 0x01, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x6f, 0x4c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x34, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61,
-	0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x32, 0xe1, 0x02, 0x0a, 0x05, 0x47, 0x61, 0x6d,
-	0x65, 0x73, 0x12, 0x71, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
-	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
-	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x30, 0x92, 0x41, 0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22,
-	0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x61, 0x6d, 0x65, 0x56, 0x32, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d,
-	0x65, 0x56, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x92, 0x41,
-	0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x6c,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x92, 0x41, 0x0e, 0x62, 0x0c,
-	0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x42, 0xf8, 0x01, 0x92,
-	0x41, 0xbb, 0x01, 0x52, 0x53, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x4c, 0x0a, 0x4a, 0x59, 0x6f,
-	0x75, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20,
-	0x6e, 0x6f, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x69, 0x73, 0x20,
-	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x5a, 0x64, 0x0a, 0x62, 0x0a, 0x06, 0x42, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x12, 0x58, 0x08, 0x02, 0x12, 0x43, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74,
-	0x68, 0x65, 0x20, 0x60, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x3a, 0x20, 0x60, 0x20, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65,
-	0x72, 0x20, 0x61, 0x62, 0x63, 0x64, 0x65, 0x31, 0x32, 0x33, 0x34, 0x35, 0x2e, 0x1a, 0x0d, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x5a, 0x37,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x6e, 0x75, 0x74, 0x34, 0x32, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x69,
-	0x6e, 0x67, 0x2d, 0x6d, 0x61, 0x72, 0x73, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_pkg_api_services_proto_rawDescOnce sync.Once
-	file_pkg_api_services_proto_rawDescData = file_pkg_api_services_proto_rawDesc
-)
-
-func file_pkg_api_services_proto_rawDescGZIP() []byte {
-	file_pkg_api_services_proto_rawDescOnce.Do(func() {
-		file_pkg_api_services_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_services_proto_rawDescData)
-	})
-	return file_pkg_api_services_proto_rawDescData
-}
-
-var file_pkg_api_services_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_api_services_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_pkg_api_services_proto_goTypes = []any{
-	(CreateGameV2_Board)(0),            // 0: api.CreateGameV2.Board
-	(*Login)(nil),                      // 1: api.Login
-	(*GetMe)(nil),                      // 2: api.GetMe
-	(*UpdateMe)(nil),                   // 3: api.UpdateMe
-	(*UpdateDeviceToken)(nil),          // 4: api.UpdateDeviceToken
-	(*SearchUser)(nil),                 // 5: api.SearchUser
-	(*GetEloLeaderboard)(nil),          // 6: api.GetEloLeaderboard
-	(*CreateGame)(nil),                 // 7: api.CreateGame
-	(*CreateGameV2)(nil),               // 8: api.CreateGameV2
-	(*GetGames)(nil),                   // 9: api.GetGames
-	(*Login_Request)(nil),              // 10: api.Login.Request
-	(*Login_Response)(nil),             // 11: api.Login.Response
-	(*GetMe_Request)(nil),              // 12: api.GetMe.Request
-	(*GetMe_Response)(nil),             // 13: api.GetMe.Response
-	(*UpdateMe_Request)(nil),           // 14: api.UpdateMe.Request
-	(*UpdateMe_Response)(nil),          // 15: api.UpdateMe.Response
-	(*UpdateDeviceToken_Request)(nil),  // 16: api.UpdateDeviceToken.Request
-	(*UpdateDeviceToken_Response)(nil), // 17: api.UpdateDeviceToken.Response
-	(*SearchUser_Request)(nil),         // 18: api.SearchUser.Request
-	(*SearchUser_Response)(nil),        // 19: api.SearchUser.Response
-	(*GetEloLeaderboard_Request)(nil),  // 20: api.GetEloLeaderboard.Request
-	(*GetEloLeaderboard_Response)(nil), // 21: api.GetEloLeaderboard.Response
-	(*CreateGame_Request)(nil),         // 22: api.CreateGame.Request
-	(*CreateGame_Response)(nil),        // 23: api.CreateGame.Response
-	(*CreateGameV2_Request)(nil),       // 24: api.CreateGameV2.Request
-	(*CreateGameV2_Response)(nil),      // 25: api.CreateGameV2.Response
-	(*GetGames_Request)(nil),           // 26: api.GetGames.Request
-	(*GetGames_Response)(nil),          // 27: api.GetGames.Response
-	(*User)(nil),                       // 28: api.User
-	(PlayerColor)(0),                   // 29: api.PlayerColor
-	(*Game)(nil),                       // 30: api.Game
-}
-var file_pkg_api_services_proto_depIdxs = []int32{
-	28, // 0: api.Login.Response.user:type_name -> api.User
-	28, // 1: api.GetMe.Response.user:type_name -> api.User
-	29, // 2: api.UpdateMe.Request.color:type_name -> api.PlayerColor
-	28, // 3: api.UpdateMe.Response.user:type_name -> api.User
-	28, // 4: api.SearchUser.Response.users:type_name -> api.User
-	28, // 5: api.GetEloLeaderboard.Response.users:type_name -> api.User
-	0,  // 6: api.CreateGameV2.Request.board:type_name -> api.CreateGameV2.Board
-	30, // 7: api.GetGames.Response.games:type_name -> api.Game
-	10, // 8: api.Users.Login:input_type -> api.Login.Request
-	12, // 9: api.Users.GetMe:input_type -> api.GetMe.Request
-	14, // 10: api.Users.UpdateMe:input_type -> api.UpdateMe.Request
-	16, // 11: api.Users.UpdateDeviceToken:input_type -> api.UpdateDeviceToken.Request
-	18, // 12: api.Users.SearchUser:input_type -> api.SearchUser.Request
-	20, // 13: api.Users.GetEloLeaderboard:input_type -> api.GetEloLeaderboard.Request
-	22, // 14: api.Games.CreateGame:input_type -> api.CreateGame.Request
-	24, // 15: api.Games.CreateGameV2:input_type -> api.CreateGameV2.Request
-	26, // 16: api.Games.GetGames:input_type -> api.GetGames.Request
-	11, // 17: api.Users.Login:output_type -> api.Login.Response
-	13, // 18: api.Users.GetMe:output_type -> api.GetMe.Response
-	15, // 19: api.Users.UpdateMe:output_type -> api.UpdateMe.Response
-	17, // 20: api.Users.UpdateDeviceToken:output_type -> api.UpdateDeviceToken.Response
-	19, // 21: api.Users.SearchUser:output_type -> api.SearchUser.Response
-	21, // 22: api.Users.GetEloLeaderboard:output_type -> api.GetEloLeaderboard.Response
-	23, // 23: api.Games.CreateGame:output_type -> api.CreateGame.Response
-	25, // 24: api.Games.CreateGameV2:output_type -> api.CreateGameV2.Response
-	27, // 25: api.Games.GetGames:output_type -> api.GetGames.Response
-	17, // [17:26] is the sub-list for method output_type
-	8,  // [8:17] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_pkg_api_services_proto_init() }
-func file_pkg_api_services_proto_init() {
-	if File_pkg_api_services_proto != nil {
-		return
-	}
-	file_pkg_api_user_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_api_services_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Login); i {
+		file_pkg_api_services_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLobbies_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1513,8 +6293,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMe); i {
+		file_pkg_api_services_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLobbies_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1525,8 +6305,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateMe); i {
+		file_pkg_api_services_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLobby_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1537,8 +6317,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeviceToken); i {
+		file_pkg_api_services_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLobby_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1549,8 +6329,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUser); i {
+		file_pkg_api_services_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*InviteToLobby_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1561,8 +6341,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetEloLeaderboard); i {
+		file_pkg_api_services_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*InviteToLobby_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1573,8 +6353,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGame); i {
+		file_pkg_api_services_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptInvitation_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1585,8 +6365,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGameV2); i {
+		file_pkg_api_services_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptInvitation_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1597,8 +6377,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGames); i {
+		file_pkg_api_services_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*DeclineInvitation_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1609,8 +6389,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*Login_Request); i {
+		file_pkg_api_services_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*DeclineInvitation_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1621,8 +6401,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*Login_Response); i {
+		file_pkg_api_services_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*StartLobby_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1633,8 +6413,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMe_Request); i {
+		file_pkg_api_services_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*StartLobby_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1645,8 +6425,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMe_Response); i {
+		file_pkg_api_services_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinQueue_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1657,8 +6437,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateMe_Request); i {
+		file_pkg_api_services_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*JoinQueue_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1669,8 +6449,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateMe_Response); i {
+		file_pkg_api_services_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveQueue_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1681,8 +6461,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeviceToken_Request); i {
+		file_pkg_api_services_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveQueue_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1693,8 +6473,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeviceToken_Response); i {
+		file_pkg_api_services_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueueStatus_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1705,8 +6485,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUser_Request); i {
+		file_pkg_api_services_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueueStatus_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1717,8 +6497,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUser_Response); i {
+		file_pkg_api_services_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeliveryAttempts_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1729,8 +6509,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*GetEloLeaderboard_Request); i {
+		file_pkg_api_services_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeliveryAttempts_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1741,8 +6521,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*GetEloLeaderboard_Response); i {
+		file_pkg_api_services_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePreset_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1753,8 +6533,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGame_Request); i {
+		file_pkg_api_services_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePreset_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1765,8 +6545,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGame_Response); i {
+		file_pkg_api_services_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPresets_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1777,8 +6557,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGameV2_Request); i {
+		file_pkg_api_services_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPresets_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1789,8 +6569,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateGameV2_Response); i {
+		file_pkg_api_services_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePreset_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1801,8 +6581,8 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGames_Request); i {
+		file_pkg_api_services_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePreset_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1813,8 +6593,20 @@ func file_pkg_api_services_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_services_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGames_Response); i {
+		file_pkg_api_services_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePreset_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_services_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePreset_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,9 +6624,9 @@ func file_pkg_api_services_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_api_services_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   27,
+			NumMessages:   99,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   6,
 		},
 		GoTypes:           file_pkg_api_services_proto_goTypes,
 		DependencyIndexes: file_pkg_api_services_proto_depIdxs,
@@ -20,12 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Users_Login_FullMethodName             = "/api.Users/Login"
-	Users_GetMe_FullMethodName             = "/api.Users/GetMe"
-	Users_UpdateMe_FullMethodName          = "/api.Users/UpdateMe"
-	Users_UpdateDeviceToken_FullMethodName = "/api.Users/UpdateDeviceToken"
-	Users_SearchUser_FullMethodName        = "/api.Users/SearchUser"
-	Users_GetEloLeaderboard_FullMethodName = "/api.Users/GetEloLeaderboard"
+	Users_Login_FullMethodName                       = "/api.Users/Login"
+	Users_GetMe_FullMethodName                       = "/api.Users/GetMe"
+	Users_UpdateMe_FullMethodName                    = "/api.Users/UpdateMe"
+	Users_UpdateDeviceToken_FullMethodName           = "/api.Users/UpdateDeviceToken"
+	Users_RegisterWebPushSubscription_FullMethodName = "/api.Users/RegisterWebPushSubscription"
+	Users_SearchUser_FullMethodName                  = "/api.Users/SearchUser"
+	Users_GetEloLeaderboard_FullMethodName           = "/api.Users/GetEloLeaderboard"
+	Users_GetRatingHistory_FullMethodName            = "/api.Users/GetRatingHistory"
+	Users_GetNotificationSettings_FullMethodName     = "/api.Users/GetNotificationSettings"
+	Users_UpdateNotificationSettings_FullMethodName  = "/api.Users/UpdateNotificationSettings"
 )
 
 // UsersClient is the client API for Users service.
@@ -36,8 +40,14 @@ type UsersClient interface {
 	GetMe(ctx context.Context, in *GetMe_Request, opts ...grpc.CallOption) (*GetMe_Response, error)
 	UpdateMe(ctx context.Context, in *UpdateMe_Request, opts ...grpc.CallOption) (*UpdateMe_Response, error)
 	UpdateDeviceToken(ctx context.Context, in *UpdateDeviceToken_Request, opts ...grpc.CallOption) (*UpdateDeviceToken_Response, error)
+	// Registers a browser push subscription. Without authorization the user is identified
+	// by a player id of theirs, as the Mars player page has nothing else.
+	RegisterWebPushSubscription(ctx context.Context, in *RegisterWebPushSubscription_Request, opts ...grpc.CallOption) (*RegisterWebPushSubscription_Response, error)
 	SearchUser(ctx context.Context, in *SearchUser_Request, opts ...grpc.CallOption) (*SearchUser_Response, error)
 	GetEloLeaderboard(ctx context.Context, in *GetEloLeaderboard_Request, opts ...grpc.CallOption) (*GetEloLeaderboard_Response, error)
+	GetRatingHistory(ctx context.Context, in *GetRatingHistory_Request, opts ...grpc.CallOption) (*GetRatingHistory_Response, error)
+	GetNotificationSettings(ctx context.Context, in *GetNotificationSettings_Request, opts ...grpc.CallOption) (*GetNotificationSettings_Response, error)
+	UpdateNotificationSettings(ctx context.Context, in *UpdateNotificationSettings_Request, opts ...grpc.CallOption) (*UpdateNotificationSettings_Response, error)
 }
 
 type usersClient struct {
@@ -88,6 +98,16 @@ func (c *usersClient) UpdateDeviceToken(ctx context.Context, in *UpdateDeviceTok
 	return out, nil
 }
 
+func (c *usersClient) RegisterWebPushSubscription(ctx context.Context, in *RegisterWebPushSubscription_Request, opts ...grpc.CallOption) (*RegisterWebPushSubscription_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterWebPushSubscription_Response)
+	err := c.cc.Invoke(ctx, Users_RegisterWebPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersClient) SearchUser(ctx context.Context, in *SearchUser_Request, opts ...grpc.CallOption) (*SearchUser_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchUser_Response)
@@ -108,6 +128,36 @@ func (c *usersClient) GetEloLeaderboard(ctx context.Context, in *GetEloLeaderboa
 	return out, nil
 }
 
+func (c *usersClient) GetRatingHistory(ctx context.Context, in *GetRatingHistory_Request, opts ...grpc.CallOption) (*GetRatingHistory_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatingHistory_Response)
+	err := c.cc.Invoke(ctx, Users_GetRatingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) GetNotificationSettings(ctx context.Context, in *GetNotificationSettings_Request, opts ...grpc.CallOption) (*GetNotificationSettings_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationSettings_Response)
+	err := c.cc.Invoke(ctx, Users_GetNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersClient) UpdateNotificationSettings(ctx context.Context, in *UpdateNotificationSettings_Request, opts ...grpc.CallOption) (*UpdateNotificationSettings_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationSettings_Response)
+	err := c.cc.Invoke(ctx, Users_UpdateNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServer is the server API for Users service.
 // All implementations must embed UnimplementedUsersServer
 // for forward compatibility
@@ -116,8 +166,14 @@ type UsersServer interface {
 	GetMe(context.Context, *GetMe_Request) (*GetMe_Response, error)
 	UpdateMe(context.Context, *UpdateMe_Request) (*UpdateMe_Response, error)
 	UpdateDeviceToken(context.Context, *UpdateDeviceToken_Request) (*UpdateDeviceToken_Response, error)
+	// Registers a browser push subscription. Without authorization the user is identified
+	// by a player id of theirs, as the Mars player page has nothing else.
+	RegisterWebPushSubscription(context.Context, *RegisterWebPushSubscription_Request) (*RegisterWebPushSubscription_Response, error)
 	SearchUser(context.Context, *SearchUser_Request) (*SearchUser_Response, error)
 	GetEloLeaderboard(context.Context, *GetEloLeaderboard_Request) (*GetEloLeaderboard_Response, error)
+	GetRatingHistory(context.Context, *GetRatingHistory_Request) (*GetRatingHistory_Response, error)
+	GetNotificationSettings(context.Context, *GetNotificationSettings_Request) (*GetNotificationSettings_Response, error)
+	UpdateNotificationSettings(context.Context, *UpdateNotificationSettings_Request) (*UpdateNotificationSettings_Response, error)
 	mustEmbedUnimplementedUsersServer()
 }
 
@@ -137,12 +193,24 @@ func (UnimplementedUsersServer) UpdateMe(context.Context, *UpdateMe_Request) (*U
 func (UnimplementedUsersServer) UpdateDeviceToken(context.Context, *UpdateDeviceToken_Request) (*UpdateDeviceToken_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeviceToken not implemented")
 }
+func (UnimplementedUsersServer) RegisterWebPushSubscription(context.Context, *RegisterWebPushSubscription_Request) (*RegisterWebPushSubscription_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebPushSubscription not implemented")
+}
 func (UnimplementedUsersServer) SearchUser(context.Context, *SearchUser_Request) (*SearchUser_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUser not implemented")
 }
 func (UnimplementedUsersServer) GetEloLeaderboard(context.Context, *GetEloLeaderboard_Request) (*GetEloLeaderboard_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEloLeaderboard not implemented")
 }
+func (UnimplementedUsersServer) GetRatingHistory(context.Context, *GetRatingHistory_Request) (*GetRatingHistory_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingHistory not implemented")
+}
+func (UnimplementedUsersServer) GetNotificationSettings(context.Context, *GetNotificationSettings_Request) (*GetNotificationSettings_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
+}
+func (UnimplementedUsersServer) UpdateNotificationSettings(context.Context, *UpdateNotificationSettings_Request) (*UpdateNotificationSettings_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationSettings not implemented")
+}
 func (UnimplementedUsersServer) mustEmbedUnimplementedUsersServer() {}
 
 // UnsafeUsersServer may be embedded to opt out of forward compatibility for this service.
@@ -228,6 +296,24 @@ func _Users_UpdateDeviceToken_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_RegisterWebPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebPushSubscription_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).RegisterWebPushSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_RegisterWebPushSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).RegisterWebPushSubscription(ctx, req.(*RegisterWebPushSubscription_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Users_SearchUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchUser_Request)
 	if err := dec(in); err != nil {
@@ -264,6 +350,60 @@ func _Users_GetEloLeaderboard_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Users_GetRatingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingHistory_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetRatingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_GetRatingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetRatingHistory(ctx, req.(*GetRatingHistory_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationSettings_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).GetNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_GetNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).GetNotificationSettings(ctx, req.(*GetNotificationSettings_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Users_UpdateNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationSettings_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServer).UpdateNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Users_UpdateNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServer).UpdateNotificationSettings(ctx, req.(*UpdateNotificationSettings_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Users_ServiceDesc is the grpc.ServiceDesc for Users service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -287,6 +427,10 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDeviceToken",
 			Handler:    _Users_UpdateDeviceToken_Handler,
 		},
+		{
+			MethodName: "RegisterWebPushSubscription",
+			Handler:    _Users_RegisterWebPushSubscription_Handler,
+		},
 		{
 			MethodName: "SearchUser",
 			Handler:    _Users_SearchUser_Handler,
@@ -295,15 +439,32 @@ var Users_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEloLeaderboard",
 			Handler:    _Users_GetEloLeaderboard_Handler,
 		},
+		{
+			MethodName: "GetRatingHistory",
+			Handler:    _Users_GetRatingHistory_Handler,
+		},
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _Users_GetNotificationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationSettings",
+			Handler:    _Users_UpdateNotificationSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/services.proto",
 }
 
 const (
-	Games_CreateGame_FullMethodName   = "/api.Games/CreateGame"
-	Games_CreateGameV2_FullMethodName = "/api.Games/CreateGameV2"
-	Games_GetGames_FullMethodName     = "/api.Games/GetGames"
+	Games_CreateGame_FullMethodName     = "/api.Games/CreateGame"
+	Games_CreateGameV2_FullMethodName   = "/api.Games/CreateGameV2"
+	Games_CreateGameV3_FullMethodName   = "/api.Games/CreateGameV3"
+	Games_GetGames_FullMethodName       = "/api.Games/GetGames"
+	Games_GetGame_FullMethodName        = "/api.Games/GetGame"
+	Games_GetGameHistory_FullMethodName = "/api.Games/GetGameHistory"
+	Games_Rematch_FullMethodName        = "/api.Games/Rematch"
+	Games_Nudge_FullMethodName          = "/api.Games/Nudge"
 )
 
 // GamesClient is the client API for Games service.
@@ -312,7 +473,17 @@ const (
 type GamesClient interface {
 	CreateGame(ctx context.Context, in *CreateGame_Request, opts ...grpc.CallOption) (*CreateGame_Response, error)
 	CreateGameV2(ctx context.Context, in *CreateGameV2_Request, opts ...grpc.CallOption) (*CreateGameV2_Response, error)
+	// CreateGameV3 exposes all the options of the Mars server.
+	// Options are validated against the Mars server rules, e.g. Moon requires Corporate Era.
+	CreateGameV3(ctx context.Context, in *CreateGameV3_Request, opts ...grpc.CallOption) (*CreateGameV3_Response, error)
 	GetGames(ctx context.Context, in *GetGames_Request, opts ...grpc.CallOption) (*GetGames_Response, error)
+	GetGame(ctx context.Context, in *GetGame_Request, opts ...grpc.CallOption) (*GetGame_Response, error)
+	GetGameHistory(ctx context.Context, in *GetGameHistory_Request, opts ...grpc.CallOption) (*GetGameHistory_Response, error)
+	// Rematch creates a new game with the players and settings of a finished game.
+	// A game is rematched once, the existing rematch is returned when asked again.
+	Rematch(ctx context.Context, in *Rematch_Request, opts ...grpc.CallOption) (*Rematch_Response, error)
+	// Nudge reminds players the game is waiting for, a game can be nudged once in a while
+	Nudge(ctx context.Context, in *Nudge_Request, opts ...grpc.CallOption) (*Nudge_Response, error)
 }
 
 type gamesClient struct {
@@ -343,6 +514,16 @@ func (c *gamesClient) CreateGameV2(ctx context.Context, in *CreateGameV2_Request
 	return out, nil
 }
 
+func (c *gamesClient) CreateGameV3(ctx context.Context, in *CreateGameV3_Request, opts ...grpc.CallOption) (*CreateGameV3_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGameV3_Response)
+	err := c.cc.Invoke(ctx, Games_CreateGameV3_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gamesClient) GetGames(ctx context.Context, in *GetGames_Request, opts ...grpc.CallOption) (*GetGames_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetGames_Response)
@@ -353,13 +534,63 @@ func (c *gamesClient) GetGames(ctx context.Context, in *GetGames_Request, opts .
 	return out, nil
 }
 
+func (c *gamesClient) GetGame(ctx context.Context, in *GetGame_Request, opts ...grpc.CallOption) (*GetGame_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGame_Response)
+	err := c.cc.Invoke(ctx, Games_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesClient) GetGameHistory(ctx context.Context, in *GetGameHistory_Request, opts ...grpc.CallOption) (*GetGameHistory_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameHistory_Response)
+	err := c.cc.Invoke(ctx, Games_GetGameHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesClient) Rematch(ctx context.Context, in *Rematch_Request, opts ...grpc.CallOption) (*Rematch_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Rematch_Response)
+	err := c.cc.Invoke(ctx, Games_Rematch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gamesClient) Nudge(ctx context.Context, in *Nudge_Request, opts ...grpc.CallOption) (*Nudge_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Nudge_Response)
+	err := c.cc.Invoke(ctx, Games_Nudge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GamesServer is the server API for Games service.
 // All implementations must embed UnimplementedGamesServer
 // for forward compatibility
 type GamesServer interface {
 	CreateGame(context.Context, *CreateGame_Request) (*CreateGame_Response, error)
 	CreateGameV2(context.Context, *CreateGameV2_Request) (*CreateGameV2_Response, error)
+	// CreateGameV3 exposes all the options of the Mars server.
+	// Options are validated against the Mars server rules, e.g. Moon requires Corporate Era.
+	CreateGameV3(context.Context, *CreateGameV3_Request) (*CreateGameV3_Response, error)
 	GetGames(context.Context, *GetGames_Request) (*GetGames_Response, error)
+	GetGame(context.Context, *GetGame_Request) (*GetGame_Response, error)
+	GetGameHistory(context.Context, *GetGameHistory_Request) (*GetGameHistory_Response, error)
+	// Rematch creates a new game with the players and settings of a finished game.
+	// A game is rematched once, the existing rematch is returned when asked again.
+	Rematch(context.Context, *Rematch_Request) (*Rematch_Response, error)
+	// Nudge reminds players the game is waiting for, a game can be nudged once in a while
+	Nudge(context.Context, *Nudge_Request) (*Nudge_Response, error)
 	mustEmbedUnimplementedGamesServer()
 }
 
@@ -373,9 +604,24 @@ func (UnimplementedGamesServer) CreateGame(context.Context, *CreateGame_Request)
 func (UnimplementedGamesServer) CreateGameV2(context.Context, *CreateGameV2_Request) (*CreateGameV2_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGameV2 not implemented")
 }
+func (UnimplementedGamesServer) CreateGameV3(context.Context, *CreateGameV3_Request) (*CreateGameV3_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGameV3 not implemented")
+}
 func (UnimplementedGamesServer) GetGames(context.Context, *GetGames_Request) (*GetGames_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGames not implemented")
 }
+func (UnimplementedGamesServer) GetGame(context.Context, *GetGame_Request) (*GetGame_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedGamesServer) GetGameHistory(context.Context, *GetGameHistory_Request) (*GetGameHistory_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameHistory not implemented")
+}
+func (UnimplementedGamesServer) Rematch(context.Context, *Rematch_Request) (*Rematch_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rematch not implemented")
+}
+func (UnimplementedGamesServer) Nudge(context.Context, *Nudge_Request) (*Nudge_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nudge not implemented")
+}
 func (UnimplementedGamesServer) mustEmbedUnimplementedGamesServer() {}
 
 // UnsafeGamesServer may be embedded to opt out of forward compatibility for this service.
@@ -425,6 +671,24 @@ func _Games_CreateGameV2_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Games_CreateGameV3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameV3_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServer).CreateGameV3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Games_CreateGameV3_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServer).CreateGameV3(ctx, req.(*CreateGameV3_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Games_GetGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGames_Request)
 	if err := dec(in); err != nil {
@@ -443,6 +707,78 @@ func _Games_GetGames_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Games_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGame_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Games_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServer).GetGame(ctx, req.(*GetGame_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Games_GetGameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameHistory_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServer).GetGameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Games_GetGameHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServer).GetGameHistory(ctx, req.(*GetGameHistory_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Games_Rematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Rematch_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServer).Rematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Games_Rematch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServer).Rematch(ctx, req.(*Rematch_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Games_Nudge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Nudge_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamesServer).Nudge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Games_Nudge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamesServer).Nudge(ctx, req.(*Nudge_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Games_ServiceDesc is the grpc.ServiceDesc for Games service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -458,10 +794,844 @@ var Games_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateGameV2",
 			Handler:    _Games_CreateGameV2_Handler,
 		},
+		{
+			MethodName: "CreateGameV3",
+			Handler:    _Games_CreateGameV3_Handler,
+		},
 		{
 			MethodName: "GetGames",
 			Handler:    _Games_GetGames_Handler,
 		},
+		{
+			MethodName: "GetGame",
+			Handler:    _Games_GetGame_Handler,
+		},
+		{
+			MethodName: "GetGameHistory",
+			Handler:    _Games_GetGameHistory_Handler,
+		},
+		{
+			MethodName: "Rematch",
+			Handler:    _Games_Rematch_Handler,
+		},
+		{
+			MethodName: "Nudge",
+			Handler:    _Games_Nudge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/services.proto",
+}
+
+const (
+	Lobbies_CreateLobby_FullMethodName       = "/api.Lobbies/CreateLobby"
+	Lobbies_GetLobbies_FullMethodName        = "/api.Lobbies/GetLobbies"
+	Lobbies_GetLobby_FullMethodName          = "/api.Lobbies/GetLobby"
+	Lobbies_InviteToLobby_FullMethodName     = "/api.Lobbies/InviteToLobby"
+	Lobbies_AcceptInvitation_FullMethodName  = "/api.Lobbies/AcceptInvitation"
+	Lobbies_DeclineInvitation_FullMethodName = "/api.Lobbies/DeclineInvitation"
+	Lobbies_StartLobby_FullMethodName        = "/api.Lobbies/StartLobby"
+)
+
+// LobbiesClient is the client API for Lobbies service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Lobbies gather players before a game is created. The game starts with the players who accepted
+// once every invitation is answered, or earlier by the host.
+type LobbiesClient interface {
+	CreateLobby(ctx context.Context, in *CreateLobby_Request, opts ...grpc.CallOption) (*CreateLobby_Response, error)
+	// Open and starting lobbies the user is a member of or is invited to
+	GetLobbies(ctx context.Context, in *GetLobbies_Request, opts ...grpc.CallOption) (*GetLobbies_Response, error)
+	GetLobby(ctx context.Context, in *GetLobby_Request, opts ...grpc.CallOption) (*GetLobby_Response, error)
+	// Only the host can invite
+	InviteToLobby(ctx context.Context, in *InviteToLobby_Request, opts ...grpc.CallOption) (*InviteToLobby_Response, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitation_Request, opts ...grpc.CallOption) (*AcceptInvitation_Response, error)
+	DeclineInvitation(ctx context.Context, in *DeclineInvitation_Request, opts ...grpc.CallOption) (*DeclineInvitation_Response, error)
+	// Only the host can start the game before everyone accepts, pending players are left out
+	StartLobby(ctx context.Context, in *StartLobby_Request, opts ...grpc.CallOption) (*StartLobby_Response, error)
+}
+
+type lobbiesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLobbiesClient(cc grpc.ClientConnInterface) LobbiesClient {
+	return &lobbiesClient{cc}
+}
+
+func (c *lobbiesClient) CreateLobby(ctx context.Context, in *CreateLobby_Request, opts ...grpc.CallOption) (*CreateLobby_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLobby_Response)
+	err := c.cc.Invoke(ctx, Lobbies_CreateLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbiesClient) GetLobbies(ctx context.Context, in *GetLobbies_Request, opts ...grpc.CallOption) (*GetLobbies_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLobbies_Response)
+	err := c.cc.Invoke(ctx, Lobbies_GetLobbies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbiesClient) GetLobby(ctx context.Context, in *GetLobby_Request, opts ...grpc.CallOption) (*GetLobby_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLobby_Response)
+	err := c.cc.Invoke(ctx, Lobbies_GetLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbiesClient) InviteToLobby(ctx context.Context, in *InviteToLobby_Request, opts ...grpc.CallOption) (*InviteToLobby_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteToLobby_Response)
+	err := c.cc.Invoke(ctx, Lobbies_InviteToLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbiesClient) AcceptInvitation(ctx context.Context, in *AcceptInvitation_Request, opts ...grpc.CallOption) (*AcceptInvitation_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInvitation_Response)
+	err := c.cc.Invoke(ctx, Lobbies_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbiesClient) DeclineInvitation(ctx context.Context, in *DeclineInvitation_Request, opts ...grpc.CallOption) (*DeclineInvitation_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineInvitation_Response)
+	err := c.cc.Invoke(ctx, Lobbies_DeclineInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbiesClient) StartLobby(ctx context.Context, in *StartLobby_Request, opts ...grpc.CallOption) (*StartLobby_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartLobby_Response)
+	err := c.cc.Invoke(ctx, Lobbies_StartLobby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LobbiesServer is the server API for Lobbies service.
+// All implementations must embed UnimplementedLobbiesServer
+// for forward compatibility
+//
+// Lobbies gather players before a game is created. The game starts with the players who accepted
+// once every invitation is answered, or earlier by the host.
+type LobbiesServer interface {
+	CreateLobby(context.Context, *CreateLobby_Request) (*CreateLobby_Response, error)
+	// Open and starting lobbies the user is a member of or is invited to
+	GetLobbies(context.Context, *GetLobbies_Request) (*GetLobbies_Response, error)
+	GetLobby(context.Context, *GetLobby_Request) (*GetLobby_Response, error)
+	// Only the host can invite
+	InviteToLobby(context.Context, *InviteToLobby_Request) (*InviteToLobby_Response, error)
+	AcceptInvitation(context.Context, *AcceptInvitation_Request) (*AcceptInvitation_Response, error)
+	DeclineInvitation(context.Context, *DeclineInvitation_Request) (*DeclineInvitation_Response, error)
+	// Only the host can start the game before everyone accepts, pending players are left out
+	StartLobby(context.Context, *StartLobby_Request) (*StartLobby_Response, error)
+	mustEmbedUnimplementedLobbiesServer()
+}
+
+// UnimplementedLobbiesServer must be embedded to have forward compatible implementations.
+type UnimplementedLobbiesServer struct {
+}
+
+func (UnimplementedLobbiesServer) CreateLobby(context.Context, *CreateLobby_Request) (*CreateLobby_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLobby not implemented")
+}
+func (UnimplementedLobbiesServer) GetLobbies(context.Context, *GetLobbies_Request) (*GetLobbies_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLobbies not implemented")
+}
+func (UnimplementedLobbiesServer) GetLobby(context.Context, *GetLobby_Request) (*GetLobby_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLobby not implemented")
+}
+func (UnimplementedLobbiesServer) InviteToLobby(context.Context, *InviteToLobby_Request) (*InviteToLobby_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToLobby not implemented")
+}
+func (UnimplementedLobbiesServer) AcceptInvitation(context.Context, *AcceptInvitation_Request) (*AcceptInvitation_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedLobbiesServer) DeclineInvitation(context.Context, *DeclineInvitation_Request) (*DeclineInvitation_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
+func (UnimplementedLobbiesServer) StartLobby(context.Context, *StartLobby_Request) (*StartLobby_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLobby not implemented")
+}
+func (UnimplementedLobbiesServer) mustEmbedUnimplementedLobbiesServer() {}
+
+// UnsafeLobbiesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LobbiesServer will
+// result in compilation errors.
+type UnsafeLobbiesServer interface {
+	mustEmbedUnimplementedLobbiesServer()
+}
+
+func RegisterLobbiesServer(s grpc.ServiceRegistrar, srv LobbiesServer) {
+	s.RegisterService(&Lobbies_ServiceDesc, srv)
+}
+
+func _Lobbies_CreateLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLobby_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).CreateLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_CreateLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).CreateLobby(ctx, req.(*CreateLobby_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lobbies_GetLobbies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLobbies_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).GetLobbies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_GetLobbies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).GetLobbies(ctx, req.(*GetLobbies_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lobbies_GetLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLobby_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).GetLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_GetLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).GetLobby(ctx, req.(*GetLobby_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lobbies_InviteToLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToLobby_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).InviteToLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_InviteToLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).InviteToLobby(ctx, req.(*InviteToLobby_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lobbies_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitation_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).AcceptInvitation(ctx, req.(*AcceptInvitation_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lobbies_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInvitation_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_DeclineInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).DeclineInvitation(ctx, req.(*DeclineInvitation_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lobbies_StartLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartLobby_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbiesServer).StartLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lobbies_StartLobby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbiesServer).StartLobby(ctx, req.(*StartLobby_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Lobbies_ServiceDesc is the grpc.ServiceDesc for Lobbies service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Lobbies_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Lobbies",
+	HandlerType: (*LobbiesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLobby",
+			Handler:    _Lobbies_CreateLobby_Handler,
+		},
+		{
+			MethodName: "GetLobbies",
+			Handler:    _Lobbies_GetLobbies_Handler,
+		},
+		{
+			MethodName: "GetLobby",
+			Handler:    _Lobbies_GetLobby_Handler,
+		},
+		{
+			MethodName: "InviteToLobby",
+			Handler:    _Lobbies_InviteToLobby_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _Lobbies_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _Lobbies_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "StartLobby",
+			Handler:    _Lobbies_StartLobby_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/services.proto",
+}
+
+const (
+	Matchmaking_JoinQueue_FullMethodName      = "/api.Matchmaking/JoinQueue"
+	Matchmaking_LeaveQueue_FullMethodName     = "/api.Matchmaking/LeaveQueue"
+	Matchmaking_GetQueueStatus_FullMethodName = "/api.Matchmaking/GetQueueStatus"
+)
+
+// MatchmakingClient is the client API for Matchmaking service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Matchmaking creates games for compatible players waiting in the queue
+type MatchmakingClient interface {
+	// Joining again replaces the preferences, the wait starts over
+	JoinQueue(ctx context.Context, in *JoinQueue_Request, opts ...grpc.CallOption) (*JoinQueue_Response, error)
+	LeaveQueue(ctx context.Context, in *LeaveQueue_Request, opts ...grpc.CallOption) (*LeaveQueue_Response, error)
+	GetQueueStatus(ctx context.Context, in *GetQueueStatus_Request, opts ...grpc.CallOption) (*GetQueueStatus_Response, error)
+}
+
+type matchmakingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchmakingClient(cc grpc.ClientConnInterface) MatchmakingClient {
+	return &matchmakingClient{cc}
+}
+
+func (c *matchmakingClient) JoinQueue(ctx context.Context, in *JoinQueue_Request, opts ...grpc.CallOption) (*JoinQueue_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinQueue_Response)
+	err := c.cc.Invoke(ctx, Matchmaking_JoinQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchmakingClient) LeaveQueue(ctx context.Context, in *LeaveQueue_Request, opts ...grpc.CallOption) (*LeaveQueue_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveQueue_Response)
+	err := c.cc.Invoke(ctx, Matchmaking_LeaveQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchmakingClient) GetQueueStatus(ctx context.Context, in *GetQueueStatus_Request, opts ...grpc.CallOption) (*GetQueueStatus_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueueStatus_Response)
+	err := c.cc.Invoke(ctx, Matchmaking_GetQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MatchmakingServer is the server API for Matchmaking service.
+// All implementations must embed UnimplementedMatchmakingServer
+// for forward compatibility
+//
+// Matchmaking creates games for compatible players waiting in the queue
+type MatchmakingServer interface {
+	// Joining again replaces the preferences, the wait starts over
+	JoinQueue(context.Context, *JoinQueue_Request) (*JoinQueue_Response, error)
+	LeaveQueue(context.Context, *LeaveQueue_Request) (*LeaveQueue_Response, error)
+	GetQueueStatus(context.Context, *GetQueueStatus_Request) (*GetQueueStatus_Response, error)
+	mustEmbedUnimplementedMatchmakingServer()
+}
+
+// UnimplementedMatchmakingServer must be embedded to have forward compatible implementations.
+type UnimplementedMatchmakingServer struct {
+}
+
+func (UnimplementedMatchmakingServer) JoinQueue(context.Context, *JoinQueue_Request) (*JoinQueue_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedMatchmakingServer) LeaveQueue(context.Context, *LeaveQueue_Request) (*LeaveQueue_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (UnimplementedMatchmakingServer) GetQueueStatus(context.Context, *GetQueueStatus_Request) (*GetQueueStatus_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueStatus not implemented")
+}
+func (UnimplementedMatchmakingServer) mustEmbedUnimplementedMatchmakingServer() {}
+
+// UnsafeMatchmakingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchmakingServer will
+// result in compilation errors.
+type UnsafeMatchmakingServer interface {
+	mustEmbedUnimplementedMatchmakingServer()
+}
+
+func RegisterMatchmakingServer(s grpc.ServiceRegistrar, srv MatchmakingServer) {
+	s.RegisterService(&Matchmaking_ServiceDesc, srv)
+}
+
+func _Matchmaking_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinQueue_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Matchmaking_JoinQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServer).JoinQueue(ctx, req.(*JoinQueue_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Matchmaking_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveQueue_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Matchmaking_LeaveQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServer).LeaveQueue(ctx, req.(*LeaveQueue_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Matchmaking_GetQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueStatus_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchmakingServer).GetQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Matchmaking_GetQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchmakingServer).GetQueueStatus(ctx, req.(*GetQueueStatus_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Matchmaking_ServiceDesc is the grpc.ServiceDesc for Matchmaking service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Matchmaking_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Matchmaking",
+	HandlerType: (*MatchmakingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinQueue",
+			Handler:    _Matchmaking_JoinQueue_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _Matchmaking_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "GetQueueStatus",
+			Handler:    _Matchmaking_GetQueueStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/services.proto",
+}
+
+const (
+	Admin_GetDeliveryAttempts_FullMethodName = "/api.Admin/GetDeliveryAttempts"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Admin is available to users listed in the manager configuration only
+type AdminClient interface {
+	GetDeliveryAttempts(ctx context.Context, in *GetDeliveryAttempts_Request, opts ...grpc.CallOption) (*GetDeliveryAttempts_Response, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetDeliveryAttempts(ctx context.Context, in *GetDeliveryAttempts_Request, opts ...grpc.CallOption) (*GetDeliveryAttempts_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeliveryAttempts_Response)
+	err := c.cc.Invoke(ctx, Admin_GetDeliveryAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+//
+// Admin is available to users listed in the manager configuration only
+type AdminServer interface {
+	GetDeliveryAttempts(context.Context, *GetDeliveryAttempts_Request) (*GetDeliveryAttempts_Response, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) GetDeliveryAttempts(context.Context, *GetDeliveryAttempts_Request) (*GetDeliveryAttempts_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeliveryAttempts not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_GetDeliveryAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryAttempts_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetDeliveryAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetDeliveryAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetDeliveryAttempts(ctx, req.(*GetDeliveryAttempts_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeliveryAttempts",
+			Handler:    _Admin_GetDeliveryAttempts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/services.proto",
+}
+
+const (
+	Presets_CreatePreset_FullMethodName = "/api.Presets/CreatePreset"
+	Presets_GetPresets_FullMethodName   = "/api.Presets/GetPresets"
+	Presets_UpdatePreset_FullMethodName = "/api.Presets/UpdatePreset"
+	Presets_DeletePreset_FullMethodName = "/api.Presets/DeletePreset"
+)
+
+// PresetsClient is the client API for Presets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Presets are named game options, the owner can share them with other users
+type PresetsClient interface {
+	CreatePreset(ctx context.Context, in *CreatePreset_Request, opts ...grpc.CallOption) (*CreatePreset_Response, error)
+	// Presets the user owns or which are shared with them
+	GetPresets(ctx context.Context, in *GetPresets_Request, opts ...grpc.CallOption) (*GetPresets_Response, error)
+	// Only the owner can update the preset
+	UpdatePreset(ctx context.Context, in *UpdatePreset_Request, opts ...grpc.CallOption) (*UpdatePreset_Response, error)
+	// Only the owner can delete the preset
+	DeletePreset(ctx context.Context, in *DeletePreset_Request, opts ...grpc.CallOption) (*DeletePreset_Response, error)
+}
+
+type presetsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPresetsClient(cc grpc.ClientConnInterface) PresetsClient {
+	return &presetsClient{cc}
+}
+
+func (c *presetsClient) CreatePreset(ctx context.Context, in *CreatePreset_Request, opts ...grpc.CallOption) (*CreatePreset_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePreset_Response)
+	err := c.cc.Invoke(ctx, Presets_CreatePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presetsClient) GetPresets(ctx context.Context, in *GetPresets_Request, opts ...grpc.CallOption) (*GetPresets_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresets_Response)
+	err := c.cc.Invoke(ctx, Presets_GetPresets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presetsClient) UpdatePreset(ctx context.Context, in *UpdatePreset_Request, opts ...grpc.CallOption) (*UpdatePreset_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreset_Response)
+	err := c.cc.Invoke(ctx, Presets_UpdatePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presetsClient) DeletePreset(ctx context.Context, in *DeletePreset_Request, opts ...grpc.CallOption) (*DeletePreset_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePreset_Response)
+	err := c.cc.Invoke(ctx, Presets_DeletePreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PresetsServer is the server API for Presets service.
+// All implementations must embed UnimplementedPresetsServer
+// for forward compatibility
+//
+// Presets are named game options, the owner can share them with other users
+type PresetsServer interface {
+	CreatePreset(context.Context, *CreatePreset_Request) (*CreatePreset_Response, error)
+	// Presets the user owns or which are shared with them
+	GetPresets(context.Context, *GetPresets_Request) (*GetPresets_Response, error)
+	// Only the owner can update the preset
+	UpdatePreset(context.Context, *UpdatePreset_Request) (*UpdatePreset_Response, error)
+	// Only the owner can delete the preset
+	DeletePreset(context.Context, *DeletePreset_Request) (*DeletePreset_Response, error)
+	mustEmbedUnimplementedPresetsServer()
+}
+
+// UnimplementedPresetsServer must be embedded to have forward compatible implementations.
+type UnimplementedPresetsServer struct {
+}
+
+func (UnimplementedPresetsServer) CreatePreset(context.Context, *CreatePreset_Request) (*CreatePreset_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePreset not implemented")
+}
+func (UnimplementedPresetsServer) GetPresets(context.Context, *GetPresets_Request) (*GetPresets_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresets not implemented")
+}
+func (UnimplementedPresetsServer) UpdatePreset(context.Context, *UpdatePreset_Request) (*UpdatePreset_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreset not implemented")
+}
+func (UnimplementedPresetsServer) DeletePreset(context.Context, *DeletePreset_Request) (*DeletePreset_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePreset not implemented")
+}
+func (UnimplementedPresetsServer) mustEmbedUnimplementedPresetsServer() {}
+
+// UnsafePresetsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PresetsServer will
+// result in compilation errors.
+type UnsafePresetsServer interface {
+	mustEmbedUnimplementedPresetsServer()
+}
+
+func RegisterPresetsServer(s grpc.ServiceRegistrar, srv PresetsServer) {
+	s.RegisterService(&Presets_ServiceDesc, srv)
+}
+
+func _Presets_CreatePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePreset_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresetsServer).CreatePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Presets_CreatePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresetsServer).CreatePreset(ctx, req.(*CreatePreset_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Presets_GetPresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresets_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresetsServer).GetPresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Presets_GetPresets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresetsServer).GetPresets(ctx, req.(*GetPresets_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Presets_UpdatePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreset_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresetsServer).UpdatePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Presets_UpdatePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresetsServer).UpdatePreset(ctx, req.(*UpdatePreset_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Presets_DeletePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePreset_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresetsServer).DeletePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Presets_DeletePreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresetsServer).DeletePreset(ctx, req.(*DeletePreset_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Presets_ServiceDesc is the grpc.ServiceDesc for Presets service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Presets_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Presets",
+	HandlerType: (*PresetsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePreset",
+			Handler:    _Presets_CreatePreset_Handler,
+		},
+		{
+			MethodName: "GetPresets",
+			Handler:    _Presets_GetPresets_Handler,
+		},
+		{
+			MethodName: "UpdatePreset",
+			Handler:    _Presets_UpdatePreset_Handler,
+		},
+		{
+			MethodName: "DeletePreset",
+			Handler:    _Presets_DeletePreset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/services.proto",
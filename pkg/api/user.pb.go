@@ -12,6 +12,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -89,6 +90,195 @@ func (PlayerColor) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_api_user_proto_rawDescGZIP(), []int{0}
 }
 
+type RatingSystem int32
+
+const (
+	RatingSystem_RATING_SYSTEM_ELO     RatingSystem = 0
+	RatingSystem_RATING_SYSTEM_GLICKO2 RatingSystem = 1
+)
+
+// Enum value maps for RatingSystem.
+var (
+	RatingSystem_name = map[int32]string{
+		0: "RATING_SYSTEM_ELO",
+		1: "RATING_SYSTEM_GLICKO2",
+	}
+	RatingSystem_value = map[string]int32{
+		"RATING_SYSTEM_ELO":     0,
+		"RATING_SYSTEM_GLICKO2": 1,
+	}
+)
+
+func (x RatingSystem) Enum() *RatingSystem {
+	p := new(RatingSystem)
+	*p = x
+	return p
+}
+
+func (x RatingSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RatingSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[1].Descriptor()
+}
+
+func (RatingSystem) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[1]
+}
+
+func (x RatingSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RatingSystem.Descriptor instead.
+func (RatingSystem) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{1}
+}
+
+type Board int32
+
+const (
+	Board_BOARD_UNKNOWN           Board = 0
+	Board_BOARD_THARSIS           Board = 1
+	Board_BOARD_HELLAS            Board = 2
+	Board_BOARD_ELYSIUM           Board = 3
+	Board_BOARD_AMAZONIS          Board = 4
+	Board_BOARD_TERRA_CIMMERIA    Board = 5
+	Board_BOARD_VASTITAS_BOREALIS Board = 6
+)
+
+// Enum value maps for Board.
+var (
+	Board_name = map[int32]string{
+		0: "BOARD_UNKNOWN",
+		1: "BOARD_THARSIS",
+		2: "BOARD_HELLAS",
+		3: "BOARD_ELYSIUM",
+		4: "BOARD_AMAZONIS",
+		5: "BOARD_TERRA_CIMMERIA",
+		6: "BOARD_VASTITAS_BOREALIS",
+	}
+	Board_value = map[string]int32{
+		"BOARD_UNKNOWN":           0,
+		"BOARD_THARSIS":           1,
+		"BOARD_HELLAS":            2,
+		"BOARD_ELYSIUM":           3,
+		"BOARD_AMAZONIS":          4,
+		"BOARD_TERRA_CIMMERIA":    5,
+		"BOARD_VASTITAS_BOREALIS": 6,
+	}
+)
+
+func (x Board) Enum() *Board {
+	p := new(Board)
+	*p = x
+	return p
+}
+
+func (x Board) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Board) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[2].Descriptor()
+}
+
+func (Board) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[2]
+}
+
+func (x Board) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Board.Descriptor instead.
+func (Board) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{2}
+}
+
+type Expansion int32
+
+const (
+	Expansion_EXPANSION_UNSPECIFIED   Expansion = 0
+	Expansion_EXPANSION_CORPORATE_ERA Expansion = 1
+	Expansion_EXPANSION_PRELUDE       Expansion = 2
+	Expansion_EXPANSION_PRELUDE2      Expansion = 3
+	Expansion_EXPANSION_VENUS_NEXT    Expansion = 4
+	Expansion_EXPANSION_COLONIES      Expansion = 5
+	Expansion_EXPANSION_TURMOIL       Expansion = 6
+	Expansion_EXPANSION_PROMO         Expansion = 7
+	Expansion_EXPANSION_COMMUNITY     Expansion = 8
+	Expansion_EXPANSION_ARES          Expansion = 9
+	Expansion_EXPANSION_MOON          Expansion = 10
+	Expansion_EXPANSION_PATHFINDERS   Expansion = 11
+	Expansion_EXPANSION_CEOS          Expansion = 12
+	Expansion_EXPANSION_UNDERWORLD    Expansion = 13
+)
+
+// Enum value maps for Expansion.
+var (
+	Expansion_name = map[int32]string{
+		0:  "EXPANSION_UNSPECIFIED",
+		1:  "EXPANSION_CORPORATE_ERA",
+		2:  "EXPANSION_PRELUDE",
+		3:  "EXPANSION_PRELUDE2",
+		4:  "EXPANSION_VENUS_NEXT",
+		5:  "EXPANSION_COLONIES",
+		6:  "EXPANSION_TURMOIL",
+		7:  "EXPANSION_PROMO",
+		8:  "EXPANSION_COMMUNITY",
+		9:  "EXPANSION_ARES",
+		10: "EXPANSION_MOON",
+		11: "EXPANSION_PATHFINDERS",
+		12: "EXPANSION_CEOS",
+		13: "EXPANSION_UNDERWORLD",
+	}
+	Expansion_value = map[string]int32{
+		"EXPANSION_UNSPECIFIED":   0,
+		"EXPANSION_CORPORATE_ERA": 1,
+		"EXPANSION_PRELUDE":       2,
+		"EXPANSION_PRELUDE2":      3,
+		"EXPANSION_VENUS_NEXT":    4,
+		"EXPANSION_COLONIES":      5,
+		"EXPANSION_TURMOIL":       6,
+		"EXPANSION_PROMO":         7,
+		"EXPANSION_COMMUNITY":     8,
+		"EXPANSION_ARES":          9,
+		"EXPANSION_MOON":          10,
+		"EXPANSION_PATHFINDERS":   11,
+		"EXPANSION_CEOS":          12,
+		"EXPANSION_UNDERWORLD":    13,
+	}
+)
+
+func (x Expansion) Enum() *Expansion {
+	p := new(Expansion)
+	*p = x
+	return p
+}
+
+func (x Expansion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Expansion) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[3].Descriptor()
+}
+
+func (Expansion) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[3]
+}
+
+func (x Expansion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Expansion.Descriptor instead.
+func (Expansion) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{3}
+}
+
 type GameStatus int32
 
 const (
@@ -122,11 +312,11 @@ func (x GameStatus) String() string {
 }
 
 func (GameStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_api_user_proto_enumTypes[1].Descriptor()
+	return file_pkg_api_user_proto_enumTypes[4].Descriptor()
 }
 
 func (GameStatus) Type() protoreflect.EnumType {
-	return &file_pkg_api_user_proto_enumTypes[1]
+	return &file_pkg_api_user_proto_enumTypes[4]
 }
 
 func (x GameStatus) Number() protoreflect.EnumNumber {
@@ -135,7 +325,270 @@ func (x GameStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GameStatus.Descriptor instead.
 func (GameStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_api_user_proto_rawDescGZIP(), []int{1}
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{4}
+}
+
+type DevicePlatform int32
+
+const (
+	DevicePlatform_DEVICE_PLATFORM_IOS     DevicePlatform = 0
+	DevicePlatform_DEVICE_PLATFORM_ANDROID DevicePlatform = 1
+	DevicePlatform_DEVICE_PLATFORM_WEB     DevicePlatform = 2
+)
+
+// Enum value maps for DevicePlatform.
+var (
+	DevicePlatform_name = map[int32]string{
+		0: "DEVICE_PLATFORM_IOS",
+		1: "DEVICE_PLATFORM_ANDROID",
+		2: "DEVICE_PLATFORM_WEB",
+	}
+	DevicePlatform_value = map[string]int32{
+		"DEVICE_PLATFORM_IOS":     0,
+		"DEVICE_PLATFORM_ANDROID": 1,
+		"DEVICE_PLATFORM_WEB":     2,
+	}
+)
+
+func (x DevicePlatform) Enum() *DevicePlatform {
+	p := new(DevicePlatform)
+	*p = x
+	return p
+}
+
+func (x DevicePlatform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DevicePlatform) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[5].Descriptor()
+}
+
+func (DevicePlatform) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[5]
+}
+
+func (x DevicePlatform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DevicePlatform.Descriptor instead.
+func (DevicePlatform) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{5}
+}
+
+type LobbyStatus int32
+
+const (
+	LobbyStatus_LOBBY_STATUS_OPEN LobbyStatus = 0
+	// The game is being created
+	LobbyStatus_LOBBY_STATUS_STARTING LobbyStatus = 1
+	LobbyStatus_LOBBY_STATUS_STARTED  LobbyStatus = 2
+	LobbyStatus_LOBBY_STATUS_EXPIRED  LobbyStatus = 3
+)
+
+// Enum value maps for LobbyStatus.
+var (
+	LobbyStatus_name = map[int32]string{
+		0: "LOBBY_STATUS_OPEN",
+		1: "LOBBY_STATUS_STARTING",
+		2: "LOBBY_STATUS_STARTED",
+		3: "LOBBY_STATUS_EXPIRED",
+	}
+	LobbyStatus_value = map[string]int32{
+		"LOBBY_STATUS_OPEN":     0,
+		"LOBBY_STATUS_STARTING": 1,
+		"LOBBY_STATUS_STARTED":  2,
+		"LOBBY_STATUS_EXPIRED":  3,
+	}
+)
+
+func (x LobbyStatus) Enum() *LobbyStatus {
+	p := new(LobbyStatus)
+	*p = x
+	return p
+}
+
+func (x LobbyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LobbyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[6].Descriptor()
+}
+
+func (LobbyStatus) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[6]
+}
+
+func (x LobbyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LobbyStatus.Descriptor instead.
+func (LobbyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{6}
+}
+
+type InvitationStatus int32
+
+const (
+	InvitationStatus_INVITATION_STATUS_PENDING  InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_ACCEPTED InvitationStatus = 1
+	InvitationStatus_INVITATION_STATUS_DECLINED InvitationStatus = 2
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_PENDING",
+		1: "INVITATION_STATUS_ACCEPTED",
+		2: "INVITATION_STATUS_DECLINED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_PENDING":  0,
+		"INVITATION_STATUS_ACCEPTED": 1,
+		"INVITATION_STATUS_DECLINED": 2,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[7].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[7]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{7}
+}
+
+type GameOptions_RandomMilestonesAwards int32
+
+const (
+	GameOptions_RANDOM_MILESTONES_AWARDS_NONE    GameOptions_RandomMilestonesAwards = 0
+	GameOptions_RANDOM_MILESTONES_AWARDS_LIMITED GameOptions_RandomMilestonesAwards = 1
+	GameOptions_RANDOM_MILESTONES_AWARDS_FULL    GameOptions_RandomMilestonesAwards = 2
+)
+
+// Enum value maps for GameOptions_RandomMilestonesAwards.
+var (
+	GameOptions_RandomMilestonesAwards_name = map[int32]string{
+		0: "RANDOM_MILESTONES_AWARDS_NONE",
+		1: "RANDOM_MILESTONES_AWARDS_LIMITED",
+		2: "RANDOM_MILESTONES_AWARDS_FULL",
+	}
+	GameOptions_RandomMilestonesAwards_value = map[string]int32{
+		"RANDOM_MILESTONES_AWARDS_NONE":    0,
+		"RANDOM_MILESTONES_AWARDS_LIMITED": 1,
+		"RANDOM_MILESTONES_AWARDS_FULL":    2,
+	}
+)
+
+func (x GameOptions_RandomMilestonesAwards) Enum() *GameOptions_RandomMilestonesAwards {
+	p := new(GameOptions_RandomMilestonesAwards)
+	*p = x
+	return p
+}
+
+func (x GameOptions_RandomMilestonesAwards) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameOptions_RandomMilestonesAwards) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_user_proto_enumTypes[8].Descriptor()
+}
+
+func (GameOptions_RandomMilestonesAwards) Type() protoreflect.EnumType {
+	return &file_pkg_api_user_proto_enumTypes[8]
+}
+
+func (x GameOptions_RandomMilestonesAwards) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameOptions_RandomMilestonesAwards.Descriptor instead.
+func (GameOptions_RandomMilestonesAwards) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type Glicko struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rating     float64 `protobuf:"fixed64,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Deviation  float64 `protobuf:"fixed64,2,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Volatility float64 `protobuf:"fixed64,3,opt,name=volatility,proto3" json:"volatility,omitempty"`
+}
+
+func (x *Glicko) Reset() {
+	*x = Glicko{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Glicko) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Glicko) ProtoMessage() {}
+
+func (x *Glicko) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Glicko.ProtoReflect.Descriptor instead.
+func (*Glicko) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Glicko) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Glicko) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *Glicko) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
 }
 
 type User struct {
@@ -148,12 +601,13 @@ type User struct {
 	Color     PlayerColor            `protobuf:"varint,3,opt,name=color,proto3,enum=api.PlayerColor" json:"color,omitempty"`
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Elo       int32                  `protobuf:"varint,5,opt,name=elo,proto3" json:"elo,omitempty"`
+	Glicko    *Glicko                `protobuf:"bytes,6,opt,name=glicko,proto3" json:"glicko,omitempty"`
 }
 
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_user_proto_msgTypes[0]
+		mi := &file_pkg_api_user_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -166,7 +620,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_user_proto_msgTypes[0]
+	mi := &file_pkg_api_user_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -179,7 +633,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_pkg_api_user_proto_rawDescGZIP(), []int{0}
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *User) GetId() string {
@@ -217,6 +671,13 @@ func (x *User) GetElo() int32 {
 	return 0
 }
 
+func (x *User) GetGlicko() *Glicko {
+	if x != nil {
+		return x.Glicko
+	}
+	return nil
+}
+
 type Game struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -228,12 +689,15 @@ type Game struct {
 	PlayersCount int32                  `protobuf:"varint,4,opt,name=players_count,json=playersCount,proto3" json:"players_count,omitempty"`
 	AwaitsInput  bool                   `protobuf:"varint,5,opt,name=awaits_input,json=awaitsInput,proto3" json:"awaits_input,omitempty"`
 	Status       GameStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=api.GameStatus" json:"status,omitempty"`
+	// Not set for games created before the settings were stored
+	Settings *GameSettings `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings,omitempty"`
+	Id       string        `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *Game) Reset() {
 	*x = Game{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_user_proto_msgTypes[1]
+		mi := &file_pkg_api_user_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -246,7 +710,7 @@ func (x *Game) String() string {
 func (*Game) ProtoMessage() {}
 
 func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_user_proto_msgTypes[1]
+	mi := &file_pkg_api_user_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +723,7 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Game.ProtoReflect.Descriptor instead.
 func (*Game) Descriptor() ([]byte, []int) {
-	return file_pkg_api_user_proto_rawDescGZIP(), []int{1}
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Game) GetPlayUrl() string {
@@ -304,58 +768,1969 @@ func (x *Game) GetStatus() GameStatus {
 	return GameStatus_GAME_STATUS_IN_PROGRESS
 }
 
-var File_pkg_api_user_proto protoreflect.FileDescriptor
+func (x *Game) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
 
-var file_pkg_api_user_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x01, 0x0a, 0x04, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x26, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GameSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Board        Board   `protobuf:"varint,1,opt,name=board,proto3,enum=api.Board" json:"board,omitempty"`
+	CorporateEra bool    `protobuf:"varint,2,opt,name=corporate_era,json=corporateEra,proto3" json:"corporate_era,omitempty"`
+	Prelude      bool    `protobuf:"varint,3,opt,name=prelude,proto3" json:"prelude,omitempty"`
+	VenusNext    bool    `protobuf:"varint,4,opt,name=venus_next,json=venusNext,proto3" json:"venus_next,omitempty"`
+	SolarPhase   bool    `protobuf:"varint,5,opt,name=solar_phase,json=solarPhase,proto3" json:"solar_phase,omitempty"`
+	Colonies     bool    `protobuf:"varint,6,opt,name=colonies,proto3" json:"colonies,omitempty"`
+	Seed         float64 `protobuf:"fixed64,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	// User id of the player who makes the first move
+	FirstPlayerId string `protobuf:"bytes,8,opt,name=first_player_id,json=firstPlayerId,proto3" json:"first_player_id,omitempty"`
+}
+
+func (x *GameSettings) Reset() {
+	*x = GameSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSettings) ProtoMessage() {}
+
+func (x *GameSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
+func (*GameSettings) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameSettings) GetBoard() Board {
+	if x != nil {
+		return x.Board
+	}
+	return Board_BOARD_UNKNOWN
+}
+
+func (x *GameSettings) GetCorporateEra() bool {
+	if x != nil {
+		return x.CorporateEra
+	}
+	return false
+}
+
+func (x *GameSettings) GetPrelude() bool {
+	if x != nil {
+		return x.Prelude
+	}
+	return false
+}
+
+func (x *GameSettings) GetVenusNext() bool {
+	if x != nil {
+		return x.VenusNext
+	}
+	return false
+}
+
+func (x *GameSettings) GetSolarPhase() bool {
+	if x != nil {
+		return x.SolarPhase
+	}
+	return false
+}
+
+func (x *GameSettings) GetColonies() bool {
+	if x != nil {
+		return x.Colonies
+	}
+	return false
+}
+
+func (x *GameSettings) GetSeed() float64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GameSettings) GetFirstPlayerId() string {
+	if x != nil {
+		return x.FirstPlayerId
+	}
+	return ""
+}
+
+// GameOptions mirror the game creation form of the Mars server
+type GameOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Random board if not set
+	Board         Board                      `protobuf:"varint,1,opt,name=board,proto3,enum=api.Board" json:"board,omitempty"`
+	Expansions    []Expansion                `protobuf:"varint,2,rep,packed,name=expansions,proto3,enum=api.Expansion" json:"expansions,omitempty"`
+	Configuration *GameOptions_Configuration `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Variants      *GameOptions_Variants      `protobuf:"bytes,4,opt,name=variants,proto3" json:"variants,omitempty"`
+	// Card names as the Mars server knows them
+	BannedCards   []string `protobuf:"bytes,5,rep,name=banned_cards,json=bannedCards,proto3" json:"banned_cards,omitempty"`
+	IncludedCards []string `protobuf:"bytes,6,rep,name=included_cards,json=includedCards,proto3" json:"included_cards,omitempty"`
+	// Nickname of the player who makes the first move, random if empty
+	FirstPlayer string `protobuf:"bytes,7,opt,name=first_player,json=firstPlayer,proto3" json:"first_player,omitempty"`
+}
+
+func (x *GameOptions) Reset() {
+	*x = GameOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOptions) ProtoMessage() {}
+
+func (x *GameOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOptions.ProtoReflect.Descriptor instead.
+func (*GameOptions) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameOptions) GetBoard() Board {
+	if x != nil {
+		return x.Board
+	}
+	return Board_BOARD_UNKNOWN
+}
+
+func (x *GameOptions) GetExpansions() []Expansion {
+	if x != nil {
+		return x.Expansions
+	}
+	return nil
+}
+
+func (x *GameOptions) GetConfiguration() *GameOptions_Configuration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *GameOptions) GetVariants() *GameOptions_Variants {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *GameOptions) GetBannedCards() []string {
+	if x != nil {
+		return x.BannedCards
+	}
+	return nil
+}
+
+func (x *GameOptions) GetIncludedCards() []string {
+	if x != nil {
+		return x.IncludedCards
+	}
+	return nil
+}
+
+func (x *GameOptions) GetFirstPlayer() string {
+	if x != nil {
+		return x.FirstPlayer
+	}
+	return ""
+}
+
+type VictoryPoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TerraformRating int32 `protobuf:"varint,1,opt,name=terraform_rating,json=terraformRating,proto3" json:"terraform_rating,omitempty"`
+	Milestones      int32 `protobuf:"varint,2,opt,name=milestones,proto3" json:"milestones,omitempty"`
+	Awards          int32 `protobuf:"varint,3,opt,name=awards,proto3" json:"awards,omitempty"`
+	Greenery        int32 `protobuf:"varint,4,opt,name=greenery,proto3" json:"greenery,omitempty"`
+	City            int32 `protobuf:"varint,5,opt,name=city,proto3" json:"city,omitempty"`
+	Cards           int32 `protobuf:"varint,6,opt,name=cards,proto3" json:"cards,omitempty"`
+	Moon            int32 `protobuf:"varint,7,opt,name=moon,proto3" json:"moon,omitempty"`
+	PlanetaryTracks int32 `protobuf:"varint,8,opt,name=planetary_tracks,json=planetaryTracks,proto3" json:"planetary_tracks,omitempty"`
+	EscapeVelocity  int32 `protobuf:"varint,9,opt,name=escape_velocity,json=escapeVelocity,proto3" json:"escape_velocity,omitempty"`
+	Total           int32 `protobuf:"varint,10,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *VictoryPoints) Reset() {
+	*x = VictoryPoints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VictoryPoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VictoryPoints) ProtoMessage() {}
+
+func (x *VictoryPoints) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VictoryPoints.ProtoReflect.Descriptor instead.
+func (*VictoryPoints) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VictoryPoints) GetTerraformRating() int32 {
+	if x != nil {
+		return x.TerraformRating
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetMilestones() int32 {
+	if x != nil {
+		return x.Milestones
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetAwards() int32 {
+	if x != nil {
+		return x.Awards
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetGreenery() int32 {
+	if x != nil {
+		return x.Greenery
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetCity() int32 {
+	if x != nil {
+		return x.City
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetCards() int32 {
+	if x != nil {
+		return x.Cards
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetMoon() int32 {
+	if x != nil {
+		return x.Moon
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetPlanetaryTracks() int32 {
+	if x != nil {
+		return x.PlanetaryTracks
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetEscapeVelocity() int32 {
+	if x != nil {
+		return x.EscapeVelocity
+	}
+	return 0
+}
+
+func (x *VictoryPoints) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type EloChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldElo int32 `protobuf:"varint,1,opt,name=old_elo,json=oldElo,proto3" json:"old_elo,omitempty"`
+	NewElo int32 `protobuf:"varint,2,opt,name=new_elo,json=newElo,proto3" json:"new_elo,omitempty"`
+}
+
+func (x *EloChange) Reset() {
+	*x = EloChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EloChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EloChange) ProtoMessage() {}
+
+func (x *EloChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EloChange.ProtoReflect.Descriptor instead.
+func (*EloChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EloChange) GetOldElo() int32 {
+	if x != nil {
+		return x.OldElo
+	}
+	return 0
+}
+
+func (x *EloChange) GetNewElo() int32 {
+	if x != nil {
+		return x.NewElo
+	}
+	return 0
+}
+
+type GamePlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string      `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname string      `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Color    PlayerColor `protobuf:"varint,3,opt,name=color,proto3,enum=api.PlayerColor" json:"color,omitempty"`
+	// Final place starting from 1, not set until the game has finished
+	Place           int32          `protobuf:"varint,4,opt,name=place,proto3" json:"place,omitempty"`
+	MegaCredits     int32          `protobuf:"varint,5,opt,name=mega_credits,json=megaCredits,proto3" json:"mega_credits,omitempty"`
+	TerraformRating int32          `protobuf:"varint,6,opt,name=terraform_rating,json=terraformRating,proto3" json:"terraform_rating,omitempty"`
+	VictoryPoints   *VictoryPoints `protobuf:"bytes,7,opt,name=victory_points,json=victoryPoints,proto3" json:"victory_points,omitempty"`
+	// Not set until ratings are applied to the finished game
+	EloChange *EloChange `protobuf:"bytes,8,opt,name=elo_change,json=eloChange,proto3" json:"elo_change,omitempty"`
+}
+
+func (x *GamePlayer) Reset() {
+	*x = GamePlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GamePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GamePlayer) ProtoMessage() {}
+
+func (x *GamePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GamePlayer.ProtoReflect.Descriptor instead.
+func (*GamePlayer) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GamePlayer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GamePlayer) GetColor() PlayerColor {
+	if x != nil {
+		return x.Color
+	}
+	return PlayerColor_BLUE
+}
+
+func (x *GamePlayer) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetMegaCredits() int32 {
+	if x != nil {
+		return x.MegaCredits
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetTerraformRating() int32 {
+	if x != nil {
+		return x.TerraformRating
+	}
+	return 0
+}
+
+func (x *GamePlayer) GetVictoryPoints() *VictoryPoints {
+	if x != nil {
+		return x.VictoryPoints
+	}
+	return nil
+}
+
+func (x *GamePlayer) GetEloChange() *EloChange {
+	if x != nil {
+		return x.EloChange
+	}
+	return nil
+}
+
+type GameDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Settings   *GameSettings          `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	Generation int32                  `protobuf:"varint,6,opt,name=generation,proto3" json:"generation,omitempty"`
+	Players    []*GamePlayer          `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty"`
+	// Id of the first game of the rematch series, empty if the game has never been rematched
+	SeriesId string `protobuf:"bytes,8,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Id of the game this one is a rematch of
+	RematchOf string `protobuf:"bytes,9,opt,name=rematch_of,json=rematchOf,proto3" json:"rematch_of,omitempty"`
+	// Only set for games created with the full options
+	Options *GameOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GameDetails) Reset() {
+	*x = GameDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameDetails) ProtoMessage() {}
+
+func (x *GameDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameDetails.ProtoReflect.Descriptor instead.
+func (*GameDetails) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GameDetails) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameDetails) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GameDetails) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GameDetails) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *GameDetails) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *GameDetails) GetGeneration() int32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *GameDetails) GetPlayers() []*GamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameDetails) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *GameDetails) GetRematchOf() string {
+	if x != nil {
+		return x.RematchOf
+	}
+	return ""
+}
+
+func (x *GameDetails) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type RatingChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	OldElo    int32                  `protobuf:"varint,3,opt,name=old_elo,json=oldElo,proto3" json:"old_elo,omitempty"`
+	NewElo    int32                  `protobuf:"varint,4,opt,name=new_elo,json=newElo,proto3" json:"new_elo,omitempty"`
+	OldGlicko *Glicko                `protobuf:"bytes,5,opt,name=old_glicko,json=oldGlicko,proto3" json:"old_glicko,omitempty"`
+	NewGlicko *Glicko                `protobuf:"bytes,6,opt,name=new_glicko,json=newGlicko,proto3" json:"new_glicko,omitempty"`
+}
+
+func (x *RatingChange) Reset() {
+	*x = RatingChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingChange) ProtoMessage() {}
+
+func (x *RatingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingChange.ProtoReflect.Descriptor instead.
+func (*RatingChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RatingChange) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RatingChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *RatingChange) GetOldElo() int32 {
+	if x != nil {
+		return x.OldElo
+	}
+	return 0
+}
+
+func (x *RatingChange) GetNewElo() int32 {
+	if x != nil {
+		return x.NewElo
+	}
+	return 0
+}
+
+func (x *RatingChange) GetOldGlicko() *Glicko {
+	if x != nil {
+		return x.OldGlicko
+	}
+	return nil
+}
+
+func (x *RatingChange) GetNewGlicko() *Glicko {
+	if x != nil {
+		return x.NewGlicko
+	}
+	return nil
+}
+
+type QuietHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minutes since midnight, the window may wrap around midnight
+	StartMinute int32 `protobuf:"varint,1,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	EndMinute   int32 `protobuf:"varint,2,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QuietHours) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *QuietHours) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+type NotificationSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Platforms that receive no notifications
+	DisabledPlatforms []DevicePlatform `protobuf:"varint,1,rep,packed,name=disabled_platforms,json=disabledPlatforms,proto3,enum=api.DevicePlatform" json:"disabled_platforms,omitempty"`
+	QuietHours        *QuietHours      `protobuf:"bytes,2,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	// IANA time zone of quiet hours, e.g. Europe/Berlin. UTC if empty
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Only update the badge, never alert
+	BadgeOnly bool `protobuf:"varint,4,opt,name=badge_only,json=badgeOnly,proto3" json:"badge_only,omitempty"`
+	// Alerts are sent no earlier than this after a game starts awaiting input
+	MinDelay *durationpb.Duration `protobuf:"bytes,5,opt,name=min_delay,json=minDelay,proto3" json:"min_delay,omitempty"`
+	// Do not notify about finished games and rating changes
+	MuteGameResults bool `protobuf:"varint,6,opt,name=mute_game_results,json=muteGameResults,proto3" json:"mute_game_results,omitempty"`
+}
+
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NotificationSettings) GetDisabledPlatforms() []DevicePlatform {
+	if x != nil {
+		return x.DisabledPlatforms
+	}
+	return nil
+}
+
+func (x *NotificationSettings) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationSettings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *NotificationSettings) GetBadgeOnly() bool {
+	if x != nil {
+		return x.BadgeOnly
+	}
+	return false
+}
+
+func (x *NotificationSettings) GetMinDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MinDelay
+	}
+	return nil
+}
+
+func (x *NotificationSettings) GetMuteGameResults() bool {
+	if x != nil {
+		return x.MuteGameResults
+	}
+	return false
+}
+
+type DeliveryAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutboxId int64          `protobuf:"varint,1,opt,name=outbox_id,json=outboxId,proto3" json:"outbox_id,omitempty"`
+	Platform DevicePlatform `protobuf:"varint,2,opt,name=platform,proto3,enum=api.DevicePlatform" json:"platform,omitempty"`
+	// sandbox or production, APNs tokens are delivered in one of them
+	Environment string `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	// Hex encoded prefix of the device token
+	Token       string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	PayloadHash string `protobuf:"bytes,5,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	// Id assigned by the push service, e.g. apns-id
+	MessageId string `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// HTTP status of the push service response, 0 if there was no response
+	Status    int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Reason    string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Error     string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Latency   *durationpb.Duration   `protobuf:"bytes,10,opt,name=latency,proto3" json:"latency,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DeliveryAttempt) Reset() {
+	*x = DeliveryAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryAttempt) ProtoMessage() {}
+
+func (x *DeliveryAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryAttempt.ProtoReflect.Descriptor instead.
+func (*DeliveryAttempt) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeliveryAttempt) GetOutboxId() int64 {
+	if x != nil {
+		return x.OutboxId
+	}
+	return 0
+}
+
+func (x *DeliveryAttempt) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_DEVICE_PLATFORM_IOS
+}
+
+func (x *DeliveryAttempt) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *DeliveryAttempt) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeliveryAttempt) GetPayloadHash() string {
+	if x != nil {
+		return x.PayloadHash
+	}
+	return ""
+}
+
+func (x *DeliveryAttempt) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DeliveryAttempt) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DeliveryAttempt) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeliveryAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeliveryAttempt) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *DeliveryAttempt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LobbyMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string           `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Color    PlayerColor      `protobuf:"varint,2,opt,name=color,proto3,enum=api.PlayerColor" json:"color,omitempty"`
+	Status   InvitationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=api.InvitationStatus" json:"status,omitempty"`
+	Host     bool             `protobuf:"varint,4,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *LobbyMember) Reset() {
+	*x = LobbyMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LobbyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyMember) ProtoMessage() {}
+
+func (x *LobbyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyMember.ProtoReflect.Descriptor instead.
+func (*LobbyMember) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LobbyMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *LobbyMember) GetColor() PlayerColor {
+	if x != nil {
+		return x.Color
+	}
+	return PlayerColor_BLUE
+}
+
+func (x *LobbyMember) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_PENDING
+}
+
+func (x *LobbyMember) GetHost() bool {
+	if x != nil {
+		return x.Host
+	}
+	return false
+}
+
+type Lobby struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status LobbyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=api.LobbyStatus" json:"status,omitempty"`
+	// BOARD_UNKNOWN board is chosen at random when the game is created
+	Settings *GameSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	// The host goes first, other members in the order of invitation
+	Members   []*LobbyMember         `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Set when the lobby has started
+	GameId string `protobuf:"bytes,7,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *Lobby) Reset() {
+	*x = Lobby{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lobby) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lobby) ProtoMessage() {}
+
+func (x *Lobby) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lobby.ProtoReflect.Descriptor instead.
+func (*Lobby) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Lobby) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Lobby) GetStatus() LobbyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LobbyStatus_LOBBY_STATUS_OPEN
+}
+
+func (x *Lobby) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Lobby) GetMembers() []*LobbyMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Lobby) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Lobby) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Lobby) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type MatchmakingPreferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Acceptable numbers of players, from 2 to 5
+	PlayersCounts []int32 `protobuf:"varint,1,rep,packed,name=players_counts,json=playersCounts,proto3" json:"players_counts,omitempty"`
+	// BOARD_UNKNOWN board means any board. Expansions must be the same for all matched players,
+	// seed and first player are ignored.
+	Settings *GameSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Maximum Elo difference to other players, 0 means any
+	RatingRange int32 `protobuf:"varint,3,opt,name=rating_range,json=ratingRange,proto3" json:"rating_range,omitempty"`
+}
+
+func (x *MatchmakingPreferences) Reset() {
+	*x = MatchmakingPreferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchmakingPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchmakingPreferences) ProtoMessage() {}
+
+func (x *MatchmakingPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchmakingPreferences.ProtoReflect.Descriptor instead.
+func (*MatchmakingPreferences) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MatchmakingPreferences) GetPlayersCounts() []int32 {
+	if x != nil {
+		return x.PlayersCounts
+	}
+	return nil
+}
+
+func (x *MatchmakingPreferences) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *MatchmakingPreferences) GetRatingRange() int32 {
+	if x != nil {
+		return x.RatingRange
+	}
+	return 0
+}
+
+type Preset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Nickname of the owner
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Nicknames of the users the preset is shared with
+	SharedWith []string               `protobuf:"bytes,4,rep,name=shared_with,json=sharedWith,proto3" json:"shared_with,omitempty"`
+	Options    *GameOptions           `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Preset) Reset() {
+	*x = Preset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preset) ProtoMessage() {}
+
+func (x *Preset) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preset.ProtoReflect.Descriptor instead.
+func (*Preset) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Preset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Preset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Preset) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Preset) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
+func (x *Preset) GetOptions() *GameOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Preset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Preset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GameOptions_Configuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Undo               bool `protobuf:"varint,1,opt,name=undo,proto3" json:"undo,omitempty"`
+	ShowTimers         bool `protobuf:"varint,2,opt,name=show_timers,json=showTimers,proto3" json:"show_timers,omitempty"`
+	FastMode           bool `protobuf:"varint,3,opt,name=fast_mode,json=fastMode,proto3" json:"fast_mode,omitempty"`
+	ShowOtherPlayersVp bool `protobuf:"varint,4,opt,name=show_other_players_vp,json=showOtherPlayersVp,proto3" json:"show_other_players_vp,omitempty"`
+}
+
+func (x *GameOptions_Configuration) Reset() {
+	*x = GameOptions_Configuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameOptions_Configuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOptions_Configuration) ProtoMessage() {}
+
+func (x *GameOptions_Configuration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOptions_Configuration.ProtoReflect.Descriptor instead.
+func (*GameOptions_Configuration) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *GameOptions_Configuration) GetUndo() bool {
+	if x != nil {
+		return x.Undo
+	}
+	return false
+}
+
+func (x *GameOptions_Configuration) GetShowTimers() bool {
+	if x != nil {
+		return x.ShowTimers
+	}
+	return false
+}
+
+func (x *GameOptions_Configuration) GetFastMode() bool {
+	if x != nil {
+		return x.FastMode
+	}
+	return false
+}
+
+func (x *GameOptions_Configuration) GetShowOtherPlayersVp() bool {
+	if x != nil {
+		return x.ShowOtherPlayersVp
+	}
+	return false
+}
+
+type GameOptions_Variants struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draft                  bool                               `protobuf:"varint,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	InitialDraft           bool                               `protobuf:"varint,2,opt,name=initial_draft,json=initialDraft,proto3" json:"initial_draft,omitempty"`
+	PreludeDraft           bool                               `protobuf:"varint,3,opt,name=prelude_draft,json=preludeDraft,proto3" json:"prelude_draft,omitempty"`
+	SolarPhase             bool                               `protobuf:"varint,4,opt,name=solar_phase,json=solarPhase,proto3" json:"solar_phase,omitempty"`
+	ShuffleMap             bool                               `protobuf:"varint,5,opt,name=shuffle_map,json=shuffleMap,proto3" json:"shuffle_map,omitempty"`
+	RandomMilestonesAwards GameOptions_RandomMilestonesAwards `protobuf:"varint,6,opt,name=random_milestones_awards,json=randomMilestonesAwards,proto3,enum=api.GameOptions_RandomMilestonesAwards" json:"random_milestones_awards,omitempty"`
+	VenusMilestonesAwards  bool                               `protobuf:"varint,7,opt,name=venus_milestones_awards,json=venusMilestonesAwards,proto3" json:"venus_milestones_awards,omitempty"`
+	// Requires random milestones and awards
+	FanMilestonesAwards          bool `protobuf:"varint,8,opt,name=fan_milestones_awards,json=fanMilestonesAwards,proto3" json:"fan_milestones_awards,omitempty"`
+	RemoveNegativeGlobalEvents   bool `protobuf:"varint,9,opt,name=remove_negative_global_events,json=removeNegativeGlobalEvents,proto3" json:"remove_negative_global_events,omitempty"`
+	AltVenusBoard                bool `protobuf:"varint,10,opt,name=alt_venus_board,json=altVenusBoard,proto3" json:"alt_venus_board,omitempty"`
+	RequiresVenusTrackCompletion bool `protobuf:"varint,11,opt,name=requires_venus_track_completion,json=requiresVenusTrackCompletion,proto3" json:"requires_venus_track_completion,omitempty"`
+	RequiresMoonTrackCompletion  bool `protobuf:"varint,12,opt,name=requires_moon_track_completion,json=requiresMoonTrackCompletion,proto3" json:"requires_moon_track_completion,omitempty"`
+	TwoCorps                     bool `protobuf:"varint,13,opt,name=two_corps,json=twoCorps,proto3" json:"two_corps,omitempty"`
+	// Depends on the expansions if not set
+	StartingCorporations int32 `protobuf:"varint,14,opt,name=starting_corporations,json=startingCorporations,proto3" json:"starting_corporations,omitempty"`
+	// Defaults to 3 if not set
+	StartingCeos int32 `protobuf:"varint,15,opt,name=starting_ceos,json=startingCeos,proto3" json:"starting_ceos,omitempty"`
+}
+
+func (x *GameOptions_Variants) Reset() {
+	*x = GameOptions_Variants{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_user_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameOptions_Variants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOptions_Variants) ProtoMessage() {}
+
+func (x *GameOptions_Variants) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_user_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOptions_Variants.ProtoReflect.Descriptor instead.
+func (*GameOptions_Variants) Descriptor() ([]byte, []int) {
+	return file_pkg_api_user_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *GameOptions_Variants) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetInitialDraft() bool {
+	if x != nil {
+		return x.InitialDraft
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetPreludeDraft() bool {
+	if x != nil {
+		return x.PreludeDraft
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetSolarPhase() bool {
+	if x != nil {
+		return x.SolarPhase
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetShuffleMap() bool {
+	if x != nil {
+		return x.ShuffleMap
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetRandomMilestonesAwards() GameOptions_RandomMilestonesAwards {
+	if x != nil {
+		return x.RandomMilestonesAwards
+	}
+	return GameOptions_RANDOM_MILESTONES_AWARDS_NONE
+}
+
+func (x *GameOptions_Variants) GetVenusMilestonesAwards() bool {
+	if x != nil {
+		return x.VenusMilestonesAwards
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetFanMilestonesAwards() bool {
+	if x != nil {
+		return x.FanMilestonesAwards
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetRemoveNegativeGlobalEvents() bool {
+	if x != nil {
+		return x.RemoveNegativeGlobalEvents
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetAltVenusBoard() bool {
+	if x != nil {
+		return x.AltVenusBoard
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetRequiresVenusTrackCompletion() bool {
+	if x != nil {
+		return x.RequiresVenusTrackCompletion
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetRequiresMoonTrackCompletion() bool {
+	if x != nil {
+		return x.RequiresMoonTrackCompletion
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetTwoCorps() bool {
+	if x != nil {
+		return x.TwoCorps
+	}
+	return false
+}
+
+func (x *GameOptions_Variants) GetStartingCorporations() int32 {
+	if x != nil {
+		return x.StartingCorporations
+	}
+	return 0
+}
+
+func (x *GameOptions_Variants) GetStartingCeos() int32 {
+	if x != nil {
+		return x.StartingCeos
+	}
+	return 0
+}
+
+var File_pkg_api_user_proto protoreflect.FileDescriptor
+
+var file_pkg_api_user_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x06, 0x47, 0x6c,
+	0x69, 0x63, 0x6b, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xcc, 0x01, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
 	0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6c, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x65, 0x6c, 0x6f, 0x22, 0x88, 0x02, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x03, 0x65, 0x6c, 0x6f, 0x12, 0x23, 0x0a, 0x06, 0x67, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6c, 0x69, 0x63, 0x6b,
+	0x6f, 0x52, 0x06, 0x67, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x22, 0xc7, 0x02, 0x0a, 0x04, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x77, 0x61, 0x69, 0x74, 0x73, 0x5f, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x77, 0x61, 0x69, 0x74,
-	0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a,
-	0x70, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x08,
-	0x0a, 0x04, 0x42, 0x4c, 0x55, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x59, 0x45, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x41, 0x43,
-	0x4b, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x52, 0x50, 0x4c, 0x45, 0x10, 0x05, 0x12,
-	0x0a, 0x0a, 0x06, 0x4f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x50,
-	0x49, 0x4e, 0x4b, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x4e, 0x5a, 0x45, 0x10,
-	0x08, 0x2a, 0x61, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1b, 0x0a, 0x17, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49,
-	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
-	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x49,
-	0x54, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41,
-	0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48,
-	0x45, 0x44, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x73, 0x74, 0x6e, 0x75, 0x74, 0x34, 0x32, 0x2f, 0x74, 0x65,
-	0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x2d, 0x6d, 0x61, 0x72, 0x73, 0x2d,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x77, 0x61, 0x69,
+	0x74, 0x73, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x61, 0x77, 0x61, 0x69, 0x74, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x87, 0x02, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x70, 0x6f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x45, 0x72, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x65, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x65, 0x6e, 0x75, 0x73,
+	0x4e, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x6c, 0x61, 0x72,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x69, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x6e, 0x69, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd3, 0x0a,
+	0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a,
+	0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x2e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x44, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x94, 0x01, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x6e, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x6e, 0x64, 0x6f, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a,
+	0x15, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x5f, 0x76, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x73, 0x68,
+	0x6f, 0x77, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x56, 0x70,
+	0x1a, 0xe9, 0x05, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x12,
+	0x61, 0x0a, 0x18, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x16, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x41, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x5f, 0x6d, 0x69, 0x6c, 0x65,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74,
+	0x6f, 0x6e, 0x65, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x61,
+	0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x5f, 0x61, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x66, 0x61, 0x6e, 0x4d, 0x69,
+	0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x41,
+	0x0a, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x74, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x5f, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x56,
+	0x65, 0x6e, 0x75, 0x73, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x56, 0x65, 0x6e, 0x75,
+	0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x43, 0x0a, 0x1e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x6f, 0x6f,
+	0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x73, 0x4d, 0x6f, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x77, 0x6f, 0x5f, 0x63, 0x6f, 0x72,
+	0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x77, 0x6f, 0x43, 0x6f, 0x72,
+	0x70, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x72, 0x70, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x72, 0x70, 0x6f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x6f, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x6f, 0x73, 0x22, 0x84, 0x01, 0x0a,
+	0x16, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x73, 0x41, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x41, 0x4e, 0x44, 0x4f,
+	0x4d, 0x5f, 0x4d, 0x49, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x53, 0x5f, 0x41, 0x57, 0x41,
+	0x52, 0x44, 0x53, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x41,
+	0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x4d, 0x49, 0x4c, 0x45, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x53, 0x5f,
+	0x41, 0x57, 0x41, 0x52, 0x44, 0x53, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x21, 0x0a, 0x1d, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x4d, 0x49, 0x4c, 0x45, 0x53,
+	0x54, 0x4f, 0x4e, 0x45, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x52, 0x44, 0x53, 0x5f, 0x46, 0x55, 0x4c,
+	0x4c, 0x10, 0x02, 0x22, 0xb6, 0x02, 0x0a, 0x0d, 0x56, 0x69, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f,
+	0x72, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x61, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x65, 0x65,
+	0x6e, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x72, 0x65, 0x65,
+	0x6e, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6d, 0x6f,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x61, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x56, 0x65,
+	0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x3d, 0x0a, 0x09,
+	0x45, 0x6c, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x6c, 0x64,
+	0x5f, 0x65, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x6c, 0x64, 0x45,
+	0x6c, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6c, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x45, 0x6c, 0x6f, 0x22, 0xb7, 0x02, 0x0a, 0x0a,
+	0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x67, 0x61, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65, 0x67, 0x61, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x72,
+	0x61, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0e, 0x76,
+	0x69, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x69, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x76, 0x69, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x65, 0x6c, 0x6f, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x6c, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x65, 0x6c, 0x6f, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xb2, 0x03, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6f, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x66, 0x12, 0x2a,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x6c, 0x64, 0x45, 0x6c, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f,
+	0x65, 0x6c, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x45, 0x6c,
+	0x6f, 0x12, 0x2a, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x67, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6c, 0x69, 0x63,
+	0x6b, 0x6f, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x12, 0x2a, 0x0a,
+	0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x52, 0x09,
+	0x6e, 0x65, 0x77, 0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x22, 0x4e, 0x0a, 0x0a, 0x51, 0x75, 0x69,
+	0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x14, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0a, 0x71, 0x75,
+	0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x61, 0x64, 0x67, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x75, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6d, 0x75, 0x74, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8f, 0x03, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x62, 0x62, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x2d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x22, 0xab, 0x02, 0x0a, 0x05, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
+	0x91, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x70, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4c,
+	0x55, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x59, 0x45, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x45,
+	0x45, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x41, 0x43, 0x4b, 0x10, 0x04, 0x12,
+	0x0a, 0x0a, 0x06, 0x50, 0x55, 0x52, 0x50, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4f,
+	0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x49, 0x4e, 0x4b, 0x10,
+	0x07, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x4e, 0x5a, 0x45, 0x10, 0x08, 0x2a, 0x40, 0x0a,
+	0x0c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x0a,
+	0x11, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x45,
+	0x4c, 0x4f, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x47, 0x4c, 0x49, 0x43, 0x4b, 0x4f, 0x32, 0x10, 0x01, 0x2a,
+	0x9d, 0x01, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x41,
+	0x52, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x48, 0x41, 0x52, 0x53, 0x49, 0x53, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x48, 0x45, 0x4c, 0x4c, 0x41, 0x53, 0x10,
+	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x45, 0x4c, 0x59, 0x53, 0x49,
+	0x55, 0x4d, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x41, 0x4d,
+	0x41, 0x5a, 0x4f, 0x4e, 0x49, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4f, 0x41, 0x52,
+	0x44, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x5f, 0x43, 0x49, 0x4d, 0x4d, 0x45, 0x52, 0x49, 0x41,
+	0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x56, 0x41, 0x53, 0x54,
+	0x49, 0x54, 0x41, 0x53, 0x5f, 0x42, 0x4f, 0x52, 0x45, 0x41, 0x4c, 0x49, 0x53, 0x10, 0x06, 0x2a,
+	0xda, 0x02, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x15, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x50, 0x41,
+	0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x45, 0x5f,
+	0x45, 0x52, 0x41, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4c, 0x55, 0x44,
+	0x45, 0x32, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x56, 0x45, 0x4e, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x10, 0x04, 0x12, 0x16,
+	0x0a, 0x12, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4c, 0x4f,
+	0x4e, 0x49, 0x45, 0x53, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x55, 0x52, 0x4d, 0x4f, 0x49, 0x4c, 0x10, 0x06, 0x12, 0x13, 0x0a,
+	0x0f, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f,
+	0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x45,
+	0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x52, 0x45, 0x53, 0x10, 0x09, 0x12,
+	0x12, 0x0a, 0x0e, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x4f,
+	0x4e, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x41, 0x54, 0x48, 0x46, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x10, 0x0b, 0x12, 0x12,
+	0x0a, 0x0e, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x45, 0x4f, 0x53,
+	0x10, 0x0c, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x50, 0x41, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x44, 0x45, 0x52, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x10, 0x0d, 0x2a, 0x61, 0x0a, 0x0a,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x41,
+	0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54, 0x53, 0x5f, 0x49, 0x4e,
+	0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x5f, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x49, 0x4f, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x41, 0x4e,
+	0x44, 0x52, 0x4f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x57, 0x45, 0x42, 0x10, 0x02,
+	0x2a, 0x73, 0x0a, 0x0b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4c,
+	0x4f, 0x42, 0x42, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56,
+	0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x49,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43,
+	0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x49,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45,
+	0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x65, 0x73, 0x74, 0x6e, 0x75, 0x74, 0x34,
+	0x32, 0x2f, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x6e, 0x67, 0x2d, 0x6d,
+	0x61, 0x72, 0x73, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -370,26 +2745,88 @@ func file_pkg_api_user_proto_rawDescGZIP() []byte {
 	return file_pkg_api_user_proto_rawDescData
 }
 
-var file_pkg_api_user_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_api_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_api_user_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_pkg_api_user_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_pkg_api_user_proto_goTypes = []any{
-	(PlayerColor)(0),              // 0: api.PlayerColor
-	(GameStatus)(0),               // 1: api.GameStatus
-	(*User)(nil),                  // 2: api.User
-	(*Game)(nil),                  // 3: api.Game
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(PlayerColor)(0),                        // 0: api.PlayerColor
+	(RatingSystem)(0),                       // 1: api.RatingSystem
+	(Board)(0),                              // 2: api.Board
+	(Expansion)(0),                          // 3: api.Expansion
+	(GameStatus)(0),                         // 4: api.GameStatus
+	(DevicePlatform)(0),                     // 5: api.DevicePlatform
+	(LobbyStatus)(0),                        // 6: api.LobbyStatus
+	(InvitationStatus)(0),                   // 7: api.InvitationStatus
+	(GameOptions_RandomMilestonesAwards)(0), // 8: api.GameOptions.RandomMilestonesAwards
+	(*Glicko)(nil),                          // 9: api.Glicko
+	(*User)(nil),                            // 10: api.User
+	(*Game)(nil),                            // 11: api.Game
+	(*GameSettings)(nil),                    // 12: api.GameSettings
+	(*GameOptions)(nil),                     // 13: api.GameOptions
+	(*VictoryPoints)(nil),                   // 14: api.VictoryPoints
+	(*EloChange)(nil),                       // 15: api.EloChange
+	(*GamePlayer)(nil),                      // 16: api.GamePlayer
+	(*GameDetails)(nil),                     // 17: api.GameDetails
+	(*RatingChange)(nil),                    // 18: api.RatingChange
+	(*QuietHours)(nil),                      // 19: api.QuietHours
+	(*NotificationSettings)(nil),            // 20: api.NotificationSettings
+	(*DeliveryAttempt)(nil),                 // 21: api.DeliveryAttempt
+	(*LobbyMember)(nil),                     // 22: api.LobbyMember
+	(*Lobby)(nil),                           // 23: api.Lobby
+	(*MatchmakingPreferences)(nil),          // 24: api.MatchmakingPreferences
+	(*Preset)(nil),                          // 25: api.Preset
+	(*GameOptions_Configuration)(nil),       // 26: api.GameOptions.Configuration
+	(*GameOptions_Variants)(nil),            // 27: api.GameOptions.Variants
+	(*timestamppb.Timestamp)(nil),           // 28: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 29: google.protobuf.Duration
 }
 var file_pkg_api_user_proto_depIdxs = []int32{
-	0, // 0: api.User.color:type_name -> api.PlayerColor
-	4, // 1: api.User.created_at:type_name -> google.protobuf.Timestamp
-	4, // 2: api.Game.created_at:type_name -> google.protobuf.Timestamp
-	4, // 3: api.Game.expires_at:type_name -> google.protobuf.Timestamp
-	1, // 4: api.Game.status:type_name -> api.GameStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: api.User.color:type_name -> api.PlayerColor
+	28, // 1: api.User.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: api.User.glicko:type_name -> api.Glicko
+	28, // 3: api.Game.created_at:type_name -> google.protobuf.Timestamp
+	28, // 4: api.Game.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: api.Game.status:type_name -> api.GameStatus
+	12, // 6: api.Game.settings:type_name -> api.GameSettings
+	2,  // 7: api.GameSettings.board:type_name -> api.Board
+	2,  // 8: api.GameOptions.board:type_name -> api.Board
+	3,  // 9: api.GameOptions.expansions:type_name -> api.Expansion
+	26, // 10: api.GameOptions.configuration:type_name -> api.GameOptions.Configuration
+	27, // 11: api.GameOptions.variants:type_name -> api.GameOptions.Variants
+	0,  // 12: api.GamePlayer.color:type_name -> api.PlayerColor
+	14, // 13: api.GamePlayer.victory_points:type_name -> api.VictoryPoints
+	15, // 14: api.GamePlayer.elo_change:type_name -> api.EloChange
+	28, // 15: api.GameDetails.created_at:type_name -> google.protobuf.Timestamp
+	28, // 16: api.GameDetails.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 17: api.GameDetails.finished_at:type_name -> google.protobuf.Timestamp
+	12, // 18: api.GameDetails.settings:type_name -> api.GameSettings
+	16, // 19: api.GameDetails.players:type_name -> api.GamePlayer
+	13, // 20: api.GameDetails.options:type_name -> api.GameOptions
+	28, // 21: api.RatingChange.changed_at:type_name -> google.protobuf.Timestamp
+	9,  // 22: api.RatingChange.old_glicko:type_name -> api.Glicko
+	9,  // 23: api.RatingChange.new_glicko:type_name -> api.Glicko
+	5,  // 24: api.NotificationSettings.disabled_platforms:type_name -> api.DevicePlatform
+	19, // 25: api.NotificationSettings.quiet_hours:type_name -> api.QuietHours
+	29, // 26: api.NotificationSettings.min_delay:type_name -> google.protobuf.Duration
+	5,  // 27: api.DeliveryAttempt.platform:type_name -> api.DevicePlatform
+	29, // 28: api.DeliveryAttempt.latency:type_name -> google.protobuf.Duration
+	28, // 29: api.DeliveryAttempt.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 30: api.LobbyMember.color:type_name -> api.PlayerColor
+	7,  // 31: api.LobbyMember.status:type_name -> api.InvitationStatus
+	6,  // 32: api.Lobby.status:type_name -> api.LobbyStatus
+	12, // 33: api.Lobby.settings:type_name -> api.GameSettings
+	22, // 34: api.Lobby.members:type_name -> api.LobbyMember
+	28, // 35: api.Lobby.created_at:type_name -> google.protobuf.Timestamp
+	28, // 36: api.Lobby.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 37: api.MatchmakingPreferences.settings:type_name -> api.GameSettings
+	13, // 38: api.Preset.options:type_name -> api.GameOptions
+	28, // 39: api.Preset.created_at:type_name -> google.protobuf.Timestamp
+	28, // 40: api.Preset.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 41: api.GameOptions.Variants.random_milestones_awards:type_name -> api.GameOptions.RandomMilestonesAwards
+	42, // [42:42] is the sub-list for method output_type
+	42, // [42:42] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_pkg_api_user_proto_init() }
@@ -399,7 +2836,7 @@ func file_pkg_api_user_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_pkg_api_user_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*User); i {
+			switch v := v.(*Glicko); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -411,6 +2848,18 @@ func file_pkg_api_user_proto_init() {
 			}
 		}
 		file_pkg_api_user_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*Game); i {
 			case 0:
 				return &v.state
@@ -422,14 +2871,206 @@ func file_pkg_api_user_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_api_user_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GameSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GameOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*VictoryPoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*EloChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GamePlayer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GameDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RatingChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*QuietHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*NotificationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DeliveryAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*LobbyMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*Lobby); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchmakingPreferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*Preset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GameOptions_Configuration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_user_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GameOptions_Variants); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_api_user_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      9,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},